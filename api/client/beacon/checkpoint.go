@@ -15,6 +15,7 @@ import (
 	"github.com/theQRL/qrysm/encoding/bytesutil"
 	"github.com/theQRL/qrysm/encoding/ssz/detect"
 	"github.com/theQRL/qrysm/io/file"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 	"github.com/theQRL/qrysm/runtime/version"
 )
 
@@ -30,6 +31,7 @@ type OriginData struct {
 	vu *detect.VersionedUnmarshaler
 	br [32]byte
 	sr [32]byte
+	ds *qrysmpb.DepositSnapshot
 }
 
 // SaveBlock saves the downloaded block to a unique file in the given path.
@@ -46,6 +48,40 @@ func (o *OriginData) SaveState(dir string) (string, error) {
 	return statePath, file.WriteFile(statePath, o.StateBytes())
 }
 
+// SaveDepositSnapshot saves the downloaded deposit snapshot to a unique file in the given path.
+// For readability and collision avoidance, the file name includes: type, config name and deposit count
+func (o *OriginData) SaveDepositSnapshot(dir string) (string, error) {
+	if o.ds == nil {
+		return "", errors.New("deposit snapshot was not downloaded")
+	}
+	b, err := o.ds.MarshalSSZ()
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal deposit snapshot")
+	}
+	snapshotPath := path.Join(dir, fmt.Sprintf("deposit_snapshot_%s_%d.ssz", o.vu.Config.ConfigName, o.ds.DepositCount))
+	return snapshotPath, file.WriteFile(snapshotPath, b)
+}
+
+// DownloadDepositSnapshot downloads the finalized deposit snapshot, which a beacon node started from the
+// downloaded state can use to initialize its deposit cache.
+func (o *OriginData) DownloadDepositSnapshot(ctx context.Context, client *Client) error {
+	ds, err := client.GetDepositSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	log.
+		WithField("deposit_count", ds.DepositCount).
+		WithField("deposit_root", hexutil.Encode(ds.DepositRoot)).
+		Info("Downloaded finalized deposit snapshot.")
+	o.ds = ds
+	return nil
+}
+
+// DepositSnapshot returns the downloaded deposit snapshot, or nil if it was not downloaded.
+func (o *OriginData) DepositSnapshot() *qrysmpb.DepositSnapshot {
+	return o.ds
+}
+
 // StateBytes returns the ssz-encoded bytes of the downloaded BeaconState value.
 func (o *OriginData) StateBytes() []byte {
 	return o.sb
@@ -353,3 +353,35 @@ func TestDownloadFinalizedData(t *testing.T) {
 	require.Equal(t, expected.br, od.br)
 	require.Equal(t, expected.sr, od.sr)
 }
+
+func TestDownloadDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	ds := &qrysmpb.DepositSnapshot{
+		Finalized:      [][]byte{bytes.Repeat([]byte{0x01}, 32)},
+		DepositRoot:    bytes.Repeat([]byte{0x02}, 32),
+		DepositCount:   1,
+		ExecutionHash:  bytes.Repeat([]byte{0x03}, 32),
+		ExecutionDepth: 100,
+	}
+	mds, err := ds.MarshalSSZ()
+	require.NoError(t, err)
+
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		res := &http.Response{Request: req}
+		switch req.URL.Path {
+		case getDepositSnapshotPath:
+			res.StatusCode = http.StatusOK
+			res.Body = io.NopCloser(bytes.NewBuffer(mds))
+		default:
+			res.StatusCode = http.StatusInternalServerError
+			res.Body = io.NopCloser(bytes.NewBufferString(""))
+		}
+		return res, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+
+	od := &OriginData{}
+	require.NoError(t, od.DownloadDepositSnapshot(ctx, c))
+	require.DeepEqual(t, ds, od.DepositSnapshot())
+}
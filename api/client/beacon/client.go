@@ -5,11 +5,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"path"
 	"regexp"
 	"sort"
 	"strconv"
 	"text/template"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/theQRL/go-qrl/common/hexutil"
@@ -24,14 +26,24 @@ import (
 )
 
 const (
-	getSignedBlockPath      = "/qrl/v1/beacon/blocks"
-	getBlockRootPath        = "/qrl/v1/beacon/blocks/{{.Id}}/root"
-	getForkForStatePath     = "/qrl/v1/beacon/states/{{.Id}}/fork"
-	getWeakSubjectivityPath = "/qrl/v1/beacon/weak_subjectivity"
-	getForkSchedulePath     = "/qrl/v1/config/fork_schedule"
-	getConfigSpecPath       = "/qrl/v1/config/spec"
-	getStatePath            = "/qrl/v1/debug/beacon/states"
-	getNodeVersionPath      = "/qrl/v1/node/version"
+	getSignedBlockPath                 = "/qrl/v1/beacon/blocks"
+	getBlockRootPath                   = "/qrl/v1/beacon/blocks/{{.Id}}/root"
+	getForkForStatePath                = "/qrl/v1/beacon/states/{{.Id}}/fork"
+	getGenesisPath                     = "/qrl/v1/beacon/genesis"
+	getWeakSubjectivityPath            = "/qrl/v1/beacon/weak_subjectivity"
+	getLightClientBootstrapPath        = "/qrl/v1/beacon/light_client/bootstrap/{{.Id}}"
+	getLightClientUpdatesByRangePath   = "/qrl/v1/beacon/light_client/updates"
+	getLightClientFinalityUpdatePath   = "/qrl/v1/beacon/light_client/finality_update"
+	getLightClientOptimisticUpdatePath = "/qrl/v1/beacon/light_client/optimistic_update"
+	getForkSchedulePath                = "/qrl/v1/config/fork_schedule"
+	getConfigSpecPath                  = "/qrl/v1/config/spec"
+	getStatePath                       = "/qrl/v1/debug/beacon/states"
+	getNodeVersionPath                 = "/qrl/v1/node/version"
+	getDepositSnapshotPath             = "/qrl/v1/beacon/deposit_snapshot"
+	getCommitteesPath                  = "/qrl/v1/beacon/states/{{.Id}}/committees"
+	getSyncStatusPath                  = "/qrl/v1/node/syncing"
+	postAttesterSlashingPath           = "/qrl/v1/beacon/pool/attester_slashings"
+	postProposerSlashingPath           = "/qrl/v1/beacon/pool/proposer_slashings"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the QRL Beacon API methods accept.
@@ -154,6 +166,54 @@ func (c *Client) GetFork(ctx context.Context, stateId StateOrBlockId) (*qrysmpb.
 	return fr.ToConsensus()
 }
 
+// Genesis is the genesis information of the chain followed by the beacon node.
+type Genesis struct {
+	Time           time.Time
+	ValidatorsRoot [32]byte
+	ForkVersion    [4]byte
+}
+
+// GetGenesis retrieves the genesis time, genesis validators root and genesis fork version of the chain.
+func (c *Client) GetGenesis(ctx context.Context) (*Genesis, error) {
+	body, err := c.Get(ctx, getGenesisPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting genesis")
+	}
+	d := struct {
+		Data struct {
+			GenesisTime           string `json:"genesis_time"`
+			GenesisValidatorsRoot string `json:"genesis_validators_root"`
+			GenesisForkVersion    string `json:"genesis_fork_version"`
+		} `json:"data"`
+	}{}
+	if err := json.Unmarshal(body, &d); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetGenesis")
+	}
+	genesisTime, err := strconv.ParseInt(d.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing genesis time %s", d.Data.GenesisTime)
+	}
+	root, err := hexutil.Decode(d.Data.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding hex-encoded value %s", d.Data.GenesisValidatorsRoot)
+	}
+	if len(root) != 32 {
+		return nil, fmt.Errorf("got %d byte genesis validators root, expected 32 bytes", len(root))
+	}
+	forkVersion, err := hexutil.Decode(d.Data.GenesisForkVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding hex-encoded value %s", d.Data.GenesisForkVersion)
+	}
+	if len(forkVersion) != 4 {
+		return nil, fmt.Errorf("got %d byte version, expected 4 bytes. version hex=%s", len(forkVersion), d.Data.GenesisForkVersion)
+	}
+	return &Genesis{
+		Time:           time.Unix(genesisTime, 0),
+		ValidatorsRoot: bytesutil.ToBytes32(root),
+		ForkVersion:    bytesutil.ToBytes4(forkVersion),
+	}, nil
+}
+
 // GetForkSchedule retrieve all forks, past present and future, of which this node is aware.
 func (c *Client) GetForkSchedule(ctx context.Context) (forks.OrderedSchedule, error) {
 	body, err := c.Get(ctx, getForkSchedulePath)
@@ -243,6 +303,103 @@ func (c *Client) GetState(ctx context.Context, stateId StateOrBlockId) ([]byte,
 	return b, nil
 }
 
+var getCommitteesTpl = idTemplate(getCommitteesPath)
+
+// GetCommittees retrieves the committees of the given epoch, computed from the state identified by stateId.
+// State identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
+// <slot>, <hex encoded stateRoot with 0x prefix>. Variables of type StateOrBlockId are exported by this package
+// for the named identifiers.
+func (c *Client) GetCommittees(ctx context.Context, stateId StateOrBlockId, epoch primitives.Epoch) ([]*shared.Committee, error) {
+	query := url.Values{"epoch": []string{strconv.FormatUint(uint64(epoch), 10)}}
+	b, err := c.Get(ctx, getCommitteesTpl(stateId), client.WithQueryParams(query))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting committees of epoch %d by state id = %s", epoch, stateId)
+	}
+	d := struct {
+		Data []*shared.Committee `json:"data"`
+	}{}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetCommittees")
+	}
+	return d.Data, nil
+}
+
+// SyncStatus is the sync status of the beacon node.
+type SyncStatus struct {
+	HeadSlot  primitives.Slot
+	IsSyncing bool
+}
+
+// GetSyncStatus retrieves the head slot of the beacon node and whether it is syncing.
+func (c *Client) GetSyncStatus(ctx context.Context) (*SyncStatus, error) {
+	b, err := c.Get(ctx, getSyncStatusPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting sync status")
+	}
+	d := struct {
+		Data struct {
+			HeadSlot  string `json:"head_slot"`
+			IsSyncing bool   `json:"is_syncing"`
+		} `json:"data"`
+	}{}
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetSyncStatus")
+	}
+	headSlot, err := strconv.ParseUint(d.Data.HeadSlot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing head slot %s", d.Data.HeadSlot)
+	}
+	return &SyncStatus{
+		HeadSlot:  primitives.Slot(headSlot),
+		IsSyncing: d.Data.IsSyncing,
+	}, nil
+}
+
+// SubmitAttesterSlashing submits an attester slashing to the operations pool of the beacon node.
+func (c *Client) SubmitAttesterSlashing(ctx context.Context, slashing *qrysmpb.AttesterSlashing) error {
+	s, err := shared.AttesterSlashingsFromConsensus([]*qrysmpb.AttesterSlashing{slashing})
+	if err != nil {
+		return errors.Wrap(err, "error converting attester slashing")
+	}
+	body, err := json.Marshal(s[0])
+	if err != nil {
+		return errors.Wrap(err, "error encoding attester slashing")
+	}
+	if _, err := c.Post(ctx, postAttesterSlashingPath, body); err != nil {
+		return errors.Wrap(err, "error submitting attester slashing")
+	}
+	return nil
+}
+
+// SubmitProposerSlashing submits a proposer slashing to the operations pool of the beacon node.
+func (c *Client) SubmitProposerSlashing(ctx context.Context, slashing *qrysmpb.ProposerSlashing) error {
+	s, err := shared.ProposerSlashingsFromConsensus([]*qrysmpb.ProposerSlashing{slashing})
+	if err != nil {
+		return errors.Wrap(err, "error converting proposer slashing")
+	}
+	body, err := json.Marshal(s[0])
+	if err != nil {
+		return errors.Wrap(err, "error encoding proposer slashing")
+	}
+	if _, err := c.Post(ctx, postProposerSlashingPath, body); err != nil {
+		return errors.Wrap(err, "error submitting proposer slashing")
+	}
+	return nil
+}
+
+// GetDepositSnapshot retrieves the finalized deposit snapshot (EIP-4881) of the beacon node.
+func (c *Client) GetDepositSnapshot(ctx context.Context) (*qrysmpb.DepositSnapshot, error) {
+	b, err := c.Get(ctx, getDepositSnapshotPath, client.WithSSZEncoding())
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting deposit snapshot")
+	}
+	ds := &qrysmpb.DepositSnapshot{}
+	if err := ds.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling deposit snapshot")
+	}
+	return ds, nil
+}
+
 // GetWeakSubjectivity calls a proposed API endpoint that is unique to qrysm
 // This api method does the following:
 // - computes weak subjectivity epoch
@@ -0,0 +1,93 @@
+package beacon
+
+import (
+	"context"
+	"encoding/binary"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/api/client"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+)
+
+// lightClientUpdateHeaderLength is the length of the prefix of each update returned by the updates by range
+// endpoint, made of an 8-byte little-endian length followed by a 4-byte fork digest.
+const lightClientUpdateHeaderLength = 12
+
+var getLightClientBootstrapTpl = idTemplate(getLightClientBootstrapPath)
+
+// GetLightClientBootstrap retrieves the light client bootstrap for the given block root.
+func (c *Client) GetLightClientBootstrap(ctx context.Context, blockRoot [32]byte) (*qrlpb.LightClientBootstrap, error) {
+	b, err := c.Get(ctx, getLightClientBootstrapTpl(IdFromRoot(blockRoot)), client.WithSSZEncoding())
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting light client bootstrap for root %#x", blockRoot)
+	}
+	bootstrap := &qrlpb.LightClientBootstrap{}
+	if err := bootstrap.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling light client bootstrap")
+	}
+	return bootstrap, nil
+}
+
+// GetLightClientUpdatesByRange retrieves the best light client updates for count sync committee periods,
+// starting at startPeriod. The beacon node stops at the first period it has no update for, so fewer updates
+// than requested may be returned.
+func (c *Client) GetLightClientUpdatesByRange(ctx context.Context, startPeriod, count uint64) ([]*qrlpb.LightClientUpdate, error) {
+	query := url.Values{}
+	query.Set("start_period", strconv.FormatUint(startPeriod, 10))
+	query.Set("count", strconv.FormatUint(count, 10))
+	b, err := c.Get(ctx, getLightClientUpdatesByRangePath, client.WithSSZEncoding(), client.WithQueryParams(query))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting light client updates from period %d", startPeriod)
+	}
+	return decodeLightClientUpdates(b)
+}
+
+// GetLightClientFinalityUpdate retrieves the latest light client finality update known to the beacon node.
+func (c *Client) GetLightClientFinalityUpdate(ctx context.Context) (*qrlpb.LightClientFinalityUpdate, error) {
+	b, err := c.Get(ctx, getLightClientFinalityUpdatePath, client.WithSSZEncoding())
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting light client finality update")
+	}
+	update := &qrlpb.LightClientFinalityUpdate{}
+	if err := update.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling light client finality update")
+	}
+	return update, nil
+}
+
+// GetLightClientOptimisticUpdate retrieves the latest light client optimistic update known to the beacon node.
+func (c *Client) GetLightClientOptimisticUpdate(ctx context.Context) (*qrlpb.LightClientOptimisticUpdate, error) {
+	b, err := c.Get(ctx, getLightClientOptimisticUpdatePath, client.WithSSZEncoding())
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting light client optimistic update")
+	}
+	update := &qrlpb.LightClientOptimisticUpdate{}
+	if err := update.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling light client optimistic update")
+	}
+	return update, nil
+}
+
+// decodeLightClientUpdates splits the SSZ response of the updates by range endpoint. Each update is prefixed by
+// an 8-byte little-endian length, which covers the 4-byte fork digest and the SSZ encoded update that follow it.
+func decodeLightClientUpdates(b []byte) ([]*qrlpb.LightClientUpdate, error) {
+	updates := make([]*qrlpb.LightClientUpdate, 0)
+	for len(b) > 0 {
+		if len(b) < lightClientUpdateHeaderLength {
+			return nil, errors.Errorf("light client update prefix is %d bytes, expected %d", len(b), lightClientUpdateHeaderLength)
+		}
+		length := binary.LittleEndian.Uint64(b[:8])
+		if length < 4 || length > uint64(len(b)-8) {
+			return nil, errors.Errorf("invalid light client update length %d", length)
+		}
+		update := &qrlpb.LightClientUpdate{}
+		if err := update.UnmarshalSSZ(b[lightClientUpdateHeaderLength : 8+length]); err != nil {
+			return nil, errors.Wrap(err, "error unmarshaling light client update")
+		}
+		updates = append(updates, update)
+		b = b[8+length:]
+	}
+	return updates, nil
+}
@@ -0,0 +1,69 @@
+package beacon
+
+import (
+	"encoding/binary"
+	"testing"
+
+	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func testLightClientUpdate(slot primitives.Slot) *qrlpb.LightClientUpdate {
+	header := func(s primitives.Slot) *qrlpb.BeaconBlockHeader {
+		return &qrlpb.BeaconBlockHeader{
+			Slot:       s,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		}
+	}
+	branch := func(depth int) [][]byte {
+		b := make([][]byte, depth)
+		for i := range b {
+			b[i] = make([]byte, 32)
+		}
+		return b
+	}
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.MLDSA87PubkeyLength)
+	}
+	return &qrlpb.LightClientUpdate{
+		AttestedHeader:          header(slot),
+		NextSyncCommittee:       &qrlpb.SyncCommittee{Pubkeys: pubkeys},
+		NextSyncCommitteeBranch: branch(5),
+		FinalizedHeader:         header(slot - 1),
+		FinalityBranch:          branch(6),
+		SyncAggregate: &qrlpb.SyncAggregate{
+			SyncCommitteeBits: make([]byte, params.BeaconConfig().SyncCommitteeSize/8),
+		},
+		SignatureSlot: slot + 1,
+	}
+}
+
+func TestDecodeLightClientUpdates(t *testing.T) {
+	var body []byte
+	for _, slot := range []primitives.Slot{10, 20} {
+		enc, err := testLightClientUpdate(slot).MarshalSSZ()
+		require.NoError(t, err)
+		body = binary.LittleEndian.AppendUint64(body, uint64(4+len(enc)))
+		body = append(body, 0x01, 0x02, 0x03, 0x04)
+		body = append(body, enc...)
+	}
+
+	updates, err := decodeLightClientUpdates(body)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(updates))
+	require.Equal(t, primitives.Slot(10), updates[0].AttestedHeader.Slot)
+	require.Equal(t, primitives.Slot(20), updates[1].AttestedHeader.Slot)
+
+	updates, err = decodeLightClientUpdates(nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(updates))
+
+	_, err = decodeLightClientUpdates(body[:len(body)-1])
+	require.ErrorContains(t, "invalid light client update length", err)
+}
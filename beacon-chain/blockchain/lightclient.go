@@ -6,15 +6,19 @@ import (
 	"fmt"
 
 	"github.com/theQRL/qrysm/beacon-chain/state"
+	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
 	"github.com/theQRL/qrysm/config/params"
 	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
 	"github.com/theQRL/qrysm/encoding/bytesutil"
 	"github.com/theQRL/qrysm/proto/migration"
 	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	"github.com/theQRL/qrysm/time/slots"
 )
 
 const (
-	finalityBranchNumOfLeaves = 6
+	finalityBranchNumOfLeaves      = 6
+	syncCommitteeBranchNumOfLeaves = 5
 )
 
 // CreateLightClientFinalityUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/full-node.md#create_light_client_finality_update
@@ -235,3 +239,150 @@ func NewLightClientUpdateFromOptimisticUpdate(update *qrlpb.LightClientOptimisti
 		SignatureSlot:  update.SignatureSlot,
 	}
 }
+
+// NewLightClientUpdateFromBeaconState - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/full-node.md#create_light_client_update
+// The update carries the next sync committee of the attested state only when the attested header and the
+// signature slot are in the same sync committee period, as it is only useful if signed by the current committee.
+func NewLightClientUpdateFromBeaconState(
+	ctx context.Context,
+	state state.BeaconState,
+	block interfaces.ReadOnlySignedBeaconBlock,
+	attestedState state.BeaconState,
+	finalizedBlock interfaces.ReadOnlySignedBeaconBlock) (*qrlpb.LightClientUpdate, error) {
+	result, err := NewLightClientFinalityUpdateFromBeaconState(ctx, state, block, attestedState, finalizedBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	// update_attested_period = compute_sync_committee_period_at_slot(attested_block.message.slot)
+	// update_signature_period = compute_sync_committee_period_at_slot(block.message.slot)
+	if syncCommitteePeriodAtSlot(result.AttestedHeader.Slot) == syncCommitteePeriodAtSlot(block.Block().Slot()) {
+		nextSyncCommittee, err := attestedState.NextSyncCommittee()
+		if err != nil {
+			return nil, fmt.Errorf("could not get next sync committee %v", err)
+		}
+		branch, err := attestedState.NextSyncCommitteeProof(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get next sync committee proof %v", err)
+		}
+		result.NextSyncCommittee = &qrlpb.SyncCommittee{Pubkeys: nextSyncCommittee.Pubkeys}
+		result.NextSyncCommitteeBranch = branch
+	} else {
+		result.NextSyncCommittee = emptySyncCommittee()
+		result.NextSyncCommitteeBranch = emptyBranch(syncCommitteeBranchNumOfLeaves)
+	}
+	return result, nil
+}
+
+// NewLightClientBootstrapFromBeaconState - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/full-node.md#create_light_client_bootstrap
+// The given state must be the post-state of a block, i.e. its slot must equal the slot of its latest block header.
+func NewLightClientBootstrapFromBeaconState(ctx context.Context, state state.BeaconState) (*qrlpb.LightClientBootstrap, error) {
+	// assert state.slot == state.latest_block_header.slot
+	if state.Slot() != state.LatestBlockHeader().Slot {
+		return nil, fmt.Errorf("state slot %d not equal to latest block header slot %d", state.Slot(), state.LatestBlockHeader().Slot)
+	}
+
+	// header = state.latest_block_header.copy()
+	// header.state_root = hash_tree_root(state)
+	header := state.LatestBlockHeader()
+	stateRoot, err := state.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get state root %v", err)
+	}
+	header.StateRoot = stateRoot[:]
+
+	currentSyncCommittee, err := state.CurrentSyncCommittee()
+	if err != nil {
+		return nil, fmt.Errorf("could not get current sync committee %v", err)
+	}
+	branch, err := state.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get current sync committee proof %v", err)
+	}
+
+	return &qrlpb.LightClientBootstrap{
+		Header: &qrlpb.BeaconBlockHeader{
+			Slot:          header.Slot,
+			ProposerIndex: header.ProposerIndex,
+			ParentRoot:    header.ParentRoot,
+			StateRoot:     header.StateRoot,
+			BodyRoot:      header.BodyRoot,
+		},
+		CurrentSyncCommittee:       &qrlpb.SyncCommittee{Pubkeys: currentSyncCommittee.Pubkeys},
+		CurrentSyncCommitteeBranch: branch,
+	}, nil
+}
+
+// IsBetterUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/sync-protocol.md#is_better_update
+// It reports whether newUpdate should replace oldUpdate as the best update of a sync committee period.
+func IsBetterUpdate(newUpdate, oldUpdate *qrlpb.LightClientUpdate) bool {
+	maxActiveParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Len()
+	newNumActiveParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	oldNumActiveParticipants := oldUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	newHasSupermajority := newNumActiveParticipants*3 >= maxActiveParticipants*2
+	oldHasSupermajority := oldNumActiveParticipants*3 >= maxActiveParticipants*2
+
+	// Compare supermajority (> 2/3) sync committee participation
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	// Compare presence of relevant sync committee
+	newHasRelevantSyncCommittee := newUpdate.IsSyncCommiteeUpdate() &&
+		syncCommitteePeriodAtSlot(newUpdate.AttestedHeader.Slot) == syncCommitteePeriodAtSlot(newUpdate.SignatureSlot)
+	oldHasRelevantSyncCommittee := oldUpdate.IsSyncCommiteeUpdate() &&
+		syncCommitteePeriodAtSlot(oldUpdate.AttestedHeader.Slot) == syncCommitteePeriodAtSlot(oldUpdate.SignatureSlot)
+	if newHasRelevantSyncCommittee != oldHasRelevantSyncCommittee {
+		return newHasRelevantSyncCommittee
+	}
+
+	// Compare indication of any finality
+	newHasFinality := newUpdate.IsFinalityUpdate()
+	oldHasFinality := oldUpdate.IsFinalityUpdate()
+	if newHasFinality != oldHasFinality {
+		return newHasFinality
+	}
+
+	// Compare sync committee finality
+	if newHasFinality {
+		newHasSyncCommitteeFinality := syncCommitteePeriodAtSlot(newUpdate.FinalizedHeader.Slot) == syncCommitteePeriodAtSlot(newUpdate.AttestedHeader.Slot)
+		oldHasSyncCommitteeFinality := syncCommitteePeriodAtSlot(oldUpdate.FinalizedHeader.Slot) == syncCommitteePeriodAtSlot(oldUpdate.AttestedHeader.Slot)
+		if newHasSyncCommitteeFinality != oldHasSyncCommitteeFinality {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	// Tiebreaker 1: Sync committee participation beyond supermajority
+	if newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	// Tiebreaker 2: Prefer older data (fewer changes to best)
+	if newUpdate.AttestedHeader.Slot != oldUpdate.AttestedHeader.Slot {
+		return newUpdate.AttestedHeader.Slot < oldUpdate.AttestedHeader.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+func syncCommitteePeriodAtSlot(slot primitives.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+func emptySyncCommittee() *qrlpb.SyncCommittee {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.MLDSA87PubkeyLength)
+	}
+	return &qrlpb.SyncCommittee{Pubkeys: pubkeys}
+}
+
+func emptyBranch(leaves int) [][]byte {
+	branch := make([][]byte, leaves)
+	for i := range branch {
+		branch[i] = make([]byte, 32)
+	}
+	return branch
+}
@@ -157,3 +157,132 @@ func TestLightClient_NewLightClientFinalityUpdateFromBeaconState(t *testing.T) {
 		require.DeepSSZEqual(t, zeroHash, leaf, "Leaf is not zero")
 	}
 }
+
+func TestLightClient_NewLightClientUpdateFromBeaconState(t *testing.T) {
+	l := newTestLc(t).setupTest()
+
+	update, err := NewLightClientUpdateFromBeaconState(l.ctx, l.state, l.block, l.attestedState, nil)
+	require.NoError(t, err)
+	l.checkSyncAggregate(update)
+	l.checkAttestedHeader(update)
+
+	// The attested header and the signature slot are in the same period, so the next sync committee is included.
+	nextSyncCommittee, err := l.attestedState.NextSyncCommittee()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, nextSyncCommittee.Pubkeys, update.NextSyncCommittee.Pubkeys, "Next sync committee is not equal")
+	require.Equal(t, syncCommitteeBranchNumOfLeaves, len(update.NextSyncCommitteeBranch), "Invalid next sync committee branch leaves")
+	require.Equal(t, false, update.IsFinalityUpdate(), "Update without finalized block is a finality update")
+
+	_, err = update.MarshalSSZ()
+	require.NoError(t, err)
+}
+
+func TestLightClient_NewLightClientBootstrapFromBeaconState(t *testing.T) {
+	l := newTestLc(t).setupTest()
+
+	bootstrap, err := NewLightClientBootstrapFromBeaconState(l.ctx, l.state)
+	require.NoError(t, err)
+	stateRoot, err := l.state.HashTreeRoot(l.ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, stateRoot[:], bootstrap.Header.StateRoot, "Header state root is not equal")
+	require.Equal(t, l.state.Slot(), bootstrap.Header.Slot, "Header slot is not equal")
+	currentSyncCommittee, err := l.state.CurrentSyncCommittee()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, currentSyncCommittee.Pubkeys, bootstrap.CurrentSyncCommittee.Pubkeys, "Current sync committee is not equal")
+	require.Equal(t, syncCommitteeBranchNumOfLeaves, len(bootstrap.CurrentSyncCommitteeBranch), "Invalid current sync committee branch leaves")
+
+	require.NoError(t, l.state.SetSlot(l.state.Slot()+1))
+	_, err = NewLightClientBootstrapFromBeaconState(l.ctx, l.state)
+	require.ErrorContains(t, "not equal to latest block header slot", err)
+}
+
+func lightClientUpdateWithParticipants(participants uint64, attestedSlot primitives.Slot) *qrlpb.LightClientUpdate {
+	agg := &qrlpb.SyncAggregate{SyncCommitteeBits: make([]byte, 16)}
+	for i := range participants {
+		agg.SyncCommitteeBits.SetBitAt(i, true)
+	}
+	return &qrlpb.LightClientUpdate{
+		AttestedHeader:  &qrlpb.BeaconBlockHeader{Slot: attestedSlot},
+		FinalizedHeader: &qrlpb.BeaconBlockHeader{},
+		SyncAggregate:   agg,
+		SignatureSlot:   attestedSlot + 1,
+	}
+}
+
+func TestLightClient_IsBetterUpdate(t *testing.T) {
+	supermajority := params.BeaconConfig().SyncCommitteeSize * 2 / 3
+	finalized := func(u *qrlpb.LightClientUpdate) *qrlpb.LightClientUpdate {
+		u.FinalizedHeader = &qrlpb.BeaconBlockHeader{Slot: u.AttestedHeader.Slot - 1}
+		u.FinalityBranch = [][]byte{{0x01}}
+		return u
+	}
+	tests := []struct {
+		name     string
+		new, old *qrlpb.LightClientUpdate
+		want     bool
+	}{
+		{
+			name: "supermajority beats no supermajority",
+			new:  lightClientUpdateWithParticipants(supermajority+1, 10),
+			old:  lightClientUpdateWithParticipants(supermajority-1, 10),
+			want: true,
+		},
+		{
+			name: "more participants without supermajority",
+			new:  lightClientUpdateWithParticipants(5, 10),
+			old:  lightClientUpdateWithParticipants(6, 10),
+			want: false,
+		},
+		{
+			name: "finality beats no finality",
+			new:  finalized(lightClientUpdateWithParticipants(supermajority+1, 10)),
+			old:  lightClientUpdateWithParticipants(supermajority+10, 10),
+			want: true,
+		},
+		{
+			name: "more participants beyond supermajority",
+			new:  lightClientUpdateWithParticipants(supermajority+2, 10),
+			old:  lightClientUpdateWithParticipants(supermajority+1, 10),
+			want: true,
+		},
+		{
+			name: "older attested header breaks ties",
+			new:  lightClientUpdateWithParticipants(supermajority+1, 12),
+			old:  lightClientUpdateWithParticipants(supermajority+1, 10),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsBetterUpdate(tt.new, tt.old))
+		})
+	}
+}
+
+func TestService_SetLatestLightClientUpdates(t *testing.T) {
+	s := &Service{}
+	finalized := func(u *qrlpb.LightClientUpdate, finalizedSlot primitives.Slot) *qrlpb.LightClientUpdate {
+		u.FinalizedHeader = &qrlpb.BeaconBlockHeader{Slot: finalizedSlot}
+		u.FinalityBranch = [][]byte{{0x01}}
+		return u
+	}
+
+	fu, ou := s.setLatestLightClientUpdates(lightClientUpdateWithParticipants(10, 10))
+	require.Equal(t, (*qrlpb.LightClientFinalityUpdate)(nil), fu)
+	require.NotNil(t, ou)
+	require.Equal(t, primitives.Slot(10), s.LightClientOptimisticUpdate().AttestedHeader.Slot)
+
+	// An update attesting an older block does not replace the optimistic update.
+	fu, ou = s.setLatestLightClientUpdates(finalized(lightClientUpdateWithParticipants(10, 9), 4))
+	require.NotNil(t, fu)
+	require.Equal(t, (*qrlpb.LightClientOptimisticUpdate)(nil), ou)
+	require.Equal(t, primitives.Slot(4), s.LightClientFinalityUpdate().FinalizedHeader.Slot)
+
+	// Same finalized header, without a supermajority, is not forwarded again.
+	fu, _ = s.setLatestLightClientUpdates(finalized(lightClientUpdateWithParticipants(10, 11), 4))
+	require.Equal(t, (*qrlpb.LightClientFinalityUpdate)(nil), fu)
+
+	fu, _ = s.setLatestLightClientUpdates(finalized(lightClientUpdateWithParticipants(10, 12), 8))
+	require.NotNil(t, fu)
+	require.Equal(t, primitives.Slot(8), s.LightClientFinalityUpdate().FinalizedHeader.Slot)
+}
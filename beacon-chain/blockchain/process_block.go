@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/beacon-chain/cache"
 	"github.com/theQRL/qrysm/beacon-chain/core/blocks"
 	"github.com/theQRL/qrysm/beacon-chain/core/feed"
 	statefeed "github.com/theQRL/qrysm/beacon-chain/core/feed/state"
@@ -69,6 +70,9 @@ func (s *Service) postBlockProcess(ctx context.Context, roblock consensusblocks.
 	}
 
 	defer s.sendStateFeedOnBlock(roblock) // only send event after successful insertion
+	if features.Get().EnableLightClient {
+		defer s.processLightClientUpdates(ctx, roblock, postState)
+	}
 
 	start := time.Now()
 	headRoot, err := s.cfg.ForkChoiceStore.Head(ctx)
@@ -245,9 +249,11 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 		verify, err = sigSet.Verify()
 	}
 	if err != nil {
+		cache.VerifiedSignatures.Invalidate(sigSet)
 		return invalidBlock{error: err}
 	}
 	if !verify {
+		cache.VerifiedSignatures.Invalidate(sigSet)
 		return invalidBlock{error: errors.New("batch block signature verification failed")}
 	}
 
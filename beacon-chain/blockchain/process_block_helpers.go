@@ -251,8 +251,9 @@ func (s *Service) insertFinalizedDeposits(ctx context.Context, fRoot [32]byte) {
 	// to be included(rather than the last one to be processed). This was most likely
 	// done as the state cannot represent signed integers.
 	finalizedExecutionDepIdx := executionDepositIndex - 1
-	if err = s.cfg.DepositCache.InsertFinalizedDeposits(ctx, int64(finalizedExecutionDepIdx), common.Hash(finalizedState.ExecutionData().BlockHash),
-		0 /* Setting a zero value as we have no access to block height */); err != nil {
+	executionHash := common.Hash(finalizedState.ExecutionData().BlockHash)
+	if err = s.cfg.DepositCache.InsertFinalizedDeposits(ctx, int64(finalizedExecutionDepIdx), executionHash,
+		s.executionBlockHeight(ctx, executionHash)); err != nil {
 		log.WithError(err).Error("could not insert finalized deposits")
 		return
 	}
@@ -267,6 +268,24 @@ func (s *Service) insertFinalizedDeposits(ctx context.Context, fRoot [32]byte) {
 	log.WithField("duration", time.Since(startTime).String()).Debugf("Finalized deposit insertion completed at index %d", finalizedExecutionDepIdx)
 }
 
+// executionBlockHeight returns the height of the execution block with the given hash. The height is recorded in the
+// finalized deposit snapshot, so that nodes bootstrapped from the snapshot know where to resume processing deposit
+// logs. Zero is returned if the execution client does not know the block.
+func (s *Service) executionBlockHeight(ctx context.Context, hash common.Hash) uint64 {
+	if s.cfg.ExecutionEngineCaller == nil {
+		return 0
+	}
+	blk, err := s.cfg.ExecutionEngineCaller.ExecutionBlockByHash(ctx, hash, false /* no txs */)
+	if err != nil {
+		log.WithError(err).WithField("hash", fmt.Sprintf("%#x", hash)).Debug("Could not get height of finalized execution block")
+		return 0
+	}
+	if blk == nil || blk.Number == nil {
+		return 0
+	}
+	return blk.Number.Uint64()
+}
+
 // This ensures that the input root defaults to using genesis root instead of zero hashes. This is needed for handling
 // fork choice justification routine.
 func (s *Service) ensureRootNotZeros(root [32]byte) [32]byte {
@@ -0,0 +1,164 @@
+package blockchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/beacon-chain/core/feed"
+	statefeed "github.com/theQRL/qrysm/beacon-chain/core/feed/state"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/config/params"
+	consensusblocks "github.com/theQRL/qrysm/consensus-types/blocks"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	"github.com/theQRL/qrysm/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// LightClientUpdateFetcher retrieves the latest light client updates produced by the light client server.
+type LightClientUpdateFetcher interface {
+	LightClientFinalityUpdate() *qrlpb.LightClientFinalityUpdate
+	LightClientOptimisticUpdate() *qrlpb.LightClientOptimisticUpdate
+}
+
+// LightClientFinalityUpdate returns the latest finality update, or nil if none has been produced yet.
+func (s *Service) LightClientFinalityUpdate() *qrlpb.LightClientFinalityUpdate {
+	s.lcLock.RLock()
+	defer s.lcLock.RUnlock()
+	return s.lcFinalityUpdate
+}
+
+// LightClientOptimisticUpdate returns the latest optimistic update, or nil if none has been produced yet.
+func (s *Service) LightClientOptimisticUpdate() *qrlpb.LightClientOptimisticUpdate {
+	s.lcLock.RLock()
+	defer s.lcLock.RUnlock()
+	return s.lcOptimisticUpdate
+}
+
+// processLightClientUpdates computes the light client update for the parent of the given block, saves it
+// when it is the best update of its sync committee period, and publishes the finality and optimistic updates
+// it implies when they are newer than the ones published so far. Failures are not fatal to block processing.
+func (s *Service) processLightClientUpdates(ctx context.Context, roblock consensusblocks.ROBlock, postState state.BeaconState) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.processLightClientUpdates")
+	defer span.End()
+
+	update, err := s.newLightClientUpdate(ctx, roblock, postState)
+	if err != nil {
+		log.WithError(err).Debug("Could not create light client update")
+		return
+	}
+	if err := s.saveBestLightClientUpdate(ctx, update); err != nil {
+		log.WithError(err).Error("Could not save light client update")
+	}
+
+	finalityUpdate, optimisticUpdate := s.setLatestLightClientUpdates(update)
+	if finalityUpdate == nil && optimisticUpdate == nil {
+		return
+	}
+	go s.publishLightClientUpdates(update.SignatureSlot, finalityUpdate, optimisticUpdate)
+}
+
+func (s *Service) newLightClientUpdate(ctx context.Context, roblock consensusblocks.ROBlock, postState state.BeaconState) (*qrlpb.LightClientUpdate, error) {
+	parentRoot := roblock.Block().ParentRoot()
+	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, parentRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get attested state for root %#x", parentRoot)
+	}
+	var finalizedBlock interfaces.ReadOnlySignedBeaconBlock
+	finalizedRoot := bytesutil.ToBytes32(attestedState.FinalizedCheckpoint().Root)
+	if finalizedRoot != params.BeaconConfig().ZeroHash {
+		finalizedBlock, err = s.getBlock(ctx, finalizedRoot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get finalized block for root %#x", finalizedRoot)
+		}
+	}
+	return NewLightClientUpdateFromBeaconState(ctx, postState, roblock, attestedState, finalizedBlock)
+}
+
+// saveBestLightClientUpdate persists the update when it is better than the one stored for its sync committee period.
+func (s *Service) saveBestLightClientUpdate(ctx context.Context, update *qrlpb.LightClientUpdate) error {
+	period := syncCommitteePeriodAtSlot(update.AttestedHeader.Slot)
+	best, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	if err != nil {
+		return errors.Wrapf(err, "could not get light client update for period %d", period)
+	}
+	if best != nil && !IsBetterUpdate(update, best) {
+		return nil
+	}
+	return s.cfg.BeaconDB.SaveLightClientUpdate(ctx, period, update)
+}
+
+// setLatestLightClientUpdates records the finality and optimistic updates derived from the given update, following
+// the gossip forwarding rules of the light client networking spec. The updates which replaced the previous ones are
+// returned, or nil when they are not newer.
+func (s *Service) setLatestLightClientUpdates(update *qrlpb.LightClientUpdate) (*qrlpb.LightClientFinalityUpdate, *qrlpb.LightClientOptimisticUpdate) {
+	s.lcLock.Lock()
+	defer s.lcLock.Unlock()
+
+	var finalityUpdate *qrlpb.LightClientFinalityUpdate
+	if update.IsFinalityUpdate() && isNewerFinalityUpdate(update, s.lcFinalityUpdate) {
+		finalityUpdate = CreateLightClientFinalityUpdate(update)
+		s.lcFinalityUpdate = finalityUpdate
+	}
+	var optimisticUpdate *qrlpb.LightClientOptimisticUpdate
+	if s.lcOptimisticUpdate == nil || update.AttestedHeader.Slot > s.lcOptimisticUpdate.AttestedHeader.Slot {
+		optimisticUpdate = CreateLightClientOptimisticUpdate(update)
+		s.lcOptimisticUpdate = optimisticUpdate
+	}
+	return finalityUpdate, optimisticUpdate
+}
+
+// isNewerFinalityUpdate reports whether the finalized header of the update is newer than the one of the previous
+// finality update, or at the same slot with a supermajority of the sync committee where the previous one had none.
+func isNewerFinalityUpdate(update *qrlpb.LightClientUpdate, prev *qrlpb.LightClientFinalityUpdate) bool {
+	if prev == nil {
+		return true
+	}
+	if update.FinalizedHeader.Slot != prev.FinalizedHeader.Slot {
+		return update.FinalizedHeader.Slot > prev.FinalizedHeader.Slot
+	}
+	return hasSupermajority(update.SyncAggregate) && !hasSupermajority(prev.SyncAggregate)
+}
+
+func hasSupermajority(agg *qrlpb.SyncAggregate) bool {
+	return agg.SyncCommitteeBits.Count()*3 >= agg.SyncCommitteeBits.Len()*2
+}
+
+// publishLightClientUpdates notifies the new updates on the state feed and broadcasts them over gossip. Peers ignore
+// updates received before one third of the signature slot has elapsed, so publishing waits until then.
+func (s *Service) publishLightClientUpdates(
+	signatureSlot primitives.Slot,
+	finalityUpdate *qrlpb.LightClientFinalityUpdate,
+	optimisticUpdate *qrlpb.LightClientOptimisticUpdate,
+) {
+	cfg := params.BeaconConfig()
+	slotStart := slots.StartTime(uint64(s.genesisTime.Unix()), signatureSlot)
+	publishTime := slotStart.Add(time.Duration(cfg.SecondsPerSlot/cfg.IntervalsPerSlot) * time.Second)
+	select {
+	case <-time.After(time.Until(publishTime)):
+	case <-s.ctx.Done():
+		return
+	}
+
+	if finalityUpdate != nil {
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.LightClientFinalityUpdate,
+			Data: &qrlpb.LightClientFinalityUpdateWithVersion{Version: qrlpb.Version_ZOND, Data: finalityUpdate},
+		})
+		if err := s.cfg.P2p.Broadcast(s.ctx, finalityUpdate); err != nil {
+			log.WithError(err).Error("Could not broadcast light client finality update")
+		}
+	}
+	if optimisticUpdate != nil {
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.LightClientOptimisticUpdate,
+			Data: &qrlpb.LightClientOptimisticUpdateWithVersion{Version: qrlpb.Version_ZOND, Data: optimisticUpdate},
+		})
+		if err := s.cfg.P2p.Broadcast(s.ctx, optimisticUpdate); err != nil {
+			log.WithError(err).Error("Could not broadcast light client optimistic update")
+		}
+	}
+}
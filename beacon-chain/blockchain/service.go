@@ -28,6 +28,7 @@ import (
 	"github.com/theQRL/qrysm/consensus-types/blocks"
 	"github.com/theQRL/qrysm/consensus-types/interfaces"
 	"github.com/theQRL/qrysm/encoding/bytesutil"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 	qrysmTime "github.com/theQRL/qrysm/time"
 	"github.com/theQRL/qrysm/time/slots"
@@ -52,6 +53,9 @@ type Service struct {
 	clockWaiter          startup.ClockWaiter
 	syncComplete         chan struct{}
 	blockBeingSynced     *currentlySyncingBlock
+	lcLock               sync.RWMutex
+	lcFinalityUpdate     *qrlpb.LightClientFinalityUpdate
+	lcOptimisticUpdate   *qrlpb.LightClientOptimisticUpdate
 }
 
 // config options for the service.
@@ -73,6 +73,8 @@ type ChainService struct {
 	OptimisticRoots             map[[32]byte]bool
 	BlockSlot                   primitives.Slot
 	SyncingRoot                 [32]byte
+	LCFinalityUpdate            *qrlpb.LightClientFinalityUpdate
+	LCOptimisticUpdate          *qrlpb.LightClientOptimisticUpdate
 }
 
 func (s *ChainService) Ancestor(ctx context.Context, root []byte, slot primitives.Slot) ([]byte, error) {
@@ -639,3 +641,13 @@ func (s *ChainService) UnrealizedJustifiedPayloadBlockHash() [32]byte {
 func (c *ChainService) BlockBeingSynced(root [32]byte) bool {
 	return root == c.SyncingRoot
 }
+
+// LightClientFinalityUpdate mocks the same method in the chain service.
+func (s *ChainService) LightClientFinalityUpdate() *qrlpb.LightClientFinalityUpdate {
+	return s.LCFinalityUpdate
+}
+
+// LightClientOptimisticUpdate mocks the same method in the chain service.
+func (s *ChainService) LightClientOptimisticUpdate() *qrlpb.LightClientOptimisticUpdate {
+	return s.LCOptimisticUpdate
+}
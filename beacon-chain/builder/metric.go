@@ -27,4 +27,26 @@ var (
 			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
 		},
 	)
+	relayRequestLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "builder_relay_request_latency_milliseconds",
+			Help:    "Captures RPC latency of each builder relay in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay", "method"},
+	)
+	relayRequestErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_request_errors_total",
+			Help: "The number of failed requests to each builder relay, including invalid bids",
+		},
+		[]string{"relay", "method"},
+	)
+	relayBidWins = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_bid_wins_total",
+			Help: "The number of times the bid of each builder relay was chosen",
+		},
+		[]string{"relay"},
+	)
 )
@@ -1,6 +1,8 @@
 package builder
 
 import (
+	"strings"
+
 	"github.com/theQRL/qrysm/api/client/builder"
 	"github.com/theQRL/qrysm/beacon-chain/blockchain"
 	"github.com/theQRL/qrysm/beacon-chain/cache"
@@ -13,25 +15,35 @@ type Option func(s *Service) error
 
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
-	endpoint := c.String(flags.MevRelayEndpoint.Name)
-	var client *builder.Client
-	if endpoint != "" {
-		var err error
-		client, err = builder.NewClient(endpoint)
+	var opts []Option
+	for _, endpoint := range strings.Split(c.String(flags.MevRelayEndpoint.Name), ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+		client, err := builder.NewClient(endpoint)
 		if err != nil {
 			return nil, err
 		}
+		opts = append(opts, WithBuilderClient(client))
 	}
-	opts := []Option{
-		WithBuilderClient(client),
-	}
+	opts = append(opts, WithBidPolicy(&BidPolicy{MinBid: c.Uint64(flags.MinBuilderBid.Name)}))
 	return opts, nil
 }
 
-// WithBuilderClient sets the builder client for the beacon chain builder service.
+// WithBuilderClient adds a builder client for the beacon chain builder service. Bids are collected from every
+// builder client that is added.
 func WithBuilderClient(client builder.BuilderClient) Option {
 	return func(s *Service) error {
-		s.cfg.builderClient = client
+		s.cfg.builderClients = append(s.cfg.builderClients, client)
+		return nil
+	}
+}
+
+// WithBidPolicy sets the policy used to choose between the bids of the builder clients.
+func WithBidPolicy(p *BidPolicy) Option {
+	return func(s *Service) error {
+		s.cfg.bidPolicy = p
 		return nil
 	}
 }
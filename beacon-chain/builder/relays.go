@@ -0,0 +1,254 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/api/client/builder"
+	"github.com/theQRL/qrysm/beacon-chain/core/signing"
+	field_params "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+)
+
+// BidPolicy decides which of the bids collected from the relays is used. The bid with the highest value that
+// is not below MinBid, has a valid signature and passes the validation of the proposer is chosen. The preference for
+// the local payload is configured separately with LocalBlockValueBoost, as it is applied once the local payload value
+// is known.
+type BidPolicy struct {
+	// MinBid is the lowest bid value in shor that is accepted.
+	MinBid uint64
+}
+
+// relayBid is a bid received from a relay. Its signature is only verified when the bid policy considers it.
+type relayBid struct {
+	relay     builder.BuilderClient
+	signedBid builder.SignedBid
+	value     *big.Int
+	blockHash [32]byte
+}
+
+// winningBid is the relay whose bid was chosen for a slot.
+type winningBid struct {
+	relay builder.BuilderClient
+	slot  primitives.Slot
+}
+
+// choose returns the bid chosen by the policy. Bids are considered from the highest value down, so that only the
+// signatures of the bids up to the chosen one are verified.
+func (p *BidPolicy) choose(bids []*relayBid, validate BidValidator) (*relayBid, error) {
+	minBid := new(big.Int).SetUint64(p.MinBid)
+	candidates := make([]*relayBid, 0, len(bids))
+	for _, b := range bids {
+		if b.value.Cmp(minBid) < 0 {
+			log.WithFields(log.Fields{
+				"endpoint": b.relay.NodeURL(),
+				"value":    b.value.String(),
+				"minBid":   p.MinBid,
+			}).Debug("Ignoring builder bid below the minimum bid")
+			continue
+		}
+		candidates = append(candidates, b)
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no builder bid of at least %d shor", p.MinBid)
+	}
+	// Ties go to the relay configured first.
+	slices.SortStableFunc(candidates, func(a, b *relayBid) int {
+		return b.value.Cmp(a.value)
+	})
+	var err error
+	for _, b := range candidates {
+		if err = b.verify(validate); err == nil {
+			return b, nil
+		}
+		relayRequestErrors.WithLabelValues(b.relay.NodeURL(), "get_header").Inc()
+		log.WithError(err).WithFields(log.Fields{
+			"endpoint": b.relay.NodeURL(),
+			"value":    b.value.String(),
+		}).Warn("Ignoring invalid builder bid")
+	}
+	return nil, errors.Wrap(err, "no builder relay returned a valid bid")
+}
+
+// collectBids queries every relay for a header in parallel and returns their bids. Relays that do not answer before
+// the context deadline are ignored. An error is returned if no relay returned a bid.
+func (s *Service) collectBids(
+	ctx context.Context,
+	slot primitives.Slot,
+	parentHash [32]byte,
+	pubKey [field_params.MLDSA87PubkeyLength]byte,
+) ([]*relayBid, error) {
+	bids := make([]*relayBid, len(s.relays))
+	errs := make([]error, len(s.relays))
+	var wg sync.WaitGroup
+	for i, relay := range s.relays {
+		wg.Add(1)
+		go func(i int, relay builder.BuilderClient) {
+			defer wg.Done()
+			start := time.Now()
+			signedBid, err := relay.GetHeader(ctx, slot, parentHash, pubKey)
+			relayRequestLatency.WithLabelValues(relay.NodeURL(), "get_header").Observe(float64(time.Since(start).Milliseconds()))
+			if err == nil {
+				bids[i], err = newRelayBid(relay, signedBid)
+			}
+			if err != nil {
+				relayRequestErrors.WithLabelValues(relay.NodeURL(), "get_header").Inc()
+				log.WithError(err).WithField("endpoint", relay.NodeURL()).Debug("Could not get builder bid from relay")
+				errs[i] = err
+			}
+		}(i, relay)
+	}
+	wg.Wait()
+
+	valid := make([]*relayBid, 0, len(bids))
+	for _, b := range bids {
+		if b != nil {
+			valid = append(valid, b)
+		}
+	}
+	if len(valid) == 0 {
+		if len(errs) == 1 {
+			return nil, errs[0]
+		}
+		return nil, errors.New("no builder relay returned a valid bid")
+	}
+	return valid, nil
+}
+
+// newRelayBid reads the value and block hash of a bid from a relay.
+func newRelayBid(relay builder.BuilderClient, signedBid builder.SignedBid) (*relayBid, error) {
+	if signedBid == nil || signedBid.IsNil() {
+		return nil, errors.New("builder returned nil bid")
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid")
+	}
+	if bid == nil || bid.IsNil() {
+		return nil, errors.New("builder returned nil bid")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid header")
+	}
+	return &relayBid{
+		relay:     relay,
+		signedBid: signedBid,
+		value:     bytesutil.LittleEndianBytesToBigInt(bid.Value()),
+		blockHash: bytesutil.ToBytes32(header.BlockHash()),
+	}, nil
+}
+
+// verify checks the bid with the validation of the proposer, then its signature.
+func (b *relayBid) verify(validate BidValidator) error {
+	if validate != nil {
+		if err := validate(b.signedBid); err != nil {
+			return err
+		}
+	}
+	if err := verifyBidSignature(b.signedBid); err != nil {
+		return errors.Wrap(err, "could not validate builder signature")
+	}
+	return nil
+}
+
+// verifyBidSignature returns an error if the signature of the builder bid is invalid.
+func verifyBidSignature(signedBid builder.SignedBid) error {
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+	if err != nil {
+		return err
+	}
+	if signedBid == nil || signedBid.IsNil() {
+		return errors.New("nil builder bid")
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return errors.Wrap(err, "could not get bid")
+	}
+	if bid == nil || bid.IsNil() {
+		return errors.New("builder returned nil bid")
+	}
+	return signing.VerifySigningRoot(bid, bid.Pubkey(), signedBid.Signature(), d)
+}
+
+// recordWinner remembers the relay whose bid was chosen, so that the blinded block is only submitted to it, and
+// forgets the winners of slots older than an epoch.
+func (s *Service) recordWinner(slot primitives.Slot, b *relayBid) {
+	relayBidWins.WithLabelValues(b.relay.NodeURL()).Inc()
+	log.WithFields(log.Fields{
+		"endpoint": b.relay.NodeURL(),
+		"value":    b.value.String(),
+		"slot":     slot,
+	}).Debug("Chose builder bid")
+
+	s.winnersLock.Lock()
+	defer s.winnersLock.Unlock()
+	for hash, w := range s.winners {
+		if w.slot+params.BeaconConfig().SlotsPerEpoch < slot {
+			delete(s.winners, hash)
+		}
+	}
+	s.winners[b.blockHash] = &winningBid{relay: b.relay, slot: slot}
+}
+
+// winningRelay returns the relay whose bid was chosen for the payload of the block. With a single relay, the
+// block is submitted to it even if its bid was not recorded, e.g. after a restart.
+func (s *Service) winningRelay(b interfaces.ReadOnlySignedBeaconBlock) (builder.BuilderClient, error) {
+	if b == nil || b.IsNil() {
+		return nil, errors.New("nil block")
+	}
+	payload, err := b.Block().Body().Execution()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get execution header")
+	}
+	s.winnersLock.Lock()
+	w, ok := s.winners[bytesutil.ToBytes32(payload.BlockHash())]
+	s.winnersLock.Unlock()
+	if ok {
+		return w.relay, nil
+	}
+	if len(s.relays) == 1 {
+		return s.relays[0], nil
+	}
+	return nil, fmt.Errorf("no relay bid for payload with block hash %#x", payload.BlockHash())
+}
+
+// registerWithRelays registers the validators with every relay in parallel. An error is returned only if no relay
+// accepted the registrations.
+func (s *Service) registerWithRelays(ctx context.Context, reg []*qrysmpb.SignedValidatorRegistrationV1) error {
+	errs := make([]error, len(s.relays))
+	var wg sync.WaitGroup
+	for i, relay := range s.relays {
+		wg.Add(1)
+		go func(i int, relay builder.BuilderClient) {
+			defer wg.Done()
+			start := time.Now()
+			err := relay.RegisterValidator(ctx, reg)
+			relayRequestLatency.WithLabelValues(relay.NodeURL(), "register_validator").Observe(float64(time.Since(start).Milliseconds()))
+			if err != nil {
+				relayRequestErrors.WithLabelValues(relay.NodeURL(), "register_validator").Inc()
+				log.WithError(err).WithField("endpoint", relay.NodeURL()).Warn("Could not register validators with relay")
+				errs[i] = err
+			}
+		}(i, relay)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err == nil {
+			return nil
+		}
+	}
+	return errs[0]
+}
@@ -0,0 +1,204 @@
+package builder
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/theQRL/qrysm/api/client/builder"
+	"github.com/theQRL/qrysm/beacon-chain/core/signing"
+	field_params "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/blocks"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/crypto/ml_dsa_87"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	v1 "github.com/theQRL/qrysm/proto/engine/v1"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+type fakeRelay struct {
+	url       string
+	bid       builder.SignedBid
+	err       error
+	submitted int
+}
+
+func (r *fakeRelay) NodeURL() string {
+	return r.url
+}
+
+func (r *fakeRelay) GetHeader(context.Context, primitives.Slot, [32]byte, [field_params.MLDSA87PubkeyLength]byte) (builder.SignedBid, error) {
+	return r.bid, r.err
+}
+
+func (*fakeRelay) RegisterValidator(context.Context, []*qrysmpb.SignedValidatorRegistrationV1) error {
+	return nil
+}
+
+func (r *fakeRelay) SubmitBlindedBlock(context.Context, interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, error) {
+	r.submitted++
+	return nil, nil
+}
+
+func (*fakeRelay) Status(context.Context) error {
+	return nil
+}
+
+func signedBid(t *testing.T, value int64, blockHash byte, validSignature bool) builder.SignedBid {
+	sk, err := ml_dsa_87.RandKey()
+	require.NoError(t, err)
+	bid := &qrysmpb.BuilderBidZond{
+		Header: &v1.ExecutionPayloadHeaderZond{
+			FeeRecipient:     make([]byte, field_params.FeeRecipientLength),
+			StateRoot:        make([]byte, field_params.RootLength),
+			ReceiptsRoot:     make([]byte, field_params.RootLength),
+			LogsBloom:        make([]byte, field_params.LogsBloomLength),
+			PrevRandao:       make([]byte, field_params.RootLength),
+			BaseFeePerGas:    make([]byte, field_params.RootLength),
+			BlockHash:        bytesutil.PadTo([]byte{blockHash}, field_params.RootLength),
+			TransactionsRoot: make([]byte, field_params.RootLength),
+			ParentHash:       make([]byte, field_params.RootLength),
+			WithdrawalsRoot:  make([]byte, field_params.RootLength),
+		},
+		Pubkey: sk.PublicKey().Marshal(),
+		Value:  bytesutil.PadTo(bytesutil.ReverseByteOrder(big.NewInt(value).Bytes()), 32),
+	}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, domain)
+	require.NoError(t, err)
+	if !validSignature {
+		sr[0] ^= 1
+	}
+	sBid, err := builder.WrappedSignedBuilderBidZond(&qrysmpb.SignedBuilderBidZond{Message: bid, Signature: sk.Sign(sr[:]).Marshal()})
+	require.NoError(t, err)
+	return sBid
+}
+
+func blindedBlock(t *testing.T, blockHash byte) interfaces.ReadOnlySignedBeaconBlock {
+	b := util.NewBlindedBeaconBlockZond()
+	b.Block.Body.ExecutionPayloadHeader.BlockHash = bytesutil.PadTo([]byte{blockHash}, field_params.RootLength)
+	wb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return wb
+}
+
+func TestService_GetHeader_MultipleRelays(t *testing.T) {
+	ctx := context.Background()
+	low := &fakeRelay{url: "low", bid: signedBid(t, 10, 1, true)}
+	high := &fakeRelay{url: "high", bid: signedBid(t, 30, 2, true)}
+	badSignature := &fakeRelay{url: "bad-signature", bid: signedBid(t, 50, 3, false)}
+	down := &fakeRelay{url: "down", err: errors.New("connection refused")}
+	s, err := NewService(ctx,
+		WithBuilderClient(low), WithBuilderClient(high), WithBuilderClient(badSignature), WithBuilderClient(down))
+	require.NoError(t, err)
+
+	sBid, err := s.GetHeader(ctx, 1, [32]byte{}, [field_params.MLDSA87PubkeyLength]byte{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, high.bid, sBid)
+
+	// The blinded block is only submitted to the relay whose bid was chosen.
+	_, err = s.SubmitBlindedBlock(ctx, blindedBlock(t, 2))
+	require.NoError(t, err)
+	assert.Equal(t, 1, high.submitted)
+	assert.Equal(t, 0, low.submitted)
+	_, err = s.SubmitBlindedBlock(ctx, blindedBlock(t, 1))
+	assert.ErrorContains(t, "no relay bid for payload", err)
+}
+
+func TestService_GetHeader_MinBid(t *testing.T) {
+	ctx := context.Background()
+	relay := &fakeRelay{url: "relay", bid: signedBid(t, 10, 1, true)}
+	s, err := NewService(ctx, WithBuilderClient(relay), WithBidPolicy(&BidPolicy{MinBid: 11}))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [field_params.MLDSA87PubkeyLength]byte{}, nil)
+	assert.ErrorContains(t, "no builder bid of at least 11 shor", err)
+
+	s.cfg.bidPolicy.MinBid = 10
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [field_params.MLDSA87PubkeyLength]byte{}, nil)
+	require.NoError(t, err)
+}
+
+func TestService_GetHeader_AllRelaysFail(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewService(ctx,
+		WithBuilderClient(&fakeRelay{url: "a", err: errors.New("connection refused")}),
+		WithBuilderClient(&fakeRelay{url: "b", bid: signedBid(t, 10, 1, false)}))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [field_params.MLDSA87PubkeyLength]byte{}, nil)
+	assert.ErrorContains(t, "no builder relay returned a valid bid", err)
+}
+
+func TestService_GetHeader_InvalidHighestBid(t *testing.T) {
+	ctx := context.Background()
+	low := &fakeRelay{url: "low", bid: signedBid(t, 10, 1, true)}
+	high := &fakeRelay{url: "high", bid: signedBid(t, 30, 2, true)}
+	s, err := NewService(ctx, WithBuilderClient(low), WithBuilderClient(high))
+	require.NoError(t, err)
+
+	// The proposer rejects the highest bid, so the next best valid bid is chosen instead of no bid at all.
+	validate := func(signedBid builder.SignedBid) error {
+		bid, err := signedBid.Message()
+		require.NoError(t, err)
+		header, err := bid.Header()
+		require.NoError(t, err)
+		if header.BlockHash()[0] == 2 {
+			return errors.New("incorrect timestamp")
+		}
+		return nil
+	}
+	sBid, err := s.GetHeader(ctx, 1, [32]byte{}, [field_params.MLDSA87PubkeyLength]byte{}, validate)
+	require.NoError(t, err)
+	assert.Equal(t, low.bid, sBid)
+
+	reject := func(builder.SignedBid) error {
+		return errors.New("incorrect timestamp")
+	}
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [field_params.MLDSA87PubkeyLength]byte{}, reject)
+	assert.ErrorContains(t, "incorrect timestamp", err)
+}
+
+func TestVerifyBidSignature(t *testing.T) {
+	sk, err := ml_dsa_87.RandKey()
+	require.NoError(t, err)
+	bid := &qrysmpb.BuilderBidZond{
+		Header: &v1.ExecutionPayloadHeaderZond{
+			ParentHash:       make([]byte, field_params.RootLength),
+			FeeRecipient:     make([]byte, field_params.FeeRecipientLength),
+			StateRoot:        make([]byte, field_params.RootLength),
+			ReceiptsRoot:     make([]byte, field_params.RootLength),
+			LogsBloom:        make([]byte, field_params.LogsBloomLength),
+			PrevRandao:       make([]byte, field_params.RootLength),
+			BaseFeePerGas:    make([]byte, field_params.RootLength),
+			BlockHash:        make([]byte, field_params.RootLength),
+			TransactionsRoot: make([]byte, field_params.RootLength),
+			BlockNumber:      1,
+			WithdrawalsRoot:  make([]byte, field_params.RootLength),
+		},
+		Pubkey: sk.PublicKey().Marshal(),
+		Value:  bytesutil.PadTo([]byte{1, 2, 3}, 32),
+	}
+	d := params.BeaconConfig().DomainApplicationBuilder
+	domain, err := signing.ComputeDomain(d, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, domain)
+	require.NoError(t, err)
+	pbBid := &qrysmpb.SignedBuilderBidZond{
+		Message:   bid,
+		Signature: sk.Sign(sr[:]).Marshal(),
+	}
+	sBid, err := builder.WrappedSignedBuilderBidZond(pbBid)
+	require.NoError(t, err)
+	require.NoError(t, verifyBidSignature(sBid))
+
+	pbBid.Message.Value = make([]byte, 32)
+	sBid, err = builder.WrappedSignedBuilderBidZond(pbBid)
+	require.NoError(t, err)
+	require.ErrorIs(t, verifyBidSignature(sBid), signing.ErrSigFailedToVerify)
+}
@@ -3,6 +3,7 @@ package builder
 import (
 	"context"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -23,10 +24,20 @@ import (
 // ErrNoBuilder is used when builder endpoint is not configured.
 var ErrNoBuilder = errors.New("builder endpoint not configured")
 
+// BidValidator checks a builder bid against the expectations of the proposer. A bid which fails the validation is
+// never chosen.
+type BidValidator func(builder.SignedBid) error
+
 // BlockBuilder defines the interface for interacting with the block builder
 type BlockBuilder interface {
 	SubmitBlindedBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, error)
-	GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [field_params.MLDSA87PubkeyLength]byte) (builder.SignedBid, error)
+	GetHeader(
+		ctx context.Context,
+		slot primitives.Slot,
+		parentHash [32]byte,
+		pubKey [field_params.MLDSA87PubkeyLength]byte,
+		validate BidValidator,
+	) (builder.SignedBid, error)
 	RegisterValidator(ctx context.Context, reg []*qrysmpb.SignedValidatorRegistrationV1) error
 	RegistrationByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (*qrysmpb.ValidatorRegistrationV1, error)
 	Configured() bool
@@ -34,45 +45,57 @@ type BlockBuilder interface {
 
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderClient builder.BuilderClient
-	beaconDB      db.HeadAccessDatabase
-	headFetcher   blockchain.HeadFetcher
+	builderClients []builder.BuilderClient
+	bidPolicy      *BidPolicy
+	beaconDB       db.HeadAccessDatabase
+	headFetcher    blockchain.HeadFetcher
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
 type Service struct {
 	cfg               *config
-	c                 builder.BuilderClient
+	relays            []builder.BuilderClient
 	ctx               context.Context
 	cancel            context.CancelFunc
 	registrationCache *cache.RegistrationCache
+	winnersLock       sync.Mutex
+	winners           map[[32]byte]*winningBid
 }
 
 // NewService instantiates a new service.
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    &config{},
+		ctx:     ctx,
+		cancel:  cancel,
+		cfg:     &config{},
+		winners: make(map[[32]byte]*winningBid),
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderClient != nil && !reflect.ValueOf(s.cfg.builderClient).IsNil() {
-		s.c = s.cfg.builderClient
+	if s.cfg.bidPolicy == nil {
+		s.cfg.bidPolicy = &BidPolicy{}
+	}
+	for _, c := range s.cfg.builderClients {
+		if c == nil || reflect.ValueOf(c).IsNil() {
+			continue
+		}
+		s.relays = append(s.relays, c)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			log.WithError(err).Error("Failed to check builder status")
+		if err := c.Status(ctx); err != nil {
+			log.WithError(err).WithField("endpoint", c.NodeURL()).Error("Failed to check builder status")
 		} else {
-			log.WithField("endpoint", s.c.NodeURL()).Info("Builder has been configured")
-			log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
-				"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+			log.WithField("endpoint", c.NodeURL()).Info("Builder has been configured")
 		}
 	}
+	if len(s.relays) > 0 {
+		log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
+			"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+	}
 	return s, nil
 }
 
@@ -87,7 +110,7 @@ func (s *Service) Stop() error {
 	return nil
 }
 
-// SubmitBlindedBlock submits a blinded block to the builder relay network.
+// SubmitBlindedBlock submits a blinded block to the relay whose bid was chosen for it.
 func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
 	defer span.End()
@@ -95,42 +118,71 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlyS
 	defer func() {
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return nil, ErrNoBuilder
 	}
 
-	return s.c.SubmitBlindedBlock(ctx, b)
+	relay, err := s.winningRelay(b)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+	relayStart := time.Now()
+	payload, err := relay.SubmitBlindedBlock(ctx, b)
+	relayRequestLatency.WithLabelValues(relay.NodeURL(), "submit_blinded_block").Observe(float64(time.Since(relayStart).Milliseconds()))
+	if err != nil {
+		relayRequestErrors.WithLabelValues(relay.NodeURL(), "submit_blinded_block").Inc()
+		tracing.AnnotateError(span, err)
+	}
+	return payload, err
 }
 
-// GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
-func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [field_params.MLDSA87PubkeyLength]byte) (builder.SignedBid, error) {
+// GetHeader retrieves the header for a given slot and parent hash from the builder relay network. Every relay is
+// queried in parallel and the bid chosen by the bid policy among the bids with a valid signature which pass the
+// given validation is returned.
+func (s *Service) GetHeader(
+	ctx context.Context,
+	slot primitives.Slot,
+	parentHash [32]byte,
+	pubKey [field_params.MLDSA87PubkeyLength]byte,
+	validate BidValidator,
+) (builder.SignedBid, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
 	defer span.End()
 	start := time.Now()
 	defer func() {
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		tracing.AnnotateError(span, ErrNoBuilder)
 		return nil, ErrNoBuilder
 	}
 
-	h, err := s.c.GetHeader(ctx, slot, parentHash, pubKey)
-	tracing.AnnotateError(span, err)
-	return h, err
+	bids, err := s.collectBids(ctx, slot, parentHash, pubKey)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+	best, err := s.cfg.bidPolicy.choose(bids, validate)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+	s.recordWinner(slot, best)
+	return best.signedBid, nil
 }
 
 // Status retrieves the status of the builder relay network.
 func (s *Service) Status() error {
 	// Return early if builder isn't initialized in service.
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return nil
 	}
 
 	return nil
 }
 
-// RegisterValidator registers a validator with the builder relay network.
+// RegisterValidator registers a validator with every relay of the builder relay network.
 // It also saves the registration object to the DB.
 func (s *Service) RegisterValidator(ctx context.Context, reg []*qrysmpb.SignedValidatorRegistrationV1) error {
 	ctx, span := trace.StartSpan(ctx, "builder.RegisterValidator")
@@ -139,7 +191,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*qrysmpb.SignedVa
 	defer func() {
 		registerValidatorLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return ErrNoBuilder
 	}
 
@@ -164,7 +216,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*qrysmpb.SignedVa
 		valid = append(valid, r)
 		indexToRegistration[nx] = r.Message
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
+	if err := s.registerWithRelays(ctx, valid); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
 
@@ -193,7 +245,7 @@ func (s *Service) RegistrationByValidatorID(ctx context.Context, id primitives.V
 
 // Configured returns true if the user has configured a builder client.
 func (s *Service) Configured() bool {
-	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
+	return len(s.relays) > 0
 }
 
 func (s *Service) pollRelayerStatus(ctx context.Context) {
@@ -202,9 +254,10 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
-					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
+			for _, relay := range s.relays {
+				if err := relay.Status(ctx); err != nil {
+					relayRequestErrors.WithLabelValues(relay.NodeURL(), "status").Inc()
+					log.WithError(err).WithField("endpoint", relay.NodeURL()).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
 				}
 			}
 		case <-ctx.Done():
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/api/client/builder"
+	beaconbuilder "github.com/theQRL/qrysm/beacon-chain/builder"
 	"github.com/theQRL/qrysm/beacon-chain/cache"
 	"github.com/theQRL/qrysm/beacon-chain/db"
 	field_params "github.com/theQRL/qrysm/config/fieldparams"
@@ -52,8 +53,19 @@ func (s *MockBuilderService) SubmitBlindedBlock(_ context.Context, b interfaces.
 }
 
 // GetHeader for mocking.
-func (s *MockBuilderService) GetHeader(_ context.Context, slot primitives.Slot, _ [32]byte, _ [field_params.MLDSA87PubkeyLength]byte) (builder.SignedBid, error) {
-	return builder.WrappedSignedBuilderBidZond(s.BidZond)
+func (s *MockBuilderService) GetHeader(
+	_ context.Context, _ primitives.Slot, _ [32]byte, _ [field_params.MLDSA87PubkeyLength]byte, validate beaconbuilder.BidValidator,
+) (builder.SignedBid, error) {
+	signedBid, err := builder.WrappedSignedBuilderBidZond(s.BidZond)
+	if err != nil {
+		return nil, err
+	}
+	if validate != nil {
+		if err := validate(signedBid); err != nil {
+			return nil, err
+		}
+	}
+	return signedBid, nil
 }
 
 // RegistrationByValidatorID returns either the values from the cache or db.
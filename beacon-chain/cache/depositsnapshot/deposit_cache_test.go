@@ -1190,3 +1190,59 @@ func BenchmarkDepositTree_HashTreeRootOldImplementation(b *testing.B) {
 		require.NoError(b, err)
 	}
 }
+
+func TestInitializeFromSnapshot(t *testing.T) {
+	ctx := context.Background()
+	deposits := make([]*qrysmpb.Deposit, 5)
+	var roots [][]byte
+	for i := range deposits {
+		deposits[i] = &qrysmpb.Deposit{
+			Data: &qrysmpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, field_params.MLDSA87PubkeyLength),
+				WithdrawalCredentials: make([]byte, 64),
+				Signature:             make([]byte, field_params.MLDSA87SignatureLength),
+			},
+		}
+		root, err := deposits[i].Data.HashTreeRoot()
+		require.NoError(t, err)
+		roots = append(roots, root[:])
+	}
+	// The snapshot covers the first three deposits.
+	tree := NewDepositTree()
+	for i := range 3 {
+		require.NoError(t, tree.Insert(roots[i], i))
+	}
+	require.NoError(t, tree.Finalize(2, [32]byte{'a'}, 10))
+	snapshot, err := tree.ToProto()
+	require.NoError(t, err)
+
+	dc, err := New()
+	require.NoError(t, err)
+	require.NoError(t, dc.InitializeFromSnapshot(ctx, snapshot))
+	require.ErrorContains(t, "cannot initialize a non-empty deposit cache", dc.InitializeFromSnapshot(ctx, snapshot))
+
+	n, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(15))
+	assert.Equal(t, uint64(3), n)
+	assert.DeepEqual(t, snapshot.DepositRoot, root[:])
+
+	require.ErrorContains(t, "wanted deposit with index 3", dc.InsertDeposit(ctx, deposits[0], 20, 0, [32]byte{}))
+	require.NoError(t, dc.InsertDeposit(ctx, deposits[3], 20, 3, [32]byte{'b'}))
+	require.NoError(t, dc.InsertDeposit(ctx, deposits[4], 21, 4, [32]byte{'c'}))
+	n, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(20))
+	assert.Equal(t, uint64(4), n)
+	assert.Equal(t, [32]byte{'b'}, root)
+
+	require.NoError(t, dc.InsertFinalizedDeposits(ctx, 3, [32]byte{'d'}, 20))
+	require.NoError(t, dc.PruneProofs(ctx, 3))
+	finalized, err := dc.FinalizedDeposits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), finalized.MerkleTrieIndex())
+	generatedTrie, err := trie.GenerateTrieFromItems(roots[:4], params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	wantedRoot, err := generatedTrie.HashTreeRoot()
+	require.NoError(t, err)
+	finalizedRoot, err := finalized.Deposits().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantedRoot, finalizedRoot)
+	assert.Equal(t, 1, len(dc.NonFinalizedDeposits(ctx, 3, nil)))
+}
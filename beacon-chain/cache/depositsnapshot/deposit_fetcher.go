@@ -31,7 +31,10 @@ type Cache struct {
 	deposits          []*qrysmpb.DepositContainer
 	finalizedDeposits finalizedDepositsContainer
 	depositsByKey     map[[field_params.MLDSA87PubkeyLength]byte][]*qrysmpb.DepositContainer
-	depositsLock      sync.RWMutex
+	// snapshot is the finalized snapshot the cache was initialized from, if any. The deposits it covers
+	// are not held in the cache.
+	snapshot     *DepositTreeSnapshot
+	depositsLock sync.RWMutex
 }
 
 // finalizedDepositsContainer stores the trie of deposits that have been included
@@ -135,9 +138,15 @@ func (c *Cache) DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *
 	// send the deposit root of the empty trie, if executionfollow distance is greater than the time of the earliest
 	// deposit.
 	if heightIdx == 0 {
+		// The deposits of the snapshot the cache was initialized from are finalized, so they are
+		// always below the requested height.
+		if c.snapshot != nil {
+			return c.snapshot.depositCount, c.snapshot.depositRoot
+		}
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(c.deposits[heightIdx-1].DepositRoot)
+	last := c.deposits[heightIdx-1]
+	return uint64(last.Index) + 1, bytesutil.ToBytes32(last.DepositRoot)
 }
 
 // FinalizedDeposits returns the finalized deposits trie.
@@ -186,11 +195,11 @@ func (c *Cache) PruneProofs(ctx context.Context, untilDepositIndex int64) error
 	c.depositsLock.Lock()
 	defer c.depositsLock.Unlock()
 
-	if untilDepositIndex >= int64(len(c.deposits)) {
-		untilDepositIndex = int64(len(c.deposits) - 1)
-	}
+	// The cache does not hold the deposits before the snapshot it was initialized from, so the position of
+	// a deposit is not necessarily its index.
+	pos := sort.Search(len(c.deposits), func(i int) bool { return c.deposits[i].Index > untilDepositIndex }) - 1
 
-	for i := untilDepositIndex; i >= 0; i-- {
+	for i := pos; i >= 0; i-- {
 		// Finding a nil proof means that all proofs up to this deposit have been already pruned.
 		if c.deposits[i].Deposit.Proof == nil {
 			break
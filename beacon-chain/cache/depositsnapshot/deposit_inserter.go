@@ -40,8 +40,8 @@ func (c *Cache) InsertDeposit(ctx context.Context, d *qrysmpb.Deposit, blockNum
 	c.depositsLock.Lock()
 	defer c.depositsLock.Unlock()
 
-	if int(index) != len(c.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(c.deposits), index)
+	if next := c.nextDepositIndex(); index != next {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", next, index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(c.deposits), func(i int) bool { return c.deposits[i].Index >= index })
@@ -99,8 +99,8 @@ func (c *Cache) InsertFinalizedDeposits(ctx context.Context, executionDepositInd
 	}
 	// In the event we have less deposits than we need to
 	// finalize we finalize till the index on which we do have it.
-	if len(c.deposits) <= int(executionDepositIndex) {
-		executionDepositIndex = int64(len(c.deposits)) - 1
+	if lastIndex := c.deposits[len(c.deposits)-1].Index; lastIndex < executionDepositIndex {
+		executionDepositIndex = lastIndex
 	}
 	// If we finalize to some lower deposit index, we
 	// ignore it.
@@ -132,3 +132,39 @@ func (c *Cache) InsertFinalizedDeposits(ctx context.Context, executionDepositInd
 	}
 	return nil
 }
+
+// InitializeFromSnapshot initializes an empty cache from a finalized deposit snapshot, such as the one downloaded
+// during checkpoint sync. The deposits covered by the snapshot are not held in the cache, so the next deposit
+// inserted is the one at the deposit count of the snapshot.
+func (c *Cache) InitializeFromSnapshot(ctx context.Context, snapshotProto *qrysmpb.DepositSnapshot) error {
+	_, span := trace.StartSpan(ctx, "Cache.InitializeFromSnapshot")
+	defer span.End()
+	c.depositsLock.Lock()
+	defer c.depositsLock.Unlock()
+
+	if len(c.deposits) != 0 || c.finalizedDeposits.merkleTrieIndex != -1 {
+		return errors.New("cannot initialize a non-empty deposit cache from a snapshot")
+	}
+	tree, err := DepositTreeFromSnapshotProto(snapshotProto)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit tree from snapshot")
+	}
+	snapshot, err := tree.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	c.finalizedDeposits = toFinalizedDepositsContainer(tree, int64(tree.depositCount)-1)
+	c.snapshot = &snapshot
+	return nil
+}
+
+// nextDepositIndex returns the index of the deposit expected to be inserted next.
+func (c *Cache) nextDepositIndex() int64 {
+	if len(c.deposits) == 0 {
+		if c.snapshot != nil {
+			return int64(c.snapshot.depositCount)
+		}
+		return 0
+	}
+	return c.deposits[len(c.deposits)-1].Index + 1
+}
@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	lruwrpr "github.com/theQRL/qrysm/cache/lru"
+	"github.com/theQRL/qrysm/crypto/hash"
+	"github.com/theQRL/qrysm/crypto/ml_dsa_87"
+)
+
+var (
+	// maxVerifiedSignaturesSize defines the max number of signatures the verified signature cache can contain.
+	// Every active validator attests once per epoch, so this covers a couple of epochs worth of attestations
+	// of a large validator set, which is the window in which gossip attestations get included in blocks.
+	maxVerifiedSignaturesSize = 1 << 18
+
+	// Metrics.
+	verifiedSignatureMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "verified_signature_cache_miss_total",
+		Help: "The number of signatures looked up in the verified signature cache that had to be verified again.",
+	})
+	verifiedSignatureHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "verified_signature_cache_hit_total",
+		Help: "The number of signatures whose verification was skipped because they were already verified.",
+	})
+)
+
+// VerifiedSignatures records the signatures verified on gossip, so that they are not verified again when
+// the attestations that carry them are included in a block.
+var VerifiedSignatures = NewVerifiedSignatureCache()
+
+// verifiedSignatureKey identifies a verified signature. ML-DSA-87 public keys and signatures are large, so
+// their hashes are used instead. The signature is part of the key, so that a different signature of the
+// same message by the same key is never considered verified.
+type verifiedSignatureKey struct {
+	pubKey  [32]byte
+	msg     [32]byte
+	sigHash [32]byte
+}
+
+// VerifiedSignatureCache is a bounded LRU of signatures that were verified successfully.
+type VerifiedSignatureCache struct {
+	cache *lru.Cache
+	lock  sync.RWMutex // Guards the cache being replaced by Clear, the LRU itself is thread safe.
+}
+
+var _ ml_dsa_87.VerifiedSignatures = (*VerifiedSignatureCache)(nil)
+
+// NewVerifiedSignatureCache creates a new verified signature cache.
+func NewVerifiedSignatureCache() *VerifiedSignatureCache {
+	c := &VerifiedSignatureCache{}
+	c.Clear()
+	return c
+}
+
+// Clear resets the VerifiedSignatureCache to its initial state.
+func (c *VerifiedSignatureCache) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache = lruwrpr.New(maxVerifiedSignaturesSize)
+}
+
+// Verified returns true if the signature of msg by the public key was verified successfully before.
+func (c *VerifiedSignatureCache) Verified(pubKey ml_dsa_87.PublicKey, msg [32]byte, sig []byte) bool {
+	key := verifiedKey(pubKey, msg, sig)
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if _, ok := c.cache.Get(key); ok {
+		verifiedSignatureHit.Inc()
+		return true
+	}
+	verifiedSignatureMiss.Inc()
+	return false
+}
+
+// MarkVerified records that the signature of msg by the public key was verified successfully.
+func (c *VerifiedSignatureCache) MarkVerified(pubKey ml_dsa_87.PublicKey, msg [32]byte, sig []byte) {
+	key := verifiedKey(pubKey, msg, sig)
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	c.cache.Add(key, struct{}{})
+}
+
+// Invalidate removes the signatures of the batch from the cache, including the ones the batch skipped
+// because they were cached. It is used when a batch turns out to be invalid, so that none of the
+// signatures it relied on is skipped on a later verification.
+func (c *VerifiedSignatureCache) Invalidate(set *ml_dsa_87.SignatureBatch) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	for ; set != nil; set = set.Skipped {
+		for i, msg := range set.Messages {
+			for j, sig := range set.Signatures[i] {
+				c.cache.Remove(verifiedKey(set.PublicKeys[i][j], msg, sig))
+			}
+		}
+	}
+}
+
+func verifiedKey(pubKey ml_dsa_87.PublicKey, msg [32]byte, sig []byte) verifiedSignatureKey {
+	return verifiedSignatureKey{
+		pubKey:  hash.Hash(pubKey.Marshal()),
+		msg:     msg,
+		sigHash: hash.Hash(sig),
+	}
+}
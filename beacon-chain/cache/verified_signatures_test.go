@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/theQRL/qrysm/crypto/ml_dsa_87"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func TestVerifiedSignatureCache(t *testing.T) {
+	c := NewVerifiedSignatureCache()
+	key, err := ml_dsa_87.RandKey()
+	require.NoError(t, err)
+	msg := [32]byte{'a'}
+	sig := key.Sign(msg[:]).Marshal()
+	otherMsg := [32]byte{'b'}
+	otherSig := key.Sign(otherMsg[:]).Marshal()
+
+	assert.Equal(t, false, c.Verified(key.PublicKey(), msg, sig), "Expected signature not to be verified in empty cache")
+	c.MarkVerified(key.PublicKey(), msg, sig)
+	assert.Equal(t, true, c.Verified(key.PublicKey(), msg, sig))
+	assert.Equal(t, false, c.Verified(key.PublicKey(), msg, otherSig), "Expected another signature of the message not to be verified")
+	assert.Equal(t, false, c.Verified(key.PublicKey(), otherMsg, sig), "Expected the signature of another message not to be verified")
+
+	set := &ml_dsa_87.SignatureBatch{
+		Signatures:   [][][]byte{{sig}, {otherSig}},
+		PublicKeys:   [][]ml_dsa_87.PublicKey{{key.PublicKey()}, {key.PublicKey()}},
+		Messages:     [][32]byte{msg, otherMsg},
+		Descriptions: []string{"a", "b"},
+	}
+	set.MarkVerified(c)
+	assert.Equal(t, true, c.Verified(key.PublicKey(), otherMsg, otherSig))
+
+	c.Invalidate(set)
+	assert.Equal(t, false, c.Verified(key.PublicKey(), msg, sig), "Expected signature to be invalidated")
+	assert.Equal(t, false, c.Verified(key.PublicKey(), otherMsg, otherSig), "Expected signature to be invalidated")
+}
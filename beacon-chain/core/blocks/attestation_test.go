@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/theQRL/go-bitfield"
+	"github.com/theQRL/qrysm/beacon-chain/cache"
 	"github.com/theQRL/qrysm/beacon-chain/core/blocks"
 	"github.com/theQRL/qrysm/beacon-chain/core/helpers"
 	"github.com/theQRL/qrysm/beacon-chain/core/signing"
@@ -558,3 +559,64 @@ func TestRetrieveAttestationSignatureSet_AcrossFork(t *testing.T) {
 	_, err = blocks.AttestationSignatureBatch(ctx, st, []*qrysmpb.Attestation{att1, att2})
 	require.NoError(t, err)
 }
+
+func TestBlockAttestationSignatureBatch_SkipsVerifiedSignatures(t *testing.T) {
+	ctx := context.Background()
+	numOfValidators := uint64(params.BeaconConfig().SlotsPerEpoch.Mul(4))
+	validators := make([]*qrysmpb.Validator, numOfValidators)
+	_, keys, err := util.DeterministicDepositsAndKeys(numOfValidators)
+	require.NoError(t, err)
+	for i := range validators {
+		validators[i] = &qrysmpb.Validator{
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			PublicKey:             keys[i].PublicKey().Marshal(),
+			WithdrawalCredentials: make([]byte, 64),
+		}
+	}
+
+	st, err := util.NewBeaconStateZond()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(5))
+	require.NoError(t, st.SetValidators(validators))
+
+	domain, err := signing.Domain(st.Fork(), st.Fork().Epoch, params.BeaconConfig().DomainBeaconAttester, st.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	atts := make([]*qrysmpb.Attestation, 2)
+	for i := range atts {
+		comm, err := helpers.BeaconCommitteeFromState(ctx, st, 1 /*slot*/, primitives.CommitteeIndex(i))
+		require.NoError(t, err)
+		atts[i] = util.HydrateAttestation(&qrysmpb.Attestation{
+			AggregationBits: bitfield.NewBitlist(uint64(len(comm))),
+			Data: &qrysmpb.AttestationData{
+				Slot:           1,
+				CommitteeIndex: primitives.CommitteeIndex(i),
+			},
+		})
+		root, err := signing.ComputeSigningRoot(atts[i].Data, domain)
+		require.NoError(t, err)
+		for j, u := range comm {
+			atts[i].AggregationBits.SetBitAt(uint64(j), true)
+			atts[i].Signatures = append(atts[i].Signatures, keys[u].Sign(root[:]).Marshal())
+		}
+	}
+
+	cache.VerifiedSignatures.Clear()
+	t.Cleanup(cache.VerifiedSignatures.Clear)
+	gossipSet, err := blocks.AttestationSignatureBatch(ctx, st, atts[:1])
+	require.NoError(t, err)
+	gossipSet.MarkVerified(cache.VerifiedSignatures)
+
+	set, err := blocks.BlockAttestationSignatureBatch(ctx, st, atts)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(set.Messages))
+	assert.Equal(t, len(atts[1].Signatures), len(set.Signatures[0]))
+	verified, err := set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, true, verified)
+
+	// Signatures that were not verified before are all kept.
+	cache.VerifiedSignatures.Clear()
+	set, err = blocks.BlockAttestationSignatureBatch(ctx, st, atts)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(set.Messages))
+}
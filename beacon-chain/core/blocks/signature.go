@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/beacon-chain/cache"
 	"github.com/theQRL/qrysm/beacon-chain/core/helpers"
 	"github.com/theQRL/qrysm/beacon-chain/core/signing"
 	"github.com/theQRL/qrysm/beacon-chain/state"
@@ -301,3 +302,14 @@ func AttestationSignatureBatch(ctx context.Context, beaconState state.ReadOnlyBe
 
 	return set, nil
 }
+
+// BlockAttestationSignatureBatch retrieves the signature batch of the attestations included in a block like
+// AttestationSignatureBatch does, leaving out the signatures that were already verified on gossip.
+func BlockAttestationSignatureBatch(ctx context.Context, beaconState state.ReadOnlyBeaconState, atts []*qrysmpb.Attestation) (*ml_dsa_87.SignatureBatch, error) {
+	set, err := AttestationSignatureBatch(ctx, beaconState, atts)
+	if err != nil {
+		return nil, err
+	}
+	_, set = set.RemoveVerified(cache.VerifiedSignatures)
+	return set, nil
+}
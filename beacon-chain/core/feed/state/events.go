@@ -23,6 +23,10 @@ const (
 	NewHead
 	// MissedSlot is sent when we need to notify users that a slot was missed.
 	MissedSlot
+	// LightClientFinalityUpdate is sent when the light client server has a new finality update.
+	LightClientFinalityUpdate
+	// LightClientOptimisticUpdate is sent when the light client server has a new optimistic update.
+	LightClientOptimisticUpdate
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
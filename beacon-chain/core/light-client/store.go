@@ -0,0 +1,299 @@
+package lightclient
+
+import (
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/beacon-chain/blockchain"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/crypto/ml_dsa_87"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+)
+
+// Store is the state of a light client, following the sync committees of the chain from a trusted bootstrap.
+// It is not safe for concurrent use.
+type Store struct {
+	// FinalizedHeader is the header that was finalized according to the latest applied update.
+	FinalizedHeader *qrlpb.BeaconBlockHeader
+	// CurrentSyncCommittee is the sync committee of the period of the finalized header.
+	CurrentSyncCommittee *qrlpb.SyncCommittee
+	// NextSyncCommittee is the sync committee of the period after the finalized header, when known.
+	NextSyncCommittee *qrlpb.SyncCommittee
+	// BestValidUpdate is the best update seen for the current period, applied when no finality is reached in time.
+	BestValidUpdate *qrlpb.LightClientUpdate
+	// OptimisticHeader is the most recent header signed by enough of the sync committee.
+	OptimisticHeader *qrlpb.BeaconBlockHeader
+	// PreviousMaxActiveParticipants is the highest participation seen in the previous period.
+	PreviousMaxActiveParticipants uint64
+	// CurrentMaxActiveParticipants is the highest participation seen in the current period.
+	CurrentMaxActiveParticipants uint64
+
+	genesisValidatorsRoot [32]byte
+	// The public keys of the sync committees are parsed once, when a committee enters the store, rather than
+	// for each update.
+	currentSyncCommitteeKeys []ml_dsa_87.PublicKey
+	nextSyncCommitteeKeys    []ml_dsa_87.PublicKey
+}
+
+// NewStore - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/sync-protocol.md#initialize_light_client_store
+// The bootstrap is only trusted when the root of its header matches the trusted block root.
+func NewStore(trustedBlockRoot [32]byte, bootstrap *qrlpb.LightClientBootstrap, genesisValidatorsRoot [32]byte) (*Store, error) {
+	if bootstrap == nil || bootstrap.Header == nil || bootstrap.CurrentSyncCommittee == nil {
+		return nil, errors.Wrap(ErrInvalidBootstrap, "nil bootstrap")
+	}
+	headerRoot, err := bootstrap.Header.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute bootstrap header root")
+	}
+	if headerRoot != trustedBlockRoot {
+		return nil, errors.Wrapf(ErrInvalidBootstrap, "header root %#x does not match trusted block root %#x", headerRoot, trustedBlockRoot)
+	}
+	committeeRoot, err := bootstrap.CurrentSyncCommittee.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute sync committee root")
+	}
+	if !VerifyBranch(bootstrap.Header.StateRoot, committeeRoot, bootstrap.CurrentSyncCommitteeBranch, qrlpb.CurrentSyncCommitteeIndex) {
+		return nil, errors.Wrap(ErrInvalidBootstrap, "invalid current sync committee branch")
+	}
+	keys, err := committeeKeys(bootstrap.CurrentSyncCommittee)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidBootstrap, err.Error())
+	}
+	return &Store{
+		FinalizedHeader:          bootstrap.Header,
+		CurrentSyncCommittee:     bootstrap.CurrentSyncCommittee,
+		OptimisticHeader:         bootstrap.Header,
+		genesisValidatorsRoot:    genesisValidatorsRoot,
+		currentSyncCommitteeKeys: keys,
+	}, nil
+}
+
+// IsNextSyncCommitteeKnown reports whether the store knows the sync committee of the next period.
+func (s *Store) IsNextSyncCommitteeKnown() bool {
+	return s.NextSyncCommittee != nil
+}
+
+// ValidateUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/sync-protocol.md#validate_light_client_update
+// The cheap structural and Merkle branch checks are done before any signature is verified.
+func (s *Store) ValidateUpdate(update *qrlpb.LightClientUpdate, currentSlot primitives.Slot) error {
+	if update == nil || update.AttestedHeader == nil || update.SyncAggregate == nil {
+		return errors.Wrap(ErrInvalidUpdate, "nil update")
+	}
+	finalizedHeader := update.FinalizedHeader
+	if finalizedHeader == nil {
+		finalizedHeader = &qrlpb.BeaconBlockHeader{}
+	}
+
+	// Verify sync committee has sufficient participants
+	participants := update.SyncAggregate.SyncCommitteeBits.Count()
+	if participants < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return errors.Wrapf(ErrInvalidUpdate, "%d sync committee participants, need at least %d", participants, params.BeaconConfig().MinSyncCommitteeParticipants)
+	}
+
+	// Verify update does not skip a sync committee period
+	if currentSlot < update.SignatureSlot ||
+		update.SignatureSlot <= update.AttestedHeader.Slot ||
+		update.AttestedHeader.Slot < finalizedHeader.Slot {
+		return errors.Wrapf(ErrInvalidUpdate, "slots out of order: current %d, signature %d, attested %d, finalized %d",
+			currentSlot, update.SignatureSlot, update.AttestedHeader.Slot, finalizedHeader.Slot)
+	}
+	storePeriod := periodAtSlot(s.FinalizedHeader.Slot)
+	signaturePeriod := periodAtSlot(update.SignatureSlot)
+	if s.IsNextSyncCommitteeKnown() {
+		if signaturePeriod != storePeriod && signaturePeriod != storePeriod+1 {
+			return errors.Wrapf(ErrInvalidUpdate, "signature period %d is not within one period of store period %d", signaturePeriod, storePeriod)
+		}
+	} else if signaturePeriod != storePeriod {
+		return errors.Wrapf(ErrInvalidUpdate, "signature period %d is not the store period %d", signaturePeriod, storePeriod)
+	}
+
+	// Verify update is relevant
+	attestedPeriod := periodAtSlot(update.AttestedHeader.Slot)
+	hasNextSyncCommittee := !s.IsNextSyncCommitteeKnown() && update.IsSyncCommiteeUpdate() && attestedPeriod == storePeriod
+	if update.AttestedHeader.Slot <= s.FinalizedHeader.Slot && !hasNextSyncCommittee {
+		return errors.Wrap(ErrInvalidUpdate, "update is not relevant")
+	}
+
+	// Verify that the finality branch, if present, confirms finalized header
+	// to match the finalized checkpoint root saved in the state of attested header.
+	// Note that the genesis finalized checkpoint root is represented as a zero hash.
+	if !update.IsFinalityUpdate() {
+		if !isEmptyHeader(update.FinalizedHeader) {
+			return errors.Wrap(ErrInvalidUpdate, "finalized header without finality branch")
+		}
+	} else {
+		var finalizedRoot [32]byte
+		if finalizedHeader.Slot == params.BeaconConfig().GenesisSlot {
+			if !isEmptyHeader(finalizedHeader) {
+				return errors.Wrap(ErrInvalidUpdate, "genesis finalized header is not empty")
+			}
+		} else {
+			root, err := finalizedHeader.HashTreeRoot()
+			if err != nil {
+				return errors.Wrap(err, "could not compute finalized header root")
+			}
+			finalizedRoot = root
+		}
+		if !VerifyBranch(update.AttestedHeader.StateRoot, finalizedRoot, update.FinalityBranch, qrlpb.FinalizedRootIndex) {
+			return errors.Wrap(ErrInvalidUpdate, "invalid finality branch")
+		}
+	}
+
+	// Verify that the next sync committee, if present, actually is the next sync committee saved in the
+	// state of the attested header
+	if !update.IsSyncCommiteeUpdate() {
+		if !isEmptySyncCommittee(update.NextSyncCommittee) {
+			return errors.Wrap(ErrInvalidUpdate, "next sync committee without branch")
+		}
+	} else {
+		if update.NextSyncCommittee == nil {
+			return errors.Wrap(ErrInvalidUpdate, "nil next sync committee")
+		}
+		if attestedPeriod == storePeriod && s.IsNextSyncCommitteeKnown() && !s.NextSyncCommittee.Equals(update.NextSyncCommittee) {
+			return errors.Wrap(ErrInvalidUpdate, "next sync committee does not match the known one")
+		}
+		committeeRoot, err := update.NextSyncCommittee.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not compute next sync committee root")
+		}
+		if !VerifyBranch(update.AttestedHeader.StateRoot, committeeRoot, update.NextSyncCommitteeBranch, qrlpb.NextSyncCommitteeIndex) {
+			return errors.Wrap(ErrInvalidUpdate, "invalid next sync committee branch")
+		}
+	}
+
+	// Verify sync committee signatures
+	keys := s.currentSyncCommitteeKeys
+	if signaturePeriod != storePeriod {
+		keys = s.nextSyncCommitteeKeys
+	}
+	return VerifySyncAggregate(keys, update.SyncAggregate, update.AttestedHeader, update.SignatureSlot, s.genesisValidatorsRoot[:])
+}
+
+// ProcessUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/sync-protocol.md#process_light_client_update
+func (s *Store) ProcessUpdate(update *qrlpb.LightClientUpdate, currentSlot primitives.Slot) error {
+	if err := s.ValidateUpdate(update, currentSlot); err != nil {
+		return err
+	}
+	bits := update.SyncAggregate.SyncCommitteeBits
+
+	// Update the best update in case we have to force-update to it if the timeout elapses
+	if s.BestValidUpdate == nil || blockchain.IsBetterUpdate(update, s.BestValidUpdate) {
+		s.BestValidUpdate = update
+	}
+
+	// Track the maximum number of active participants in the committee signatures
+	s.CurrentMaxActiveParticipants = max(s.CurrentMaxActiveParticipants, bits.Count())
+
+	// Update the optimistic header
+	if bits.Count() > s.safetyThreshold() && update.AttestedHeader.Slot > s.OptimisticHeader.Slot {
+		s.OptimisticHeader = update.AttestedHeader
+	}
+
+	// Update finalized header
+	hasFinalizedNextSyncCommittee := !s.IsNextSyncCommitteeKnown() &&
+		update.IsSyncCommiteeUpdate() && update.IsFinalityUpdate() &&
+		periodAtSlot(update.FinalizedHeader.GetSlot()) == periodAtSlot(update.AttestedHeader.Slot)
+	if bits.Count()*3 >= bits.Len()*2 &&
+		(update.FinalizedHeader.GetSlot() > s.FinalizedHeader.Slot || hasFinalizedNextSyncCommittee) {
+		// Normal update through 2/3 threshold
+		if err := s.applyUpdate(update); err != nil {
+			return err
+		}
+		s.BestValidUpdate = nil
+	}
+	return nil
+}
+
+// ProcessFinalityUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/sync-protocol.md#process_light_client_finality_update
+func (s *Store) ProcessFinalityUpdate(update *qrlpb.LightClientFinalityUpdate, currentSlot primitives.Slot) error {
+	if update == nil {
+		return errors.Wrap(ErrInvalidUpdate, "nil finality update")
+	}
+	return s.ProcessUpdate(NewUpdateFromFinalityUpdate(update), currentSlot)
+}
+
+// ProcessOptimisticUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/sync-protocol.md#process_light_client_optimistic_update
+func (s *Store) ProcessOptimisticUpdate(update *qrlpb.LightClientOptimisticUpdate, currentSlot primitives.Slot) error {
+	if update == nil {
+		return errors.Wrap(ErrInvalidUpdate, "nil optimistic update")
+	}
+	return s.ProcessUpdate(NewUpdateFromOptimisticUpdate(update), currentSlot)
+}
+
+// ProcessForceUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/sync-protocol.md#process_light_client_store_force_update
+// When no finality was reached for a whole sync committee period, the best valid update is applied, which lets
+// the store move on to the next sync committee.
+func (s *Store) ProcessForceUpdate(currentSlot primitives.Slot) error {
+	updateTimeout := primitives.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	if currentSlot <= s.FinalizedHeader.Slot+updateTimeout || s.BestValidUpdate == nil {
+		return nil
+	}
+	// Forced best update when the update timeout has elapsed.
+	// Because the apply logic waits for `finalized_header.slot` to indicate sync committee finality,
+	// the `attested_header` may be treated as `finalized_header` in extended periods of non-finality
+	// to guarantee progression into later sync committee periods according to `is_better_update`.
+	update := s.BestValidUpdate
+	if update.FinalizedHeader.GetSlot() <= s.FinalizedHeader.Slot {
+		update.FinalizedHeader = update.AttestedHeader
+	}
+	if err := s.applyUpdate(update); err != nil {
+		return err
+	}
+	s.BestValidUpdate = nil
+	return nil
+}
+
+// applyUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/sync-protocol.md#apply_light_client_update
+func (s *Store) applyUpdate(update *qrlpb.LightClientUpdate) error {
+	storePeriod := periodAtSlot(s.FinalizedHeader.Slot)
+	finalizedPeriod := periodAtSlot(update.FinalizedHeader.GetSlot())
+	if !s.IsNextSyncCommitteeKnown() {
+		if finalizedPeriod != storePeriod {
+			return errors.Wrapf(ErrInvalidUpdate, "finalized period %d is not the store period %d", finalizedPeriod, storePeriod)
+		}
+		if err := s.setNextSyncCommittee(update.NextSyncCommittee); err != nil {
+			return err
+		}
+	} else if finalizedPeriod == storePeriod+1 {
+		s.CurrentSyncCommittee = s.NextSyncCommittee
+		s.currentSyncCommitteeKeys = s.nextSyncCommitteeKeys
+		if err := s.setNextSyncCommittee(update.NextSyncCommittee); err != nil {
+			return err
+		}
+		s.PreviousMaxActiveParticipants = s.CurrentMaxActiveParticipants
+		s.CurrentMaxActiveParticipants = 0
+	}
+	if update.FinalizedHeader.GetSlot() > s.FinalizedHeader.Slot {
+		s.FinalizedHeader = update.FinalizedHeader
+		if s.FinalizedHeader.Slot > s.OptimisticHeader.Slot {
+			s.OptimisticHeader = s.FinalizedHeader
+		}
+	}
+	return nil
+}
+
+// safetyThreshold - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/sync-protocol.md#get_safety_threshold
+func (s *Store) safetyThreshold() uint64 {
+	return max(s.PreviousMaxActiveParticipants, s.CurrentMaxActiveParticipants) / 2
+}
+
+// GenesisValidatorsRoot returns the genesis validators root the store verifies signatures with.
+func (s *Store) GenesisValidatorsRoot() [32]byte {
+	return s.genesisValidatorsRoot
+}
+
+// setNextSyncCommittee stores the next sync committee along with its parsed public keys. An empty committee
+// leaves the next sync committee unknown.
+func (s *Store) setNextSyncCommittee(committee *qrlpb.SyncCommittee) error {
+	if isEmptySyncCommittee(committee) {
+		s.NextSyncCommittee = nil
+		s.nextSyncCommitteeKeys = nil
+		return nil
+	}
+	keys, err := committeeKeys(committee)
+	if err != nil {
+		return errors.Wrap(ErrInvalidUpdate, err.Error())
+	}
+	s.NextSyncCommittee = committee
+	s.nextSyncCommitteeKeys = keys
+	return nil
+}
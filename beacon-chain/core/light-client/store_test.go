@@ -0,0 +1,235 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/beacon-chain/core/signing"
+	p2pType "github.com/theQRL/qrysm/beacon-chain/p2p/types"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/crypto/ml_dsa_87"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+var testGenesisValidatorsRoot = [32]byte{'g', 'v', 'r'}
+
+type testCommittee struct {
+	keys      []ml_dsa_87.MLDSA87Key
+	committee *qrlpb.SyncCommittee
+}
+
+func newTestCommittee(t *testing.T) *testCommittee {
+	size := params.BeaconConfig().SyncCommitteeSize
+	c := &testCommittee{
+		keys:      make([]ml_dsa_87.MLDSA87Key, size),
+		committee: &qrlpb.SyncCommittee{Pubkeys: make([][]byte, size)},
+	}
+	for i := range c.keys {
+		key, err := ml_dsa_87.RandKey()
+		require.NoError(t, err)
+		c.keys[i] = key
+		c.committee.Pubkeys[i] = key.PublicKey().Marshal()
+	}
+	return c
+}
+
+// sign returns a sync aggregate over the attested header signed by the first participants members of the committee.
+func (c *testCommittee) sign(t *testing.T, attestedHeader *qrlpb.BeaconBlockHeader, participants uint64) *qrlpb.SyncAggregate {
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainSyncCommittee, params.BeaconConfig().GenesisForkVersion, testGenesisValidatorsRoot[:])
+	require.NoError(t, err)
+	headerRoot, err := attestedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	sszBytes := p2pType.SSZBytes(headerRoot[:])
+	r, err := signing.ComputeSigningRoot(&sszBytes, d)
+	require.NoError(t, err)
+
+	agg := &qrlpb.SyncAggregate{SyncCommitteeBits: make([]byte, params.BeaconConfig().SyncCommitteeSize/8)}
+	for i := range participants {
+		agg.SyncCommitteeBits.SetBitAt(i, true)
+		agg.SyncCommitteeSignatures = append(agg.SyncCommitteeSignatures, c.keys[i].Sign(r[:]).Marshal())
+	}
+	return agg
+}
+
+// testState returns a beacon state with the given committee as both the current and next sync committee, along
+// with the header of a block whose post-state it is.
+func (c *testCommittee) testState(t *testing.T, slot primitives.Slot, finalizedRoot [32]byte) (*qrlpb.BeaconBlockHeader, [][]byte, [][]byte, [][]byte) {
+	ctx := context.Background()
+	st, err := util.NewBeaconStateZond(func(s *qrysmpb.BeaconStateZond) error {
+		s.Slot = slot
+		s.CurrentSyncCommittee = &qrysmpb.SyncCommittee{Pubkeys: c.committee.Pubkeys}
+		s.NextSyncCommittee = &qrysmpb.SyncCommittee{Pubkeys: c.committee.Pubkeys}
+		s.FinalizedCheckpoint = &qrysmpb.Checkpoint{Root: finalizedRoot[:]}
+		return nil
+	})
+	require.NoError(t, err)
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	currentBranch, err := st.CurrentSyncCommitteeProof(ctx)
+	require.NoError(t, err)
+	nextBranch, err := st.NextSyncCommitteeProof(ctx)
+	require.NoError(t, err)
+	finalityBranch, err := st.FinalizedRootProof(ctx)
+	require.NoError(t, err)
+	header := &qrlpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  stateRoot[:],
+		BodyRoot:   make([]byte, 32),
+	}
+	return header, currentBranch, nextBranch, finalityBranch
+}
+
+func (c *testCommittee) store(t *testing.T, slot primitives.Slot) *Store {
+	header, branch, _, _ := c.testState(t, slot, [32]byte{})
+	root, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	s, err := NewStore(root, &qrlpb.LightClientBootstrap{
+		Header:                     header,
+		CurrentSyncCommittee:       c.committee,
+		CurrentSyncCommitteeBranch: branch,
+	}, testGenesisValidatorsRoot)
+	require.NoError(t, err)
+	return s
+}
+
+// finalityUpdate returns an update attested at the given slot which finalizes a header at finalizedSlot and
+// carries the next sync committee.
+func (c *testCommittee) finalityUpdate(t *testing.T, attestedSlot, finalizedSlot primitives.Slot, participants uint64) *qrlpb.LightClientUpdate {
+	finalizedHeader := &qrlpb.BeaconBlockHeader{
+		Slot:       finalizedSlot,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   make([]byte, 32),
+	}
+	finalizedRoot, err := finalizedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	attestedHeader, _, nextBranch, finalityBranch := c.testState(t, attestedSlot, finalizedRoot)
+	return &qrlpb.LightClientUpdate{
+		AttestedHeader:          attestedHeader,
+		NextSyncCommittee:       c.committee,
+		NextSyncCommitteeBranch: nextBranch,
+		FinalizedHeader:         finalizedHeader,
+		FinalityBranch:          finalityBranch,
+		SyncAggregate:           c.sign(t, attestedHeader, participants),
+		SignatureSlot:           attestedSlot + 1,
+	}
+}
+
+func TestNewStore(t *testing.T) {
+	c := newTestCommittee(t)
+	header, branch, _, _ := c.testState(t, 8, [32]byte{})
+	root, err := header.HashTreeRoot()
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		s, err := NewStore(root, &qrlpb.LightClientBootstrap{
+			Header:                     header,
+			CurrentSyncCommittee:       c.committee,
+			CurrentSyncCommitteeBranch: branch,
+		}, testGenesisValidatorsRoot)
+		require.NoError(t, err)
+		assert.Equal(t, primitives.Slot(8), s.FinalizedHeader.Slot)
+		assert.Equal(t, primitives.Slot(8), s.OptimisticHeader.Slot)
+		assert.Equal(t, false, s.IsNextSyncCommitteeKnown())
+	})
+	t.Run("untrusted root", func(t *testing.T) {
+		_, err := NewStore([32]byte{'a'}, &qrlpb.LightClientBootstrap{
+			Header:                     header,
+			CurrentSyncCommittee:       c.committee,
+			CurrentSyncCommitteeBranch: branch,
+		}, testGenesisValidatorsRoot)
+		require.ErrorIs(t, err, ErrInvalidBootstrap)
+	})
+	t.Run("invalid branch", func(t *testing.T) {
+		_, err := NewStore(root, &qrlpb.LightClientBootstrap{
+			Header:                     header,
+			CurrentSyncCommittee:       c.committee,
+			CurrentSyncCommitteeBranch: branch[1:],
+		}, testGenesisValidatorsRoot)
+		require.ErrorIs(t, err, ErrInvalidBootstrap)
+	})
+}
+
+func TestStore_ProcessUpdate(t *testing.T) {
+	c := newTestCommittee(t)
+
+	t.Run("finalizes with supermajority", func(t *testing.T) {
+		s := c.store(t, 8)
+		update := c.finalityUpdate(t, 32, 16, params.BeaconConfig().SyncCommitteeSize)
+		require.NoError(t, s.ProcessUpdate(update, 33))
+		assert.Equal(t, primitives.Slot(16), s.FinalizedHeader.Slot)
+		assert.Equal(t, primitives.Slot(32), s.OptimisticHeader.Slot)
+		assert.Equal(t, true, s.IsNextSyncCommitteeKnown())
+		assert.Equal(t, (*qrlpb.LightClientUpdate)(nil), s.BestValidUpdate)
+	})
+	t.Run("no supermajority only tracks the update", func(t *testing.T) {
+		s := c.store(t, 8)
+		update := c.finalityUpdate(t, 32, 16, params.BeaconConfig().SyncCommitteeSize/2)
+		require.NoError(t, s.ProcessUpdate(update, 33))
+		assert.Equal(t, primitives.Slot(8), s.FinalizedHeader.Slot)
+		assert.Equal(t, primitives.Slot(32), s.OptimisticHeader.Slot)
+		assert.Equal(t, update, s.BestValidUpdate)
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		s := c.store(t, 8)
+		update := c.finalityUpdate(t, 32, 16, params.BeaconConfig().SyncCommitteeSize)
+		update.SyncAggregate.SyncCommitteeSignatures[3][0] ^= 0xff
+		require.ErrorIs(t, s.ProcessUpdate(update, 33), ErrInvalidSignature)
+		assert.Equal(t, primitives.Slot(8), s.FinalizedHeader.Slot)
+	})
+	t.Run("missing signature", func(t *testing.T) {
+		s := c.store(t, 8)
+		update := c.finalityUpdate(t, 32, 16, params.BeaconConfig().SyncCommitteeSize)
+		update.SyncAggregate.SyncCommitteeSignatures = update.SyncAggregate.SyncCommitteeSignatures[1:]
+		require.ErrorIs(t, s.ProcessUpdate(update, 33), ErrInvalidSignature)
+	})
+	t.Run("invalid finality branch", func(t *testing.T) {
+		s := c.store(t, 8)
+		update := c.finalityUpdate(t, 32, 16, params.BeaconConfig().SyncCommitteeSize)
+		update.FinalizedHeader.Slot = 17
+		require.ErrorIs(t, s.ProcessUpdate(update, 33), ErrInvalidUpdate)
+	})
+	t.Run("signature slot in the future", func(t *testing.T) {
+		s := c.store(t, 8)
+		update := c.finalityUpdate(t, 32, 16, params.BeaconConfig().SyncCommitteeSize)
+		require.ErrorIs(t, s.ProcessUpdate(update, 32), ErrInvalidUpdate)
+	})
+}
+
+func TestStore_ProcessOptimisticUpdate(t *testing.T) {
+	c := newTestCommittee(t)
+	s := c.store(t, 8)
+	attestedHeader, _, _, _ := c.testState(t, 20, [32]byte{})
+	update := &qrlpb.LightClientOptimisticUpdate{
+		AttestedHeader: attestedHeader,
+		SyncAggregate:  c.sign(t, attestedHeader, 10),
+		SignatureSlot:  21,
+	}
+	require.NoError(t, s.ProcessOptimisticUpdate(update, 21))
+	assert.Equal(t, primitives.Slot(20), s.OptimisticHeader.Slot)
+	assert.Equal(t, primitives.Slot(8), s.FinalizedHeader.Slot)
+	assert.Equal(t, uint64(10), s.CurrentMaxActiveParticipants)
+}
+
+func TestStore_ProcessForceUpdate(t *testing.T) {
+	c := newTestCommittee(t)
+	s := c.store(t, 8)
+	update := c.finalityUpdate(t, 32, 16, params.BeaconConfig().SyncCommitteeSize/2)
+	require.NoError(t, s.ProcessUpdate(update, 33))
+	require.NotNil(t, s.BestValidUpdate)
+
+	updateTimeout := primitives.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, s.ProcessForceUpdate(8+updateTimeout))
+	assert.Equal(t, primitives.Slot(8), s.FinalizedHeader.Slot, "Forced update before the timeout")
+
+	require.NoError(t, s.ProcessForceUpdate(9+updateTimeout))
+	assert.Equal(t, primitives.Slot(16), s.FinalizedHeader.Slot)
+	assert.Equal(t, true, s.IsNextSyncCommitteeKnown())
+	assert.Equal(t, (*qrlpb.LightClientUpdate)(nil), s.BestValidUpdate)
+}
@@ -0,0 +1,159 @@
+package lightclient
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/beacon-chain/core/signing"
+	p2pType "github.com/theQRL/qrysm/beacon-chain/p2p/types"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/container/trie"
+	"github.com/theQRL/qrysm/crypto/ml_dsa_87"
+	"github.com/theQRL/qrysm/network/forks"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	"github.com/theQRL/qrysm/time/slots"
+)
+
+var (
+	// ErrInvalidUpdate is returned when a light client update does not pass validation.
+	ErrInvalidUpdate = errors.New("invalid light client update")
+	// ErrInvalidBootstrap is returned when a light client bootstrap does not match the trusted block root.
+	ErrInvalidBootstrap = errors.New("invalid light client bootstrap")
+	// ErrInvalidSignature is returned when the sync committee signatures of an update do not verify.
+	ErrInvalidSignature = errors.New("invalid sync committee signature")
+)
+
+// NewUpdateFromFinalityUpdate converts a finality update into a light client update without a sync committee.
+func NewUpdateFromFinalityUpdate(update *qrlpb.LightClientFinalityUpdate) *qrlpb.LightClientUpdate {
+	return &qrlpb.LightClientUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}
+}
+
+// NewUpdateFromOptimisticUpdate converts an optimistic update into a light client update without
+// a sync committee or a finalized header.
+func NewUpdateFromOptimisticUpdate(update *qrlpb.LightClientOptimisticUpdate) *qrlpb.LightClientUpdate {
+	return &qrlpb.LightClientUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}
+}
+
+// VerifyBranch checks a Merkle branch of a light client object against a state root. The generalized
+// index determines both the expected length of the branch and the position of the leaf.
+func VerifyBranch(stateRoot []byte, leaf [32]byte, branch [][]byte, gIndex uint64) bool {
+	if len(branch) != qrlpb.FloorLog2(gIndex) {
+		return false
+	}
+	return trie.VerifyMerkleProof(stateRoot, leaf[:], gIndex, branch)
+}
+
+// VerifySyncAggregate checks the signatures of the sync committee members that participated in the aggregate
+// against the signing root of the attested header. Unlike a BLS aggregate, the aggregate carries one ML-DSA-87
+// signature per participant, ordered by committee position, so every signature is checked against the public key
+// of its member. The signatures are verified as a single batch, which checks them in parallel.
+func VerifySyncAggregate(
+	committeeKeys []ml_dsa_87.PublicKey,
+	agg *qrlpb.SyncAggregate,
+	attestedHeader *qrlpb.BeaconBlockHeader,
+	signatureSlot primitives.Slot,
+	genesisValidatorsRoot []byte,
+) error {
+	bits := agg.SyncCommitteeBits
+	if bits.Len() != uint64(len(committeeKeys)) {
+		return errors.Wrapf(ErrInvalidSignature, "sync committee bits length %d does not match committee size %d", bits.Len(), len(committeeKeys))
+	}
+	keys := make([]ml_dsa_87.PublicKey, 0, bits.Count())
+	for _, i := range bits.BitIndices() {
+		keys = append(keys, committeeKeys[i])
+	}
+	if len(agg.SyncCommitteeSignatures) != len(keys) {
+		return errors.Wrapf(ErrInvalidSignature, "got %d signatures for %d participants", len(agg.SyncCommitteeSignatures), len(keys))
+	}
+	if len(keys) == 0 {
+		return errors.Wrap(ErrInvalidSignature, "no participants")
+	}
+
+	// The signature is made in the slot before the signature slot, with the fork of that slot.
+	epoch := slots.ToEpoch(max(signatureSlot, 1) - 1)
+	fork, err := forks.Fork(epoch)
+	if err != nil {
+		return err
+	}
+	d, err := signing.Domain(fork, epoch, params.BeaconConfig().DomainSyncCommittee, genesisValidatorsRoot)
+	if err != nil {
+		return err
+	}
+	headerRoot, err := attestedHeader.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	sszBytes := p2pType.SSZBytes(headerRoot[:])
+	r, err := signing.ComputeSigningRoot(&sszBytes, d)
+	if err != nil {
+		return err
+	}
+
+	valid, err := ml_dsa_87.VerifyMultipleSignatures([][][]byte{agg.SyncCommitteeSignatures}, [][32]byte{r}, [][]ml_dsa_87.PublicKey{keys})
+	if err != nil {
+		return errors.Wrap(ErrInvalidSignature, err.Error())
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// committeeKeys parses the public keys of a sync committee.
+func committeeKeys(committee *qrlpb.SyncCommittee) ([]ml_dsa_87.PublicKey, error) {
+	if committee == nil {
+		return nil, errors.New("nil sync committee")
+	}
+	if uint64(len(committee.Pubkeys)) != params.BeaconConfig().SyncCommitteeSize {
+		return nil, errors.Errorf("sync committee has %d public keys, expected %d", len(committee.Pubkeys), params.BeaconConfig().SyncCommitteeSize)
+	}
+	keys := make([]ml_dsa_87.PublicKey, len(committee.Pubkeys))
+	for i, pk := range committee.Pubkeys {
+		key, err := ml_dsa_87.PublicKeyFromBytes(pk)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse public key %d of sync committee", i)
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+func periodAtSlot(slot primitives.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+// isEmptyHeader reports whether the header is unset or zero valued.
+func isEmptyHeader(h *qrlpb.BeaconBlockHeader) bool {
+	if h == nil {
+		return true
+	}
+	return h.Slot == 0 && h.ProposerIndex == 0 && isZero(h.ParentRoot) && isZero(h.StateRoot) && isZero(h.BodyRoot)
+}
+
+// isEmptySyncCommittee reports whether the sync committee is unset or only holds zero valued public keys.
+func isEmptySyncCommittee(c *qrlpb.SyncCommittee) bool {
+	if c == nil {
+		return true
+	}
+	for _, pk := range c.Pubkeys {
+		if !isZero(pk) {
+			return false
+		}
+	}
+	return true
+}
+
+func isZero(b []byte) bool {
+	return len(b) == 0 || bytes.Equal(b, make([]byte, len(b)))
+}
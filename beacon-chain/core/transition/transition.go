@@ -69,9 +69,11 @@ func ExecuteStateTransition(
 		valid, err = set.Verify()
 	}
 	if err != nil {
+		cache.VerifiedSignatures.Invalidate(set)
 		return nil, errors.Wrap(err, "could not batch verify signature")
 	}
 	if !valid {
+		cache.VerifiedSignatures.Invalidate(set)
 		return nil, errors.New("signature in block failed to verify")
 	}
 
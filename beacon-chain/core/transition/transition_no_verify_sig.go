@@ -22,7 +22,8 @@ import (
 // ExecuteStateTransitionNoVerifyAnySig defines the procedure for a state transition function.
 // This does not validate any ML-DSA-87 signatures of attestations, block proposer signature, randao signature,
 // it is used for performing a state transition as quickly as possible. This function also returns a signature
-// set of all signatures not verified, so that they can be stored and verified later.
+// set of all signatures not verified, so that they can be stored and verified later. Attestation signatures
+// that were already verified on gossip are left out of the set.
 //
 // WARNING: This method does not validate any signatures (i.e. calling `state_transition()` with `validate_result=False`).
 // This method also modifies the passed in state.
@@ -191,7 +192,7 @@ func ProcessBlockNoVerifyAnySig(
 		tracing.AnnotateError(span, err)
 		return nil, nil, errors.Wrap(err, "could not retrieve randao signature set")
 	}
-	aSet, err := b.AttestationSignatureBatch(ctx, st, signed.Block().Body().Attestations())
+	aSet, err := b.BlockAttestationSignatureBatch(ctx, st, signed.Block().Body().Attestations())
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "could not retrieve attestation signature set")
 	}
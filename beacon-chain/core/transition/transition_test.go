@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/theQRL/go-bitfield"
+	"github.com/theQRL/qrysm/beacon-chain/cache"
 	"github.com/theQRL/qrysm/beacon-chain/core/blocks"
 	"github.com/theQRL/qrysm/beacon-chain/core/helpers"
 	"github.com/theQRL/qrysm/beacon-chain/core/time"
@@ -107,6 +108,46 @@ func TestExecuteStateTransition_FullProcess(t *testing.T) {
 	assert.DeepNotEqual(t, oldMix, mix, "Did not expect new and old randao mix to equal")
 }
 
+func TestExecuteStateTransition_InvalidBlockInvalidatesSkippedSignatures(t *testing.T) {
+	ctx := context.Background()
+	beaconState, privKeys := util.DeterministicGenesisStateZond(t, 64)
+	blk, err := util.GenerateFullBlockZond(beaconState, privKeys, util.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	wsb, err := consensusblocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	beaconState, err = transition.ExecuteStateTransition(ctx, beaconState, wsb)
+	require.NoError(t, err)
+
+	blk, err = util.GenerateFullBlockZond(beaconState, privKeys, util.DefaultBlockGenConfig(), 2)
+	require.NoError(t, err)
+	require.NotEqual(t, 0, len(blk.Block.Body.Attestations))
+	// Forge an attestation signature, which also breaks the block signature, and cache it as verified.
+	atts := blk.Block.Body.Attestations
+	atts[0].Signatures[0] = privKeys[0].Sign(make([]byte, 32)).Marshal()
+	cache.VerifiedSignatures.Clear()
+	t.Cleanup(cache.VerifiedSignatures.Clear)
+	attSet, err := blocks.AttestationSignatureBatch(ctx, beaconState, atts)
+	require.NoError(t, err)
+	attSet.MarkVerified(cache.VerifiedSignatures)
+	skipped, err := blocks.BlockAttestationSignatureBatch(ctx, beaconState, atts)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(skipped.Messages))
+
+	wsb, err = consensusblocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	_, err = transition.ExecuteStateTransition(ctx, beaconState.Copy(), wsb)
+	require.NotNil(t, err)
+
+	// The failed block does not leave the forged signature cached, so it is verified on a retry.
+	assert.Equal(t, false, cache.VerifiedSignatures.Verified(attSet.PublicKeys[0][0], attSet.Messages[0], attSet.Signatures[0][0]))
+	retried, err := blocks.BlockAttestationSignatureBatch(ctx, beaconState, atts)
+	require.NoError(t, err)
+	require.DeepEqual(t, attSet.Messages, retried.Messages)
+	valid, err := retried.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, false, valid)
+}
+
 func TestProcessBlock_IncorrectProcessExits(t *testing.T) {
 	beaconState, _ := util.DeterministicGenesisStateZond(t, 100)
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/theQRL/go-qrl/common"
 	"github.com/theQRL/qrysm/beacon-chain/db/filters"
 	slashertypes "github.com/theQRL/qrysm/beacon-chain/slasher/types"
@@ -14,6 +15,7 @@ import (
 	"github.com/theQRL/qrysm/consensus-types/interfaces"
 	"github.com/theQRL/qrysm/consensus-types/primitives"
 	"github.com/theQRL/qrysm/monitoring/backup"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 )
 
@@ -58,18 +60,25 @@ type ReadOnlyDatabase interface {
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	PrunedBeforeSlot(ctx context.Context) (primitives.Slot, error)
 
 	// P2P Metadata operations.
 	MetadataSeqNum(ctx context.Context) (uint64, error)
+	PeerReputations(ctx context.Context) (map[peer.ID]*qrysmpb.PeerReputation, error)
+
+	// Light client server operations.
+	LightClientUpdate(ctx context.Context, period uint64) (*qrlpb.LightClientUpdate, error)
+	LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) (map[uint64]*qrlpb.LightClientUpdate, error)
 }
 
 // ReadOnlyDatabaseWithSeqNum defines a struct which has read access to database methods
-// and also has read/write access to the p2p metadata sequence number.
+// and also has read/write access to the p2p metadata sequence number and peer reputations.
 // Only used for the p2p service.
 type ReadOnlyDatabaseWithSeqNum interface {
 	ReadOnlyDatabase
 
 	SaveMetadataSeqNum(ctx context.Context, seqNum uint64) error
+	SavePeerReputations(ctx context.Context, reputations map[peer.ID]*qrysmpb.PeerReputation) error
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -78,6 +87,7 @@ type NoHeadAccessDatabase interface {
 
 	// Block related methods.
 	DeleteBlock(ctx context.Context, root [32]byte) error
+	DeleteHistoricalDataBeforeSlot(ctx context.Context, cutoffSlot primitives.Slot) (int, error)
 	SaveBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
@@ -106,6 +116,10 @@ type NoHeadAccessDatabase interface {
 
 	// P2P Metadata operations.
 	SaveMetadataSeqNum(ctx context.Context, seqNum uint64) error
+	SavePeerReputations(ctx context.Context, reputations map[peer.ID]*qrysmpb.PeerReputation) error
+
+	// Light client server operations.
+	SaveLightClientUpdate(ctx context.Context, period uint64, update *qrlpb.LightClientUpdate) error
 }
 
 // HeadAccessDatabase defines a struct with access to reading chain head data.
@@ -125,6 +139,7 @@ type HeadAccessDatabase interface {
 	// initialization method needed for origin checkpoint sync
 	SaveOrigin(ctx context.Context, serState, serBlock []byte) error
 	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	BackfillFinalizedIndex(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock, finalizedChildRoot [32]byte) error
 }
 
 // SlasherDatabase interface for persisting data related to detecting slashable offenses on Ethereum.
@@ -171,6 +186,18 @@ type SlasherDatabase interface {
 		ctx context.Context,
 		indices []primitives.ValidatorIndex,
 	) ([]*qrysmpb.HighestAttestation, error)
+	BackfilledEpoch(ctx context.Context) (primitives.Epoch, bool, error)
+	SaveBackfilledEpoch(ctx context.Context, epoch primitives.Epoch) error
+	SlashingRecord(
+		ctx context.Context, epoch primitives.Epoch, root [32]byte,
+	) (*slashertypes.SlashingRecord, error)
+	SlashingRecords(
+		ctx context.Context, startEpoch, endEpoch primitives.Epoch, offset, limit int,
+	) ([]*slashertypes.SlashingRecord, error)
+	ValidatorSlashingRecords(
+		ctx context.Context, idx primitives.ValidatorIndex, offset, limit int,
+	) ([]*slashertypes.SlashingRecord, error)
+	SaveSlashingRecord(ctx context.Context, record *slashertypes.SlashingRecord) error
 	DatabasePath() string
 	ClearDB() error
 }
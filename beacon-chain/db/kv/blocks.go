@@ -85,7 +85,8 @@ func (s *Store) HeadBlockRoot() ([32]byte, error) {
 	return root, err
 }
 
-// BackfillBlockRoot keeps track of the highest block available before the OriginCheckpointBlockRoot
+// BackfillBlockRoot keeps track of the lowest block backfilled below the OriginCheckpointBlockRoot.
+// Until backfill has saved a block, this points at the genesis block root.
 func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
 	_, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
 	defer span.End()
@@ -458,7 +459,7 @@ func (s *Store) SaveOriginCheckpointBlockRoot(ctx context.Context, blockRoot [32
 	})
 }
 
-// SaveBackfillBlockRoot is used to keep track of the most recently backfilled block root when
+// SaveBackfillBlockRoot is used to keep track of the lowest backfilled block root when
 // the node was initialized via checkpoint sync.
 func (s *Store) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
 	_, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlockRoot")
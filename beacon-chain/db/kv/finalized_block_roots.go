@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 
+	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/beacon-chain/db/filters"
 	"github.com/theQRL/qrysm/consensus-types/blocks"
 	"github.com/theQRL/qrysm/consensus-types/interfaces"
@@ -16,6 +17,12 @@ import (
 
 var previousFinalizedCheckpointKey = []byte("previous-finalized-checkpoint")
 
+var (
+	errEmptyBlockSlice         = errors.New("no blocks given to add to the finalized index")
+	errIncorrectBlockParent    = errors.New("unexpected parent root for block")
+	errNotConnectedToFinalized = errors.New("child root is not present in the finalized block roots index")
+)
+
 // Blocks from the recent finalized epoch are not part of the finalized and canonical chain in this
 // index. These containers will be removed on the next update of finalized checkpoint. Note that
 // these block roots may be considered canonical in the "head view" of the beacon chain, but not so
@@ -219,3 +226,68 @@ func (s *Store) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (in
 	tracing.AnnotateError(span, err)
 	return blk, err
 }
+
+// BackfillFinalizedIndex adds blocks downloaded by backfill to the finalized block roots index. The blocks must be
+// sorted by slot in ascending order and form a chain whose highest block is the parent of finalizedChildRoot,
+// which must already be present in the index. Because backfill walks the chain backwards from the origin
+// checkpoint, this links the downloaded blocks onto the lower end of the existing finalized index.
+func (s *Store) BackfillFinalizedIndex(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock, finalizedChildRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillFinalizedIndex")
+	defer span.End()
+	if len(blks) == 0 {
+		return errEmptyBlockSlice
+	}
+
+	roots := make([][32]byte, len(blks))
+	for i := range blks {
+		root, err := blks[i].Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		roots[i] = root
+	}
+	encs := make([][]byte, len(blks))
+	for i := range blks {
+		child := finalizedChildRoot
+		if i < len(blks)-1 {
+			child = roots[i+1]
+			if blks[i+1].Block().ParentRoot() != roots[i] {
+				return errors.Wrapf(errIncorrectBlockParent, "block at slot %d is not the parent of block at slot %d",
+					blks[i].Block().Slot(), blks[i+1].Block().Slot())
+			}
+		}
+		parentRoot := blks[i].Block().ParentRoot()
+		enc, err := encode(ctx, &qrysmpb.FinalizedBlockRootContainer{
+			ParentRoot: parentRoot[:],
+			ChildRoot:  child[:],
+		})
+		if err != nil {
+			return err
+		}
+		encs[i] = enc
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		childEnc := bkt.Get(finalizedChildRoot[:])
+		if len(childEnc) == 0 || bytes.Equal(childEnc, containerFinalizedButNotCanonical) {
+			return errors.Wrapf(errNotConnectedToFinalized, "root=%#x", finalizedChildRoot)
+		}
+		child := &qrysmpb.FinalizedBlockRootContainer{}
+		if err := decode(ctx, childEnc, child); err != nil {
+			return err
+		}
+		highest := roots[len(roots)-1]
+		if !bytes.Equal(child.ParentRoot, highest[:]) {
+			return errors.Wrapf(errIncorrectBlockParent, "finalized child root=%#x does not descend from backfilled root=%#x",
+				finalizedChildRoot, highest)
+		}
+		for i := range roots {
+			if err := bkt.Put(roots[i][:], encs[i]); err != nil {
+				tracing.AnnotateError(span, err)
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -209,3 +209,64 @@ func makeBlocksZond(t *testing.T, i, n uint64, previousRoot [32]byte) []interfac
 	}
 	return ifaceBlocks
 }
+
+func TestStore_BackfillFinalizedIndex(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	require.ErrorIs(t, db.BackfillFinalizedIndex(ctx, []interfaces.ReadOnlySignedBeaconBlock{}, [32]byte{}), errEmptyBlockSlice)
+
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocksZond(t, 0, slotsPerEpoch*2, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+
+	// Use the first block of epoch 1 as the checkpoint sync origin.
+	origin := blks[slotsPerEpoch-1]
+	originRoot, err := origin.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOriginCheckpointBlockRoot(ctx, originRoot))
+	st, err := util.NewBeaconStateZond()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, originRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &qrysmpb.Checkpoint{Epoch: 1, Root: originRoot[:]}))
+
+	below := blks[:slotsPerEpoch-1]
+	for i := range below {
+		root, err := below[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, false, db.IsFinalizedBlock(ctx, root))
+	}
+
+	// A batch that does not link onto the finalized index is rejected.
+	require.ErrorIs(t, db.BackfillFinalizedIndex(ctx, below[:2], originRoot), errIncorrectBlockParent)
+	// A batch that is linked to a root that isn't in the index is rejected.
+	lastRoot, err := blks[len(blks)-1].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.ErrorIs(t, db.BackfillFinalizedIndex(ctx, below, lastRoot), errNotConnectedToFinalized)
+
+	// Backfill the upper half first, then the lower half, the way the backfill service walks backwards.
+	half := len(below) / 2
+	require.NoError(t, db.BackfillFinalizedIndex(ctx, below[half:], originRoot))
+	halfRoot, err := below[half].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.BackfillFinalizedIndex(ctx, below[:half], halfRoot))
+	for i := range below {
+		root, err := below[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at index %d was not considered finalized in the index", i)
+	}
+
+	// Child pointers allow walking the index forward from the lowest backfilled block up to the origin.
+	root, err := below[0].Block().HashTreeRoot()
+	require.NoError(t, err)
+	for i := 1; i < len(below); i++ {
+		child, err := db.FinalizedChildBlock(ctx, root)
+		require.NoError(t, err)
+		require.Equal(t, below[i].Block().Slot(), child.Block().Slot())
+		root, err = child.Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	child, err := db.FinalizedChildBlock(ctx, root)
+	require.NoError(t, err)
+	require.Equal(t, origin.Block().Slot(), child.Block().Slot())
+}
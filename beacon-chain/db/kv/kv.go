@@ -129,6 +129,8 @@ var Buckets = [][]byte{
 
 	feeRecipientBucket,
 	registrationBucket,
+	lightClientUpdatesBucket,
+	peerReputationBucket,
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
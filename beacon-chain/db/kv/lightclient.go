@@ -0,0 +1,79 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveLightClientUpdate saves the best light client update of the given sync committee period,
+// replacing any update previously stored for that period.
+func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, update *qrlpb.LightClientUpdate) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientUpdate")
+	defer span.End()
+
+	enc, err := encode(ctx, update)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(lightClientUpdatesBucket).Put(periodKey(period), enc)
+	})
+}
+
+// LightClientUpdate returns the light client update stored for the given sync committee period,
+// or nil if there is none.
+func (s *Store) LightClientUpdate(ctx context.Context, period uint64) (*qrlpb.LightClientUpdate, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdate")
+	defer span.End()
+
+	var update *qrlpb.LightClientUpdate
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(lightClientUpdatesBucket).Get(periodKey(period))
+		if enc == nil {
+			return nil
+		}
+		update = &qrlpb.LightClientUpdate{}
+		return decode(ctx, enc, update)
+	})
+	return update, err
+}
+
+// LightClientUpdates returns the light client updates stored for the sync committee periods in the range
+// [startPeriod, endPeriod], keyed by period. Periods without an update are absent from the result.
+func (s *Store) LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) (map[uint64]*qrlpb.LightClientUpdate, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdates")
+	defer span.End()
+
+	if startPeriod > endPeriod {
+		return nil, errors.Errorf("start period %d is greater than end period %d", startPeriod, endPeriod)
+	}
+	updates := make(map[uint64]*qrlpb.LightClientUpdate)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(lightClientUpdatesBucket).Cursor()
+		for k, v := c.Seek(periodKey(startPeriod)); k != nil; k, v = c.Next() {
+			period := binary.BigEndian.Uint64(k)
+			if period > endPeriod {
+				break
+			}
+			update := &qrlpb.LightClientUpdate{}
+			if err := decode(ctx, v, update); err != nil {
+				return err
+			}
+			updates[period] = update
+		}
+		return nil
+	})
+	return updates, err
+}
+
+// periodKey encodes a sync committee period in big endian, so that the keys are sorted by period.
+func periodKey(period uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, period)
+	return key
+}
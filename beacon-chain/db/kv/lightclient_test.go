@@ -0,0 +1,70 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func lightClientUpdate(slot primitives.Slot) *qrlpb.LightClientUpdate {
+	return &qrlpb.LightClientUpdate{
+		AttestedHeader: &qrlpb.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+		SyncAggregate: &qrlpb.SyncAggregate{SyncCommitteeBits: make([]byte, 16)},
+		SignatureSlot: slot + 1,
+	}
+}
+
+func TestStore_LightClientUpdate_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	update, err := db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, (*qrlpb.LightClientUpdate)(nil), update)
+
+	want := lightClientUpdate(10)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, want))
+	update, err = db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(want, update), "Wanted %v, received %v", want, update)
+
+	// A better update replaces the previous one.
+	want = lightClientUpdate(20)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, want))
+	update, err = db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(want, update), "Wanted %v, received %v", want, update)
+}
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	for _, period := range []uint64{1, 2, 4, 300} {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, lightClientUpdate(primitives.Slot(period))))
+	}
+
+	updates, err := db.LightClientUpdates(ctx, 2, 5)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(updates))
+	assert.Equal(t, primitives.Slot(2), updates[2].AttestedHeader.Slot)
+	assert.Equal(t, primitives.Slot(4), updates[4].AttestedHeader.Slot)
+
+	updates, err = db.LightClientUpdates(ctx, 0, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(updates))
+	assert.Equal(t, primitives.Slot(300), updates[300].AttestedHeader.Slot)
+
+	_, err = db.LightClientUpdates(ctx, 5, 2)
+	require.ErrorContains(t, "start period 5 is greater than end period 2", err)
+}
@@ -3,7 +3,10 @@ package kv
 import (
 	"context"
 
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/encoding/bytesutil"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
@@ -40,3 +43,49 @@ func (s *Store) SaveMetadataSeqNum(ctx context.Context, seqNum uint64) error {
 		return bkt.Put(metadataSequenceNumberKey, val)
 	})
 }
+
+// PeerReputations retrieves the saved peer reputations from the database.
+func (s *Store) PeerReputations(ctx context.Context) (map[peer.ID]*qrysmpb.PeerReputation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PeerReputations")
+	defer span.End()
+
+	reputations := make(map[peer.ID]*qrysmpb.PeerReputation)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(peerReputationBucket)
+		return bkt.ForEach(func(k, v []byte) error {
+			r := &qrysmpb.PeerReputation{}
+			if err := decode(ctx, v, r); err != nil {
+				return errors.Wrapf(err, "could not unmarshal reputation of peer %s", peer.ID(k))
+			}
+			reputations[peer.ID(k)] = r
+			return nil
+		})
+	})
+	return reputations, err
+}
+
+// SavePeerReputations replaces the saved peer reputations with the given ones.
+func (s *Store) SavePeerReputations(ctx context.Context, reputations map[peer.ID]*qrysmpb.PeerReputation) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SavePeerReputations")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(peerReputationBucket); err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+			return err
+		}
+		bkt, err := tx.CreateBucket(peerReputationBucket)
+		if err != nil {
+			return err
+		}
+		for pid, r := range reputations {
+			enc, err := encode(ctx, r)
+			if err != nil {
+				return errors.Wrapf(err, "could not marshal reputation of peer %s", pid)
+			}
+			if err := bkt.Put([]byte(pid), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
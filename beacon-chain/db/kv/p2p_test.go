@@ -3,8 +3,11 @@ package kv
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 	"github.com/theQRL/qrysm/testing/assert"
 	"github.com/theQRL/qrysm/testing/require"
 )
@@ -29,3 +32,40 @@ func TestStore_MetadataSeqNum(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, uint64(43), seqNum)
 }
+
+func TestStore_PeerReputations(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	reputations, err := db.PeerReputations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(reputations))
+
+	start := time.Unix(1700000000, 0)
+	want := map[peer.ID]*qrysmpb.PeerReputation{
+		peer.ID("a"): {
+			BadResponses: 3,
+			GossipScore:  -10.5,
+			Bans: []*qrysmpb.PeerBan{
+				{Reason: "too many bad responses", StartUnixNano: start.UnixNano(), EndUnixNano: start.Add(time.Hour).UnixNano()},
+				{Reason: "spam", Manual: true, StartUnixNano: start.Add(2 * time.Hour).UnixNano()},
+			},
+		},
+		peer.ID("b"): {Allowed: true},
+	}
+	requireReputations := func(t *testing.T, want map[peer.ID]*qrysmpb.PeerReputation) {
+		reputations, err := db.PeerReputations(ctx)
+		require.NoError(t, err)
+		require.Equal(t, len(want), len(reputations))
+		for pid, r := range want {
+			require.DeepEqual(t, r, reputations[pid])
+		}
+	}
+	require.NoError(t, db.SavePeerReputations(ctx, want))
+	requireReputations(t, want)
+
+	// Saving replaces the previous reputations.
+	delete(want, peer.ID("a"))
+	require.NoError(t, db.SavePeerReputations(ctx, want))
+	requireReputations(t, want)
+}
@@ -0,0 +1,232 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// pruneBatchSize is the number of slots pruned within a single write transaction, to avoid holding
+// the db lock for too long and to bound the size of each transaction.
+const pruneBatchSize = 256
+
+// PrunedBeforeSlot returns the slot below which finalized history has been removed from the db by
+// DeleteHistoricalDataBeforeSlot. Zero means that nothing has been pruned.
+func (s *Store) PrunedBeforeSlot(ctx context.Context) (primitives.Slot, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PrunedBeforeSlot")
+	defer span.End()
+
+	var slot primitives.Slot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(blocksBucket).Get(prunedBeforeSlotKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		slot = bytesutil.BytesToSlotBigEndian(enc)
+		return nil
+	})
+	return slot, err
+}
+
+// DeleteHistoricalDataBeforeSlot removes the blocks, state summaries, states, archived points and finalized
+// block roots index entries for every slot in the range (genesis, cutoffSlot). The genesis block and state,
+// the origin checkpoint block and state, and the blocks of the justified and finalized checkpoints are never
+// removed. The work is split in batches of slots, each deleted in its own transaction, and the cutoff is
+// recorded once all batches succeed so that it can be reported by PrunedBeforeSlot. The number of deleted
+// block roots is returned.
+func (s *Store) DeleteHistoricalDataBeforeSlot(ctx context.Context, cutoffSlot primitives.Slot) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteHistoricalDataBeforeSlot")
+	defer span.End()
+
+	protected, err := s.protectedRoots(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var deleted int
+	// Genesis is never pruned, so the first batch starts right after it.
+	from := primitives.Slot(1)
+	for from < cutoffSlot {
+		if ctx.Err() != nil {
+			return deleted, ctx.Err()
+		}
+		var n int
+		if err := s.db.Update(func(tx *bolt.Tx) error {
+			n, from, err = s.pruneBatch(ctx, tx, from, cutoffSlot, protected)
+			return err
+		}); err != nil {
+			return deleted, err
+		}
+		deleted += n
+	}
+
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		if prev := bkt.Get(prunedBeforeSlotKey); len(prev) > 0 && bytesutil.BytesToSlotBigEndian(prev) >= cutoffSlot {
+			return nil
+		}
+		return bkt.Put(prunedBeforeSlotKey, bytesutil.SlotToBytesBigEndian(cutoffSlot))
+	})
+	return deleted, err
+}
+
+// protectedRoots returns the roots that must survive pruning: genesis, the origin checkpoint and the
+// justified and finalized checkpoint blocks.
+func (s *Store) protectedRoots(ctx context.Context) (map[[32]byte]bool, error) {
+	protected := make(map[[32]byte]bool)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		for _, k := range [][]byte{genesisBlockRootKey, originCheckpointBlockRootKey} {
+			if r := bkt.Get(k); len(r) > 0 {
+				protected[bytesutil.ToBytes32(r)] = true
+			}
+		}
+		bkt = tx.Bucket(checkpointBucket)
+		for _, k := range [][]byte{justifiedCheckpointKey, finalizedCheckpointKey} {
+			enc := bkt.Get(k)
+			if enc == nil {
+				continue
+			}
+			cp := &qrysmpb.Checkpoint{}
+			if err := decode(ctx, enc, cp); err != nil {
+				return err
+			}
+			protected[bytesutil.ToBytes32(cp.Root)] = true
+		}
+		return nil
+	})
+	return protected, err
+}
+
+// pruneBatch deletes the history of up to pruneBatchSize block slots in the range [from, cutoff), along with the
+// states in the same range. It returns the number of deleted blocks and the slot where the next batch starts.
+func (s *Store) pruneBatch(ctx context.Context, tx *bolt.Tx, from, cutoff primitives.Slot, protected map[[32]byte]bool) (int, primitives.Slot, error) {
+	blockSlots, blockRoots, err := slotIndexRange(tx.Bucket(blockSlotIndicesBucket), from, cutoff, pruneBatchSize)
+	if err != nil {
+		return 0, 0, err
+	}
+	// When the batch is full, the next one starts right after the highest block slot of this batch.
+	// States are only pruned up to that point, so that states which belong to the blocks of the next
+	// batch are still there when their block is removed.
+	to := cutoff
+	if len(blockSlots) == pruneBatchSize {
+		to = blockSlots[len(blockSlots)-1] + 1
+	}
+	stateSlots, stateRoots, err := slotIndexRange(tx.Bucket(stateSlotIndicesBucket), from, to, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// States must go first, as the slot of a state is looked up through its summary or block.
+	for i := range stateRoots {
+		for _, root := range stateRoots[i] {
+			if protected[root] {
+				continue
+			}
+			if err := s.deleteStateTx(ctx, tx, root); err != nil {
+				return 0, 0, errors.Wrapf(err, "could not delete state for root %#x", root)
+			}
+		}
+		// Drop the index entry, unless it still points at a protected state.
+		if err := deleteUnprotectedIndex(tx.Bucket(stateSlotIndicesBucket), stateSlots[i], stateRoots[i], protected); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	var deleted int
+	for i := range blockRoots {
+		for _, root := range blockRoots[i] {
+			if protected[root] {
+				continue
+			}
+			if err := s.deleteBlockTx(ctx, tx, root); err != nil {
+				return 0, 0, errors.Wrapf(err, "could not delete block for root %#x", root)
+			}
+			deleted++
+		}
+		if err := deleteUnprotectedIndex(tx.Bucket(blockSlotIndicesBucket), blockSlots[i], blockRoots[i], protected); err != nil {
+			return 0, 0, err
+		}
+	}
+	return deleted, to, nil
+}
+
+// deleteBlockTx removes a block along with its state, state summary, parent root index and finalized index entries.
+// The slot index entry is handled by the caller.
+func (s *Store) deleteBlockTx(ctx context.Context, tx *bolt.Tx, root [32]byte) error {
+	if err := s.deleteStateTx(ctx, tx, root); err != nil {
+		return err
+	}
+	s.stateSummaryCache.delete(root)
+	if err := tx.Bucket(stateSummaryBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	if err := tx.Bucket(finalizedBlockRootsIndexBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	bkt := tx.Bucket(blocksBucket)
+	enc := bkt.Get(root[:])
+	if enc == nil {
+		return nil
+	}
+	blk, err := unmarshalBlock(ctx, enc)
+	if err != nil {
+		return err
+	}
+	parentRoot := blk.Block().ParentRoot()
+	parentIndices := map[string][]byte{
+		string(blockParentRootIndicesBucket): parentRoot[:],
+	}
+	if err := deleteValueForIndices(ctx, parentIndices, root[:], tx); err != nil {
+		return err
+	}
+	if err := bkt.Delete(root[:]); err != nil {
+		return err
+	}
+	s.blockCache.Del(string(root[:]))
+	return nil
+}
+
+// slotIndexRange returns the keys of a slot index bucket in the range [from, to), along with the roots stored
+// under each key. When limit is greater than zero, at most limit keys are returned.
+func slotIndexRange(bkt *bolt.Bucket, from, to primitives.Slot, limit int) ([]primitives.Slot, [][][32]byte, error) {
+	slots := make([]primitives.Slot, 0)
+	roots := make([][][32]byte, 0)
+	c := bkt.Cursor()
+	for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(from)); k != nil; k, v = c.Next() {
+		if limit > 0 && len(slots) >= limit {
+			break
+		}
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		if slot >= to {
+			break
+		}
+		rl, err := splitRoots(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		slots = append(slots, slot)
+		roots = append(roots, rl)
+	}
+	return slots, roots, nil
+}
+
+// deleteUnprotectedIndex removes a slot index entry, keeping only the protected roots it holds, if any.
+func deleteUnprotectedIndex(bkt *bolt.Bucket, slot primitives.Slot, roots [][32]byte, protected map[[32]byte]bool) error {
+	key := bytesutil.SlotToBytesBigEndian(slot)
+	keep := make([]byte, 0)
+	for _, r := range roots {
+		if protected[r] {
+			keep = append(keep, r[:]...)
+		}
+	}
+	if len(keep) == 0 {
+		return bkt.Delete(key)
+	}
+	return bkt.Put(key, keep)
+}
@@ -0,0 +1,115 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+	"github.com/theQRL/qrysm/time/slots"
+)
+
+func TestStore_DeleteHistoricalDataBeforeSlot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	// More blocks than a single prune batch, so that the cutoff spans several transactions.
+	blks := makeBlocksZond(t, 0, pruneBatchSize+100, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i := range blks {
+		r, err := blks[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = r
+		require.NoError(t, db.SaveStateSummary(ctx, &qrysmpb.StateSummary{Slot: blks[i].Block().Slot(), Root: r[:]}))
+	}
+
+	// Archived states below and above the cutoff.
+	archived := []int{63, 127, 319}
+	for _, i := range archived {
+		st, err := util.NewBeaconStateZond()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(blks[i].Block().Slot()))
+		require.NoError(t, db.SaveState(ctx, st, roots[i]))
+	}
+
+	finalizedIdx := 319
+	st, err := util.NewBeaconStateZond()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(blks[finalizedIdx].Block().Slot()))
+	require.NoError(t, db.SaveState(ctx, st, roots[finalizedIdx]))
+	cp := &qrysmpb.Checkpoint{Epoch: slots.ToEpoch(blks[finalizedIdx].Block().Slot()), Root: roots[finalizedIdx][:]}
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, cp))
+
+	pruned, err := db.PrunedBeforeSlot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(0), pruned)
+
+	cutoff := primitives.Slot(300)
+	deleted, err := db.DeleteHistoricalDataBeforeSlot(ctx, cutoff)
+	require.NoError(t, err)
+	require.Equal(t, int(cutoff)-1, deleted)
+
+	for i := range blks {
+		slot := blks[i].Block().Slot()
+		if slot < cutoff {
+			require.Equal(t, false, db.HasBlock(ctx, roots[i]), "block at slot %d not pruned", slot)
+			require.Equal(t, false, db.HasStateSummary(ctx, roots[i]), "state summary at slot %d not pruned", slot)
+			require.Equal(t, false, db.IsFinalizedBlock(ctx, roots[i]), "finalized index at slot %d not pruned", slot)
+			_, found, err := db.BlockRootsBySlot(ctx, slot)
+			require.NoError(t, err)
+			require.Equal(t, false, found)
+		} else {
+			require.Equal(t, true, db.HasBlock(ctx, roots[i]), "block at slot %d should not be pruned", slot)
+			require.Equal(t, true, db.HasStateSummary(ctx, roots[i]), "state summary at slot %d should not be pruned", slot)
+		}
+	}
+	for _, i := range archived {
+		slot := blks[i].Block().Slot()
+		require.Equal(t, slot >= cutoff, db.HasState(ctx, roots[i]))
+		require.Equal(t, slot >= cutoff, db.HasArchivedPoint(ctx, slot))
+	}
+	require.Equal(t, true, db.IsFinalizedBlock(ctx, roots[finalizedIdx]))
+
+	pruned, err = db.PrunedBeforeSlot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, cutoff, pruned)
+
+	// Pruning with a lower cutoff does not move the recorded slot back.
+	_, err = db.DeleteHistoricalDataBeforeSlot(ctx, cutoff-10)
+	require.NoError(t, err)
+	pruned, err = db.PrunedBeforeSlot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, cutoff, pruned)
+}
+
+func TestStore_DeleteHistoricalDataBeforeSlot_KeepsProtectedRoots(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocksZond(t, 0, 64, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	originIdx := 31
+	originRoot, err := blks[originIdx].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOriginCheckpointBlockRoot(ctx, originRoot))
+	st, err := util.NewBeaconStateZond()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(blks[originIdx].Block().Slot()))
+	require.NoError(t, db.SaveState(ctx, st, originRoot))
+
+	_, err = db.DeleteHistoricalDataBeforeSlot(ctx, 64)
+	require.NoError(t, err)
+	require.Equal(t, true, db.HasBlock(ctx, originRoot))
+	require.Equal(t, true, db.HasState(ctx, originRoot))
+	_, roots, err := db.BlockRootsBySlot(ctx, blks[originIdx].Block().Slot())
+	require.NoError(t, err)
+	require.DeepEqual(t, [][32]byte{originRoot}, roots)
+	prev, err := blks[originIdx-1].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, false, db.HasBlock(ctx, prev))
+}
@@ -20,6 +20,10 @@ var (
 	stateValidatorsBucket   = []byte("state-validators")
 	feeRecipientBucket      = []byte("fee-recipient")
 	registrationBucket      = []byte("registration")
+	peerReputationBucket    = []byte("peer-reputation")
+
+	// Light client server buckets.
+	lightClientUpdatesBucket = []byte("light-client-updates")
 
 	// Key indices buckets.
 	blockParentRootIndicesBucket        = []byte("block-parent-root-indices")
@@ -51,8 +55,10 @@ var (
 
 	// block root included in the beacon state used by weak subjectivity initial sync
 	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
-	// block root tracking the progress of backfill, or pointing at genesis if backfill has not been initiated
+	// block root of the lowest backfilled block, or pointing at genesis if backfill has not been initiated
 	backfillBlockRootKey = []byte("backfill-block-root")
+	// slot below which finalized history has been pruned from the db
+	prunedBeforeSlotKey = []byte("pruned-before-slot")
 
 	// New state management service compatibility bucket.
 	newStateServiceCompatibleBucket = []byte("new-state-compatible")
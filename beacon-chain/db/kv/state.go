@@ -336,60 +336,65 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 			return err
 		}
 
-		bkt = tx.Bucket(stateBucket)
 		// Safeguard against deleting genesis, finalized, head state.
 		if bytes.Equal(blockRoot[:], finalized.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) || bytes.Equal(blockRoot[:], justified.Root) {
 			return ErrDeleteJustifiedAndFinalized
 		}
 
-		// Nothing to delete if state doesn't exist.
-		enc = bkt.Get(blockRoot[:])
-		if enc == nil {
-			return nil
-		}
+		return s.deleteStateTx(ctx, tx, blockRoot)
+	})
+}
 
-		slot, err := s.slotByBlockRoot(ctx, tx, blockRoot[:])
-		if err != nil {
-			return err
-		}
-		indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
-		if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
-			return errors.Wrap(err, "could not delete root for DB indices")
-		}
+// deleteStateTx removes the state for the given block root, along with its slot index and validator entry hashes,
+// using the given write transaction. It does not check whether the state is safe to delete.
+func (s *Store) deleteStateTx(ctx context.Context, tx *bolt.Tx, blockRoot [32]byte) error {
+	bkt := tx.Bucket(stateBucket)
+	// Nothing to delete if state doesn't exist.
+	if enc := bkt.Get(blockRoot[:]); enc == nil {
+		return nil
+	}
 
-		ok, err := s.isStateValidatorMigrationOver()
+	slot, err := s.slotByBlockRoot(ctx, tx, blockRoot[:])
+	if err != nil {
+		return err
+	}
+	indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
+	if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+		return errors.Wrap(err, "could not delete root for DB indices")
+	}
+
+	ok, err := s.isStateValidatorMigrationOver()
+	if err != nil {
+		return err
+	}
+	if ok {
+		// remove the validator entry keys for the corresponding state.
+		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
+		compressedValidatorHashes := idxBkt.Get(blockRoot[:])
+		err = idxBkt.Delete(blockRoot[:])
 		if err != nil {
 			return err
 		}
-		if ok {
-			// remove the validator entry keys for the corresponding state.
-			idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
-			compressedValidatorHashes := idxBkt.Get(blockRoot[:])
-			err = idxBkt.Delete(blockRoot[:])
-			if err != nil {
-				return err
-			}
 
-			// remove the respective validator entries from the cache.
-			if len(compressedValidatorHashes) == 0 {
-				return errors.Errorf("invalid compressed validator keys length")
-			}
-			validatorHashes, sErr := snappy.Decode(nil, compressedValidatorHashes)
-			if sErr != nil {
-				return errors.Wrap(sErr, "failed to uncompress validator keys")
-			}
-			if len(validatorHashes)%hashLength != 0 {
-				return errors.Errorf("invalid validator keys length: %d", len(validatorHashes))
-			}
-			for i := 0; i < len(validatorHashes); i += hashLength {
-				key := validatorHashes[i : i+hashLength]
-				s.validatorEntryCache.Del(key)
-				validatorEntryCacheDelete.Inc()
-			}
+		// remove the respective validator entries from the cache.
+		if len(compressedValidatorHashes) == 0 {
+			return errors.Errorf("invalid compressed validator keys length")
+		}
+		validatorHashes, sErr := snappy.Decode(nil, compressedValidatorHashes)
+		if sErr != nil {
+			return errors.Wrap(sErr, "failed to uncompress validator keys")
 		}
+		if len(validatorHashes)%hashLength != 0 {
+			return errors.Errorf("invalid validator keys length: %d", len(validatorHashes))
+		}
+		for i := 0; i < len(validatorHashes); i += hashLength {
+			key := validatorHashes[i : i+hashLength]
+			s.validatorEntryCache.Del(key)
+			validatorEntryCacheDelete.Inc()
+		}
+	}
 
-		return bkt.Delete(blockRoot[:])
-	})
+	return bkt.Delete(blockRoot[:])
 }
 
 // DeleteStates by block roots.
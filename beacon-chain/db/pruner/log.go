@@ -0,0 +1,5 @@
+package pruner
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "db-pruner")
@@ -0,0 +1,26 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	prunedBeforeSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "db_pruned_before_slot",
+		Help: "Slot below which finalized block and state history has been pruned from the beacon db.",
+	})
+	prunedBlocks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "db_pruned_blocks_total",
+		Help: "Number of finalized blocks removed from the beacon db by the pruner.",
+	})
+	pruneErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "db_prune_errors_total",
+		Help: "Number of failed pruning attempts.",
+	})
+	pruneDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "db_prune_duration_seconds",
+		Help:    "Time taken to prune finalized history from the beacon db.",
+		Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120},
+	})
+)
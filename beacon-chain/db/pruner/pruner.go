@@ -0,0 +1,198 @@
+// Package pruner defines a service which periodically removes finalized block and state history from
+// the beacon db, keeping only a configurable window of recent epochs.
+package pruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/beacon-chain/startup"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/time/slots"
+)
+
+// Database describes the set of DB methods used by the pruner.
+type Database interface {
+	FinalizedCheckpoint(ctx context.Context) (*qrysmpb.Checkpoint, error)
+	PrunedBeforeSlot(ctx context.Context) (primitives.Slot, error)
+	DeleteHistoricalDataBeforeSlot(ctx context.Context, cutoffSlot primitives.Slot) (int, error)
+	HighestRootsBelowSlot(ctx context.Context, slot primitives.Slot) (primitives.Slot, [][32]byte, error)
+}
+
+// PruneTracker is notified of the slot below which history was deleted. This is typically fulfilled by
+// backfill.Status, so that pruned slots are reported as unavailable.
+type PruneTracker interface {
+	MarkPruned(before primitives.Slot)
+}
+
+// Service prunes the finalized history of the beacon db once per epoch.
+type Service struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	db              Database
+	clockWaiter     startup.ClockWaiter
+	retentionEpochs primitives.Epoch
+	tracker         PruneTracker
+	prunedBefore    primitives.Slot
+}
+
+// Option is a functional option for the pruner service.
+type Option func(*Service) error
+
+// WithPruneTracker sets the value which is notified of the pruning cutoff after every successful prune.
+func WithPruneTracker(t PruneTracker) Option {
+	return func(s *Service) error {
+		s.tracker = t
+		return nil
+	}
+}
+
+// MinRetentionEpochs is the lowest allowed retention window. It matches MIN_EPOCHS_FOR_BLOCK_REQUESTS, the number
+// of epochs of blocks that peers must serve so that nodes syncing from a weak subjectivity checkpoint can do so.
+func MinRetentionEpochs() primitives.Epoch {
+	cfg := params.BeaconConfig()
+	return cfg.MinValidatorWithdrawabilityDelay + primitives.Epoch(cfg.ChurnLimitQuotient/2)
+}
+
+// New initializes the pruner service. A retention window shorter than MinRetentionEpochs is raised to it.
+func New(ctx context.Context, db Database, cw startup.ClockWaiter, retentionEpochs primitives.Epoch, opts ...Option) (*Service, error) {
+	if minRetention := MinRetentionEpochs(); retentionEpochs < minRetention {
+		log.WithFields(logrus.Fields{
+			"requested": retentionEpochs,
+			"minimum":   minRetention,
+		}).Warn("History retention window is shorter than the weak subjectivity minimum, using the minimum instead")
+		retentionEpochs = minRetention
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:             ctx,
+		cancel:          cancel,
+		db:              db,
+		clockWaiter:     cw,
+		retentionEpochs: retentionEpochs,
+	}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Start the pruning routine.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the pruning routine.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the pruner. A failed prune is retried on the next epoch and does not make the node unhealthy.
+func (*Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	clock, err := s.clockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Pruner failed to receive genesis data")
+		return
+	}
+	s.prunedBefore, err = s.db.PrunedBeforeSlot(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not read pruned history slot")
+		return
+	}
+	prunedBeforeSlot.Set(float64(s.prunedBefore))
+	log.WithFields(logrus.Fields{
+		"retentionEpochs": s.retentionEpochs,
+		"prunedBefore":    s.prunedBefore,
+	}).Info("Starting pruner of finalized history")
+
+	s.pruneAndLog(clock.CurrentSlot())
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if !slots.IsEpochStart(slot) {
+				continue
+			}
+			s.pruneAndLog(slot)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) pruneAndLog(current primitives.Slot) {
+	if err := s.prune(s.ctx, current); err != nil {
+		pruneErrors.Inc()
+		log.WithError(err).Error("Could not prune finalized history")
+	}
+}
+
+// prune deletes the history below the start of the retention window, which is capped at the finalized
+// checkpoint so that only finalized data is ever removed. Finalized states are only kept at archived points,
+// so the cutoff is lowered to the block of the last archived point, whose state the blocks above the cutoff
+// are replayed on.
+func (s *Service) prune(ctx context.Context, current primitives.Slot) error {
+	epoch := slots.ToEpoch(current)
+	if epoch <= s.retentionEpochs {
+		return nil
+	}
+	cutoff, err := slots.EpochStart(epoch - s.retentionEpochs)
+	if err != nil {
+		return err
+	}
+	f, err := s.db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	finalizedSlot, err := slots.EpochStart(f.Epoch)
+	if err != nil {
+		return err
+	}
+	if cutoff > finalizedSlot {
+		cutoff = finalizedSlot
+	}
+	archivedSlot := cutoff - cutoff%params.BeaconConfig().SlotsPerArchivedPoint
+	if archivedSlot == 0 {
+		return nil
+	}
+	// The state of an archived point is saved for the highest block at or below it.
+	cutoff, _, err = s.db.HighestRootsBelowSlot(ctx, archivedSlot+1)
+	if err != nil {
+		return errors.Wrapf(err, "could not get block of archived point %d", archivedSlot)
+	}
+	if cutoff <= s.prunedBefore {
+		return nil
+	}
+
+	start := time.Now()
+	deleted, err := s.db.DeleteHistoricalDataBeforeSlot(ctx, cutoff)
+	pruneDuration.Observe(time.Since(start).Seconds())
+	prunedBlocks.Add(float64(deleted))
+	if err != nil {
+		return errors.Wrapf(err, "could not delete history before slot %d", cutoff)
+	}
+	s.prunedBefore = cutoff
+	prunedBeforeSlot.Set(float64(cutoff))
+	if s.tracker != nil {
+		s.tracker.MarkPruned(cutoff)
+	}
+	log.WithFields(logrus.Fields{
+		"prunedBefore":  cutoff,
+		"deletedBlocks": deleted,
+		"duration":      time.Since(start),
+	}).Info("Pruned finalized history")
+	return nil
+}
@@ -0,0 +1,204 @@
+package pruner
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/theQRL/qrysm/beacon-chain/core/blocks"
+	"github.com/theQRL/qrysm/beacon-chain/core/transition"
+	dbtest "github.com/theQRL/qrysm/beacon-chain/db/testing"
+	doublylinkedtree "github.com/theQRL/qrysm/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/theQRL/qrysm/beacon-chain/startup"
+	"github.com/theQRL/qrysm/beacon-chain/state/stategen"
+	"github.com/theQRL/qrysm/config/params"
+	consensusblocks "github.com/theQRL/qrysm/consensus-types/blocks"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+type mockDB struct {
+	finalized *qrysmpb.Checkpoint
+	cutoffs   []primitives.Slot
+	skipped   map[primitives.Slot]bool
+	err       error
+}
+
+func (m *mockDB) FinalizedCheckpoint(context.Context) (*qrysmpb.Checkpoint, error) {
+	return m.finalized, nil
+}
+
+func (*mockDB) PrunedBeforeSlot(context.Context) (primitives.Slot, error) {
+	return 0, nil
+}
+
+func (m *mockDB) DeleteHistoricalDataBeforeSlot(_ context.Context, cutoff primitives.Slot) (int, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	m.cutoffs = append(m.cutoffs, cutoff)
+	return 1, nil
+}
+
+func (m *mockDB) HighestRootsBelowSlot(_ context.Context, slot primitives.Slot) (primitives.Slot, [][32]byte, error) {
+	slot--
+	for m.skipped[slot] {
+		slot--
+	}
+	return slot, [][32]byte{{'a'}}, nil
+}
+
+type mockTracker struct {
+	before primitives.Slot
+}
+
+func (m *mockTracker) MarkPruned(before primitives.Slot) {
+	m.before = before
+}
+
+func TestNew_ClampsRetention(t *testing.T) {
+	s, err := New(context.Background(), &mockDB{}, startup.NewClockSynchronizer(), 1)
+	require.NoError(t, err)
+	require.Equal(t, MinRetentionEpochs(), s.retentionEpochs)
+
+	s, err = New(context.Background(), &mockDB{}, startup.NewClockSynchronizer(), MinRetentionEpochs()+10)
+	require.NoError(t, err)
+	require.Equal(t, MinRetentionEpochs()+10, s.retentionEpochs)
+}
+
+func TestPrune(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SlotsPerArchivedPoint = cfg.SlotsPerEpoch
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	spe := params.BeaconConfig().SlotsPerEpoch
+	retention := MinRetentionEpochs()
+	db := &mockDB{finalized: &qrysmpb.Checkpoint{Epoch: retention + 100}}
+	tracker := &mockTracker{}
+	s, err := New(ctx, db, startup.NewClockSynchronizer(), retention, WithPruneTracker(tracker))
+	require.NoError(t, err)
+
+	// Nothing to prune while the chain is younger than the retention window.
+	require.NoError(t, s.prune(ctx, primitives.Slot(retention)*spe))
+	require.Equal(t, 0, len(db.cutoffs))
+
+	// The cutoff is the start of the retention window.
+	current := primitives.Slot(retention+50) * spe
+	require.NoError(t, s.prune(ctx, current))
+	require.DeepEqual(t, []primitives.Slot{50 * spe}, db.cutoffs)
+	require.Equal(t, 50*spe, tracker.before)
+
+	// The same cutoff is not pruned twice.
+	require.NoError(t, s.prune(ctx, current+1))
+	require.Equal(t, 1, len(db.cutoffs))
+
+	// The cutoff never goes above the finalized checkpoint.
+	require.NoError(t, s.prune(ctx, primitives.Slot(retention+500)*spe))
+	require.DeepEqual(t, []primitives.Slot{50 * spe, primitives.Slot(retention+100) * spe}, db.cutoffs)
+	require.Equal(t, primitives.Slot(retention+100)*spe, tracker.before)
+}
+
+func TestPrune_ArchivedPoint(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SlotsPerArchivedPoint = 4 * cfg.SlotsPerEpoch
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	spe := params.BeaconConfig().SlotsPerEpoch
+	retention := MinRetentionEpochs()
+	db := &mockDB{
+		finalized: &qrysmpb.Checkpoint{Epoch: retention + 100},
+		skipped:   map[primitives.Slot]bool{48 * spe: true},
+	}
+	s, err := New(ctx, db, startup.NewClockSynchronizer(), retention)
+	require.NoError(t, err)
+
+	// Nothing is pruned below the first archived point.
+	require.NoError(t, s.prune(ctx, primitives.Slot(retention+3)*spe))
+	require.Equal(t, 0, len(db.cutoffs))
+
+	// The cutoff is lowered to the last archived point.
+	require.NoError(t, s.prune(ctx, primitives.Slot(retention+45)*spe))
+	require.DeepEqual(t, []primitives.Slot{44 * spe}, db.cutoffs)
+
+	// The cutoff is lowered to the block of the archived point when its slot was skipped.
+	require.NoError(t, s.prune(ctx, primitives.Slot(retention+50)*spe))
+	require.DeepEqual(t, []primitives.Slot{44 * spe, 48*spe - 1}, db.cutoffs)
+}
+
+func TestPrune_Error(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SlotsPerArchivedPoint = cfg.SlotsPerEpoch
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	retention := MinRetentionEpochs()
+	db := &mockDB{finalized: &qrysmpb.Checkpoint{Epoch: retention + 100}, err: errors.New("bolt")}
+	tracker := &mockTracker{}
+	s, err := New(ctx, db, startup.NewClockSynchronizer(), retention, WithPruneTracker(tracker))
+	require.NoError(t, err)
+
+	current := primitives.Slot(retention+50) * params.BeaconConfig().SlotsPerEpoch
+	require.ErrorContains(t, "could not delete history", s.prune(ctx, current))
+	require.Equal(t, primitives.Slot(0), tracker.before)
+	require.Equal(t, primitives.Slot(0), s.prunedBefore)
+}
+
+func TestPrune_RegeneratesStatesAfterCutoff(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SlotsPerArchivedPoint = 2 * cfg.SlotsPerEpoch
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	spe := params.BeaconConfig().SlotsPerEpoch
+
+	st, keys := util.DeterministicGenesisStateZond(t, 32)
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(stateRoot[:])
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, st, genesisRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	// The slot of the archived point 2*spe is skipped, so its state is the one of the block before it.
+	blockSlots := []primitives.Slot{1, 2*spe - 1, 2*spe + 1, 3 * spe}
+	roots := make([][32]byte, len(blockSlots))
+	for i, slot := range blockSlots {
+		b, err := util.GenerateFullBlockZond(st, keys, util.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		wsb, err := consensusblocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, wsb)
+		require.NoError(t, err)
+		roots[i], err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		util.SaveBlock(t, ctx, beaconDB, b)
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &qrysmpb.StateSummary{Slot: slot, Root: roots[i][:]}))
+		if i == 1 || i == 3 {
+			require.NoError(t, beaconDB.SaveState(ctx, st.Copy(), roots[i]))
+		}
+	}
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &qrysmpb.Checkpoint{Epoch: 3, Root: roots[3][:]}))
+
+	s, err := New(ctx, beaconDB, startup.NewClockSynchronizer(), MinRetentionEpochs())
+	require.NoError(t, err)
+	require.NoError(t, s.prune(ctx, primitives.Slot(MinRetentionEpochs()+10)*spe))
+	require.Equal(t, 2*spe-1, s.prunedBefore)
+	require.Equal(t, false, beaconDB.HasBlock(ctx, roots[0]))
+	require.Equal(t, true, beaconDB.HasState(ctx, roots[1]))
+
+	// The state of the block above the cutoff is replayed from the state of the archived point.
+	regenerated, err := stategen.New(beaconDB, doublylinkedtree.New()).StateByRoot(ctx, roots[2])
+	require.NoError(t, err)
+	require.Equal(t, 2*spe+1, regenerated.Slot())
+}
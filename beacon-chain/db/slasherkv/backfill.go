@@ -0,0 +1,41 @@
+package slasherkv
+
+import (
+	"context"
+
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// BackfilledEpoch returns the last epoch of the chain history replayed by the slasher backfill,
+// and false when no history has been replayed yet.
+func (s *Store) BackfilledEpoch(ctx context.Context) (primitives.Epoch, bool, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.BackfilledEpoch")
+	defer span.End()
+	var epoch primitives.Epoch
+	var found bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(slasherMetadataBucket).Get(backfilledEpochKey)
+		if enc == nil {
+			return nil
+		}
+		found = true
+		return epoch.UnmarshalSSZ(enc)
+	})
+	return epoch, found, err
+}
+
+// SaveBackfilledEpoch records the last epoch of the chain history replayed by the slasher backfill,
+// so the replay can resume from the following epoch after a restart.
+func (s *Store) SaveBackfilledEpoch(ctx context.Context, epoch primitives.Epoch) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfilledEpoch")
+	defer span.End()
+	enc, err := epoch.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(slasherMetadataBucket).Put(backfilledEpochKey, enc)
+	})
+}
@@ -0,0 +1,31 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func TestStore_BackfilledEpoch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	_, found, err := beaconDB.BackfilledEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, false, found)
+
+	require.NoError(t, beaconDB.SaveBackfilledEpoch(ctx, 0))
+	epoch, found, err := beaconDB.BackfilledEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.Equal(t, primitives.Epoch(0), epoch)
+
+	require.NoError(t, beaconDB.SaveBackfilledEpoch(ctx, 42))
+	epoch, found, err = beaconDB.BackfilledEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.Equal(t, primitives.Epoch(42), epoch)
+}
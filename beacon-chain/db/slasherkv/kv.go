@@ -77,6 +77,9 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 			attestationDataRootsBucket,
 			proposalRecordsBucket,
 			slasherChunksBucket,
+			slasherMetadataBucket,
+			slashingRecordsBucket,
+			slashingRecordsByValidatorBucket,
 		)
 	}); err != nil {
 		return nil, err
@@ -8,9 +8,15 @@ package slasherkv
 // corresponding attestations.
 var (
 	// Slasher buckets.
-	attestedEpochsByValidator  = []byte("attested-epochs-by-validator")
-	attestationRecordsBucket   = []byte("attestation-records")
-	attestationDataRootsBucket = []byte("attestation-data-roots")
-	proposalRecordsBucket      = []byte("proposal-records")
-	slasherChunksBucket        = []byte("slasher-chunks")
+	attestedEpochsByValidator        = []byte("attested-epochs-by-validator")
+	attestationRecordsBucket         = []byte("attestation-records")
+	attestationDataRootsBucket       = []byte("attestation-data-roots")
+	proposalRecordsBucket            = []byte("proposal-records")
+	slasherChunksBucket              = []byte("slasher-chunks")
+	slasherMetadataBucket            = []byte("slasher-metadata")
+	slashingRecordsBucket            = []byte("slashing-records")
+	slashingRecordsByValidatorBucket = []byte("slashing-records-by-validator")
+
+	// Slasher metadata keys.
+	backfilledEpochKey = []byte("backfilled-epoch")
 )
@@ -0,0 +1,244 @@
+package slasherkv
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	slashertypes "github.com/theQRL/qrysm/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Slashing records are stored as the snappy compressed SSZ encoding of the container
+//
+//	class SlashingRecord(Container):
+//	    kind: uint8
+//	    status: uint8
+//	    detected_at: uint64  # Unix time in nanoseconds, 0 if unknown.
+//	    included_slot: Slot
+//	    included_block_root: Bytes32
+//	    rejection_reason: List[byte, MAX_REJECTION_REASON_LENGTH]
+//	    slashing: List[byte, MAX_SLASHING_LENGTH]  # SSZ encoded AttesterSlashing or ProposerSlashing.
+const slashingRecordFixedSize = 1 + 1 + 8 + 8 + 32 + 4 + 4
+
+const (
+	attesterSlashingKind = 0
+	proposerSlashingKind = 1
+)
+
+// slashingStatuses maps the statuses of slashing records to their encoding.
+var slashingStatuses = []slashertypes.SlashingStatus{
+	slashertypes.SlashingDetected,
+	slashertypes.SlashingPooled,
+	slashertypes.SlashingIncluded,
+	slashertypes.SlashingRejected,
+}
+
+// SlashingRecord retrieves the record of the slashing with the given offense epoch and hash tree root.
+// It returns nil if slasher has not detected the slashing.
+func (s *Store) SlashingRecord(
+	ctx context.Context, epoch primitives.Epoch, root [32]byte,
+) (*slashertypes.SlashingRecord, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SlashingRecord")
+	defer span.End()
+	var record *slashertypes.SlashingRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(slashingRecordsBucket).Get(keyForSlashingRecord(epoch, root))
+		if enc == nil {
+			return nil
+		}
+		decoded, err := decodeSlashingRecord(enc)
+		if err != nil {
+			return err
+		}
+		record = decoded
+		return nil
+	})
+	return record, err
+}
+
+// SlashingRecords retrieves the records of the slashings for offenses committed from the start epoch
+// to the end epoch included, ordered by epoch. It skips the first offset records and returns at most limit
+// records.
+func (s *Store) SlashingRecords(
+	ctx context.Context, startEpoch, endEpoch primitives.Epoch, offset, limit int,
+) ([]*slashertypes.SlashingRecord, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SlashingRecords")
+	defer span.End()
+	records := make([]*slashertypes.SlashingRecord, 0)
+	end := encodeTargetEpoch(endEpoch)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(slashingRecordsBucket).Cursor()
+		for k, v := c.Seek(encodeTargetEpoch(startEpoch)); k != nil && bytes.Compare(k[:8], end) <= 0 && len(records) < limit; k, v = c.Next() {
+			if offset > 0 {
+				offset--
+				continue
+			}
+			record, err := decodeSlashingRecord(v)
+			if err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+		return nil
+	})
+	return records, err
+}
+
+// ValidatorSlashingRecords retrieves the records of the slashings for offenses committed by a validator,
+// ordered by epoch. It skips the first offset records and returns at most limit records.
+func (s *Store) ValidatorSlashingRecords(
+	ctx context.Context, idx primitives.ValidatorIndex, offset, limit int,
+) ([]*slashertypes.SlashingRecord, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ValidatorSlashingRecords")
+	defer span.End()
+	records := make([]*slashertypes.SlashingRecord, 0)
+	prefix := encodeValidatorIndex(idx)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(slashingRecordsBucket)
+		c := tx.Bucket(slashingRecordsByValidatorBucket).Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix) && len(records) < limit; k, _ = c.Next() {
+			if offset > 0 {
+				offset--
+				continue
+			}
+			enc := bkt.Get(k[len(prefix):])
+			if enc == nil {
+				return errors.Errorf("no slashing record for index key %#x", k)
+			}
+			record, err := decodeSlashingRecord(enc)
+			if err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+		return nil
+	})
+	return records, err
+}
+
+// SaveSlashingRecord saves the record of a slashing, replacing any previous record of the same slashing,
+// and indexes it by the validators it slashes.
+func (s *Store) SaveSlashingRecord(ctx context.Context, record *slashertypes.SlashingRecord) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveSlashingRecord")
+	defer span.End()
+	root, err := record.Root()
+	if err != nil {
+		return errors.Wrap(err, "could not compute slashing root")
+	}
+	enc, err := encodeSlashingRecord(record)
+	if err != nil {
+		return err
+	}
+	key := keyForSlashingRecord(record.Epoch(), root)
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(slashingRecordsBucket).Put(key, enc); err != nil {
+			return err
+		}
+		bkt := tx.Bucket(slashingRecordsByValidatorBucket)
+		for _, idx := range record.ValidatorIndices() {
+			if err := bkt.Put(append(encodeValidatorIndex(idx), key...), []byte{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Slashing records are keyed by the epoch of the offense followed by the root of the slashing,
+// so they can be read by epoch range.
+func keyForSlashingRecord(epoch primitives.Epoch, root [32]byte) []byte {
+	return append(encodeTargetEpoch(epoch), root[:]...)
+}
+
+func encodeSlashingRecord(record *slashertypes.SlashingRecord) ([]byte, error) {
+	var kind uint8
+	var slashing []byte
+	var err error
+	switch {
+	case record.AttesterSlashing != nil:
+		kind = attesterSlashingKind
+		slashing, err = record.AttesterSlashing.MarshalSSZ()
+	case record.ProposerSlashing != nil:
+		kind = proposerSlashingKind
+		slashing, err = record.ProposerSlashing.MarshalSSZ()
+	default:
+		return nil, errors.New("slashing record has no slashing")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal slashing")
+	}
+	status := slices.Index(slashingStatuses, record.Status)
+	if status < 0 {
+		return nil, errors.Errorf("unknown slashing status %q", record.Status)
+	}
+	var detectedAt uint64
+	if !record.DetectedAt.IsZero() {
+		detectedAt = uint64(record.DetectedAt.UnixNano())
+	}
+
+	enc := make([]byte, 0, slashingRecordFixedSize+len(record.RejectionReason)+len(slashing))
+	enc = ssz.MarshalUint8(enc, kind)
+	enc = ssz.MarshalUint8(enc, uint8(status))
+	enc = ssz.MarshalUint64(enc, detectedAt)
+	enc = ssz.MarshalUint64(enc, uint64(record.IncludedSlot))
+	enc = append(enc, record.IncludedBlockRoot[:]...)
+	enc = ssz.WriteOffset(enc, slashingRecordFixedSize)
+	enc = ssz.WriteOffset(enc, slashingRecordFixedSize+len(record.RejectionReason))
+	enc = append(enc, record.RejectionReason...)
+	enc = append(enc, slashing...)
+	return snappy.Encode(nil, enc), nil
+}
+
+func decodeSlashingRecord(encoded []byte) (*slashertypes.SlashingRecord, error) {
+	enc, err := snappy.Decode(nil, encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress slashing record")
+	}
+	if len(enc) < slashingRecordFixedSize {
+		return nil, errors.Errorf("wrong length for encoded slashing record, want at least %d, got %d", slashingRecordFixedSize, len(enc))
+	}
+	kind := ssz.UnmarshallUint8(enc[0:1])
+	status := int(ssz.UnmarshallUint8(enc[1:2]))
+	if status >= len(slashingStatuses) {
+		return nil, errors.Errorf("unknown slashing status %d", status)
+	}
+	reasonOffset := ssz.ReadOffset(enc[50:54])
+	slashingOffset := ssz.ReadOffset(enc[54:58])
+	if reasonOffset != slashingRecordFixedSize || slashingOffset < reasonOffset || slashingOffset > uint64(len(enc)) {
+		return nil, ssz.ErrOffset
+	}
+	record := &slashertypes.SlashingRecord{
+		Status:            slashingStatuses[status],
+		RejectionReason:   string(enc[reasonOffset:slashingOffset]),
+		IncludedSlot:      primitives.Slot(ssz.UnmarshallUint64(enc[10:18])),
+		IncludedBlockRoot: bytesutil.ToBytes32(enc[18:50]),
+	}
+	if detectedAt := ssz.UnmarshallUint64(enc[2:10]); detectedAt != 0 {
+		record.DetectedAt = time.Unix(0, int64(detectedAt)).UTC()
+	}
+	slashing := enc[slashingOffset:]
+	switch kind {
+	case attesterSlashingKind:
+		record.AttesterSlashing = &qrysmpb.AttesterSlashing{}
+		if err := record.AttesterSlashing.UnmarshalSSZ(slashing); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal attester slashing")
+		}
+	case proposerSlashingKind:
+		record.ProposerSlashing = &qrysmpb.ProposerSlashing{}
+		if err := record.ProposerSlashing.UnmarshalSSZ(slashing); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal proposer slashing")
+		}
+	default:
+		return nil, errors.Errorf("unknown slashing kind %d", kind)
+	}
+	return record, nil
+}
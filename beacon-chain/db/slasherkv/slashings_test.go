@@ -0,0 +1,92 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	slashertypes "github.com/theQRL/qrysm/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func TestStore_SlashingRecords(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	detectedAt := time.Unix(1700000000, 0).UTC()
+
+	attesterRecord := &slashertypes.SlashingRecord{
+		AttesterSlashing: &qrysmpb.AttesterSlashing{
+			Attestation_1: createAttestationWrapper(1, 3, []uint64{1, 2}, []byte{1}).IndexedAttestation,
+			Attestation_2: createAttestationWrapper(2, 3, []uint64{2, 3}, []byte{2}).IndexedAttestation,
+		},
+		DetectedAt:      detectedAt,
+		Status:          slashertypes.SlashingRejected,
+		RejectionReason: "validator already exited/slashed",
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	proposerRecord := &slashertypes.SlashingRecord{
+		ProposerSlashing: &qrysmpb.ProposerSlashing{
+			Header_1: createProposalWrapper(t, 5*slotsPerEpoch, 4, []byte{1}).SignedBeaconBlockHeader,
+			Header_2: createProposalWrapper(t, 5*slotsPerEpoch, 4, []byte{2}).SignedBeaconBlockHeader,
+		},
+		DetectedAt:        detectedAt,
+		Status:            slashertypes.SlashingIncluded,
+		IncludedSlot:      5*slotsPerEpoch + 2,
+		IncludedBlockRoot: [32]byte{'a'},
+	}
+	require.NoError(t, beaconDB.SaveSlashingRecord(ctx, proposerRecord))
+	require.NoError(t, beaconDB.SaveSlashingRecord(ctx, attesterRecord))
+
+	records, err := beaconDB.SlashingRecords(ctx, 0, 10, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	assert.DeepSSZEqual(t, attesterRecord.AttesterSlashing, records[0].AttesterSlashing)
+	assert.Equal(t, attesterRecord.Status, records[0].Status)
+	assert.Equal(t, attesterRecord.RejectionReason, records[0].RejectionReason)
+	assert.Equal(t, true, detectedAt.Equal(records[0].DetectedAt))
+	assert.DeepEqual(t, []primitives.ValidatorIndex{2}, records[0].ValidatorIndices())
+	assert.DeepSSZEqual(t, proposerRecord.ProposerSlashing, records[1].ProposerSlashing)
+	assert.Equal(t, proposerRecord.IncludedSlot, records[1].IncludedSlot)
+	assert.Equal(t, proposerRecord.IncludedBlockRoot, records[1].IncludedBlockRoot)
+
+	records, err = beaconDB.SlashingRecords(ctx, 4, 5, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, primitives.Epoch(5), records[0].Epoch())
+	records, err = beaconDB.SlashingRecords(ctx, 0, 2, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+	records, err = beaconDB.SlashingRecords(ctx, 0, 10, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, primitives.Epoch(5), records[0].Epoch())
+
+	records, err = beaconDB.ValidatorSlashingRecords(ctx, 2, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.DeepSSZEqual(t, attesterRecord.AttesterSlashing, records[0].AttesterSlashing)
+	records, err = beaconDB.ValidatorSlashingRecords(ctx, 4, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.DeepSSZEqual(t, proposerRecord.ProposerSlashing, records[0].ProposerSlashing)
+	records, err = beaconDB.ValidatorSlashingRecords(ctx, 4, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+	records, err = beaconDB.ValidatorSlashingRecords(ctx, 1, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+
+	root, err := attesterRecord.Root()
+	require.NoError(t, err)
+	record, err := beaconDB.SlashingRecord(ctx, 3, root)
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.Equal(t, slashertypes.SlashingRejected, record.Status)
+	record, err = beaconDB.SlashingRecord(ctx, 4, root)
+	require.NoError(t, err)
+	assert.Equal(t, true, record == nil)
+}
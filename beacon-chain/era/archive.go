@@ -0,0 +1,301 @@
+package era
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+)
+
+// Era is the number of an era archive. Archive e holds the blocks of the slots
+// [(e-1)*SLOTS_PER_HISTORICAL_ROOT, e*SLOTS_PER_HISTORICAL_ROOT) and the state at slot e*SLOTS_PER_HISTORICAL_ROOT,
+// so that the block roots of the state cover the blocks of the archive. Archive 0 only holds the genesis state.
+type Era uint64
+
+// StateSlot returns the slot of the state of the archive.
+func (e Era) StateSlot() primitives.Slot {
+	return primitives.Slot(uint64(e) * uint64(params.BeaconConfig().SlotsPerHistoricalRoot))
+}
+
+// StartSlot returns the first slot of the blocks of the archive.
+func (e Era) StartSlot() primitives.Slot {
+	if e == 0 {
+		return 0
+	}
+	return (e - 1).StateSlot()
+}
+
+// FileName returns the name of the archive file, made of the network name, the era and the first 4 bytes of the
+// state root.
+func FileName(network string, e Era, stateRoot [32]byte) string {
+	return fmt.Sprintf("%s-%05d-%x.era", network, e, stateRoot[:4])
+}
+
+var fileNamePattern = regexp.MustCompile(`^.+-(\d{5,})-[0-9a-f]{8}\.era$`)
+
+// File is an archive file found on disk.
+type File struct {
+	Era  Era
+	Path string
+}
+
+// List returns the archive files of the directory, ordered by era.
+func List(dir string) ([]File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read era directory")
+	}
+	var files []File
+	for _, entry := range entries {
+		m := fileNamePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || m == nil {
+			continue
+		}
+		e, err := strconv.ParseUint(m[1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse era of %s", entry.Name())
+		}
+		files = append(files, File{Era: Era(e), Path: filepath.Join(dir, entry.Name())})
+	}
+	slices.SortFunc(files, func(a, b File) int {
+		return cmp.Compare(a.Era, b.Era)
+	})
+	return files, nil
+}
+
+// Writer writes an archive: a version record, the snappy compressed SSZ blocks in slot order, the snappy
+// compressed SSZ state, an index of the block offsets by slot and an index of the state offset.
+type Writer struct {
+	w         io.Writer
+	offset    int64
+	startSlot primitives.Slot
+	// The offsets of the blocks from the start of the archive, 0 for slots without a block.
+	blocks []int64
+}
+
+// NewWriter starts an archive whose blocks start at the given slot.
+func NewWriter(w io.Writer, startSlot primitives.Slot) (*Writer, error) {
+	n, err := writeRecord(w, versionType, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not write version")
+	}
+	return &Writer{w: w, offset: n, startSlot: startSlot}, nil
+}
+
+func (w *Writer) nextSlot() primitives.Slot {
+	return w.startSlot + primitives.Slot(len(w.blocks))
+}
+
+// AddBlock writes the SSZ encoded signed block of the slot. Blocks must be added in slot order.
+func (w *Writer) AddBlock(slot primitives.Slot, block []byte) error {
+	if slot < w.nextSlot() {
+		return errors.Errorf("block at slot %d is out of order, next slot is %d", slot, w.nextSlot())
+	}
+	for w.nextSlot() < slot {
+		w.blocks = append(w.blocks, 0)
+	}
+	data, err := compress(block)
+	if err != nil {
+		return errors.Wrap(err, "could not compress block")
+	}
+	n, err := writeRecord(w.w, blockType, data)
+	if err != nil {
+		return errors.Wrapf(err, "could not write block at slot %d", slot)
+	}
+	w.blocks = append(w.blocks, w.offset)
+	w.offset += n
+	return nil
+}
+
+// Finish writes the SSZ encoded state of the slot and the indices. The block index covers every slot from the start
+// slot up to the state slot.
+func (w *Writer) Finish(stateSlot primitives.Slot, state []byte) error {
+	if stateSlot < w.nextSlot() {
+		return errors.Errorf("state at slot %d is before the last block", stateSlot)
+	}
+	for w.nextSlot() < stateSlot {
+		w.blocks = append(w.blocks, 0)
+	}
+	data, err := compress(state)
+	if err != nil {
+		return errors.Wrap(err, "could not compress state")
+	}
+	stateOffset := w.offset
+	n, err := writeRecord(w.w, stateType, data)
+	if err != nil {
+		return errors.Wrap(err, "could not write state")
+	}
+	w.offset += n
+
+	if len(w.blocks) > 0 {
+		n, err := writeSlotIndex(w.w, w.offset, w.startSlot, w.blocks)
+		if err != nil {
+			return errors.Wrap(err, "could not write block index")
+		}
+		w.offset += n
+	}
+	n, err = writeSlotIndex(w.w, w.offset, stateSlot, []int64{stateOffset})
+	if err != nil {
+		return errors.Wrap(err, "could not write state index")
+	}
+	w.offset += n
+	return nil
+}
+
+// writeSlotIndex writes a slot index at the offset. The index holds the start slot, the offsets of the records
+// relative to the index and the number of offsets.
+func writeSlotIndex(w io.Writer, offset int64, startSlot primitives.Slot, offsets []int64) (int64, error) {
+	data := make([]byte, 8*(len(offsets)+2))
+	binary.LittleEndian.PutUint64(data, uint64(startSlot))
+	for i, o := range offsets {
+		if o != 0 {
+			binary.LittleEndian.PutUint64(data[8*(i+1):], uint64(o-offset))
+		}
+	}
+	binary.LittleEndian.PutUint64(data[len(data)-8:], uint64(len(offsets)))
+	return writeRecord(w, slotIndexType, data)
+}
+
+// Reader reads an archive written by a Writer.
+type Reader struct {
+	r         io.ReaderAt
+	closer    io.Closer
+	startSlot primitives.Slot
+	// The offsets of the blocks from the start of the archive, 0 for slots without a block.
+	blocks      []int64
+	stateSlot   primitives.Slot
+	stateOffset int64
+}
+
+// Open opens the archive file at the path.
+func Open(path string) (*Reader, error) {
+	f, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err == nil {
+		var r *Reader
+		if r, err = NewReader(f, info.Size()); err == nil {
+			r.closer = f
+			return r, nil
+		}
+	}
+	if cerr := f.Close(); cerr != nil {
+		log.WithError(cerr).WithField("path", path).Error("Could not close archive")
+	}
+	return nil, errors.Wrapf(err, "could not read archive %s", path)
+}
+
+// NewReader reads the indices of an archive of the given size.
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	if _, err := readRecord(r, 0, versionType); err != nil {
+		return nil, errors.Wrap(err, "could not read version")
+	}
+	// The state index holds a single offset.
+	stateIndexOffset := size - headerSize - 24
+	stateSlot, stateOffsets, err := readSlotIndex(r, stateIndexOffset)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state index")
+	}
+	if len(stateOffsets) != 1 || stateOffsets[0] == 0 {
+		return nil, errors.New("state index does not hold a state")
+	}
+	reader := &Reader{r: r, stateSlot: stateSlot, stateOffset: stateOffsets[0], startSlot: stateSlot}
+
+	// The block index, if any, sits between the state and the state index.
+	stateLen, err := recordLength(r, reader.stateOffset, stateType)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state")
+	}
+	blockIndexOffset := reader.stateOffset + headerSize + stateLen
+	if blockIndexOffset < stateIndexOffset {
+		reader.startSlot, reader.blocks, err = readSlotIndex(r, blockIndexOffset)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read block index")
+		}
+		if reader.startSlot+primitives.Slot(len(reader.blocks)) != stateSlot {
+			return nil, errors.Errorf("block index ends at slot %d, state is at slot %d",
+				reader.startSlot+primitives.Slot(len(reader.blocks)), stateSlot)
+		}
+	}
+	return reader, nil
+}
+
+// readSlotIndex reads the slot index at the offset and returns its start slot and the offsets of its records from
+// the start of the archive.
+func readSlotIndex(r io.ReaderAt, offset int64) (primitives.Slot, []int64, error) {
+	if offset < 0 {
+		return 0, nil, errors.New("archive is too short")
+	}
+	data, err := readRecord(r, offset, slotIndexType)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(data) < 16 || len(data)%8 != 0 {
+		return 0, nil, errors.Errorf("invalid slot index length %d", len(data))
+	}
+	count := binary.LittleEndian.Uint64(data[len(data)-8:])
+	if count != uint64(len(data)/8-2) {
+		return 0, nil, errors.Errorf("slot index count %d does not match its length %d", count, len(data))
+	}
+	offsets := make([]int64, count)
+	for i := range offsets {
+		if rel := int64(binary.LittleEndian.Uint64(data[8*(i+1):])); rel != 0 {
+			offsets[i] = offset + rel
+		}
+	}
+	return primitives.Slot(binary.LittleEndian.Uint64(data)), offsets, nil
+}
+
+// Close closes the archive file when the reader was created by Open.
+func (r *Reader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
+
+// StartSlot returns the first slot of the blocks of the archive.
+func (r *Reader) StartSlot() primitives.Slot {
+	return r.startSlot
+}
+
+// StateSlot returns the slot of the state of the archive.
+func (r *Reader) StateSlot() primitives.Slot {
+	return r.stateSlot
+}
+
+// Block returns the SSZ encoded signed block of the slot, or nil when the slot has no block.
+func (r *Reader) Block(slot primitives.Slot) ([]byte, error) {
+	if slot < r.startSlot || slot >= r.startSlot+primitives.Slot(len(r.blocks)) {
+		return nil, errors.Errorf("slot %d is not in the archive", slot)
+	}
+	offset := r.blocks[slot-r.startSlot]
+	if offset == 0 {
+		return nil, nil
+	}
+	data, err := readRecord(r.r, offset, blockType)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read block at slot %d", slot)
+	}
+	return decompress(data)
+}
+
+// State returns the SSZ encoded state of the archive.
+func (r *Reader) State() ([]byte, error) {
+	data, err := readRecord(r.r, r.stateOffset, stateType)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state")
+	}
+	return decompress(data)
+}
@@ -0,0 +1,100 @@
+package era
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func TestEra_Slots(t *testing.T) {
+	n := params.BeaconConfig().SlotsPerHistoricalRoot
+	require.Equal(t, primitives.Slot(0), Era(0).StartSlot())
+	require.Equal(t, primitives.Slot(0), Era(0).StateSlot())
+	require.Equal(t, primitives.Slot(0), Era(1).StartSlot())
+	require.Equal(t, n, Era(1).StateSlot())
+	require.Equal(t, 2*n, Era(3).StartSlot())
+	require.Equal(t, 3*n, Era(3).StateSlot())
+}
+
+func TestWriterReader_RoundTrip(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, 8)
+	require.NoError(t, err)
+	require.NoError(t, w.AddBlock(8, []byte("block 8")))
+	require.NoError(t, w.AddBlock(10, []byte("block 10")))
+	require.ErrorContains(t, "out of order", w.AddBlock(9, []byte("block 9")))
+	require.NoError(t, w.AddBlock(13, []byte("block 13")))
+	require.NoError(t, w.Finish(16, []byte("state 16")))
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(8), r.StartSlot())
+	require.Equal(t, primitives.Slot(16), r.StateSlot())
+	want := map[primitives.Slot][]byte{8: []byte("block 8"), 10: []byte("block 10"), 13: []byte("block 13")}
+	for slot := primitives.Slot(8); slot < 16; slot++ {
+		b, err := r.Block(slot)
+		require.NoError(t, err)
+		require.DeepEqual(t, want[slot], b)
+	}
+	_, err = r.Block(16)
+	require.ErrorContains(t, "not in the archive", err)
+	st, err := r.State()
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("state 16"), st)
+}
+
+func TestWriterReader_StateOnly(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, 0)
+	require.NoError(t, err)
+	require.NoError(t, w.Finish(0, []byte("genesis")))
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(0), r.StateSlot())
+	_, err = r.Block(0)
+	require.ErrorContains(t, "not in the archive", err)
+	st, err := r.State()
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("genesis"), st)
+}
+
+func TestNewReader_Corrupted(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, 0)
+	require.NoError(t, err)
+	require.NoError(t, w.AddBlock(1, []byte("block 1")))
+	require.NoError(t, w.Finish(4, []byte("state")))
+	b := buf.Bytes()
+
+	_, err = NewReader(bytes.NewReader(b[:len(b)-1]), int64(len(b)-1))
+	require.NotNil(t, err)
+	_, err = NewReader(bytes.NewReader(b[:4]), 4)
+	require.NotNil(t, err)
+}
+
+func TestList(t *testing.T) {
+	dir := t.TempDir()
+	root := [32]byte{0xde, 0xad, 0xbe, 0xef}
+	for _, name := range []string{
+		FileName("mainnet", 12, root),
+		FileName("mainnet", 2, root),
+		"mainnet-00003-deadbeef.era.tmp",
+		"notes.txt",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0600))
+	}
+	require.Equal(t, "mainnet-00012-deadbeef.era", FileName("mainnet", 12, root))
+
+	files, err := List(dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(files))
+	require.Equal(t, Era(2), files[0].Era)
+	require.Equal(t, Era(12), files[1].Era)
+	require.Equal(t, filepath.Join(dir, "mainnet-00012-deadbeef.era"), files[1].Path)
+}
@@ -0,0 +1,83 @@
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+// Record types of the e2store format that era archives are made of. Every record starts with an 8 byte header
+// holding its type, the little endian uint32 length of its data and two reserved zero bytes.
+var (
+	versionType   = [2]byte{0x65, 0x32}
+	blockType     = [2]byte{0x01, 0x00}
+	stateType     = [2]byte{0x02, 0x00}
+	slotIndexType = [2]byte{0x69, 0x32}
+)
+
+const headerSize = 8
+
+var errUnexpectedRecord = errors.New("unexpected record type")
+
+// writeRecord writes a record and returns the number of bytes written.
+func writeRecord(w io.Writer, t [2]byte, data []byte) (int64, error) {
+	if uint64(len(data)) > uint64(^uint32(0)) {
+		return 0, errors.Errorf("record of %d bytes is too large", len(data))
+	}
+	var header [headerSize]byte
+	copy(header[:2], t[:])
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(data)))
+	if _, err := w.Write(header[:]); err != nil {
+		return 0, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return 0, err
+	}
+	return int64(headerSize + len(data)), nil
+}
+
+// recordLength reads the header of the record at the offset, which must be of the given type, and returns the
+// length of its data.
+func recordLength(r io.ReaderAt, offset int64, t [2]byte) (int64, error) {
+	var header [headerSize]byte
+	if _, err := r.ReadAt(header[:], offset); err != nil {
+		return 0, errors.Wrapf(err, "could not read record header at offset %d", offset)
+	}
+	if header[0] != t[0] || header[1] != t[1] {
+		return 0, errors.Wrapf(errUnexpectedRecord, "got %#x at offset %d, wanted %#x", header[:2], offset, t)
+	}
+	return int64(binary.LittleEndian.Uint32(header[2:6])), nil
+}
+
+// readRecord reads the data of the record at the offset, which must be of the given type.
+func readRecord(r io.ReaderAt, offset int64, t [2]byte) ([]byte, error) {
+	n, err := recordLength(r, offset, t)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, n)
+	if _, err := r.ReadAt(data, offset+headerSize); err != nil {
+		return nil, errors.Wrapf(err, "could not read record at offset %d", offset)
+	}
+	return data, nil
+}
+
+// compress snappy compresses SSZ data with the framed format used by the req/resp protocol.
+func compress(data []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := snappy.NewBufferedWriter(buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	return io.ReadAll(snappy.NewReader(bytes.NewReader(data)))
+}
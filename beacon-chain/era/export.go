@@ -0,0 +1,190 @@
+package era
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/beacon-chain/db/iface"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/beacon-chain/state/stategen"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/time/slots"
+)
+
+// finalizedHistory lets the canonical history replay the finalized part of the chain only.
+type finalizedHistory struct {
+	db   iface.ReadOnlyDatabase
+	slot primitives.Slot
+}
+
+func (h *finalizedHistory) IsCanonical(ctx context.Context, blockRoot [32]byte) (bool, error) {
+	return h.db.IsFinalizedBlock(ctx, blockRoot), nil
+}
+
+func (h *finalizedHistory) CurrentSlot() primitives.Slot {
+	return h.slot
+}
+
+// LastFinalizedEra returns the last era whose state slot is finalized in the database.
+func LastFinalizedEra(ctx context.Context, db iface.ReadOnlyDatabase) (Era, error) {
+	cp, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	slot, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return 0, err
+	}
+	return Era(uint64(slot) / uint64(params.BeaconConfig().SlotsPerHistoricalRoot)), nil
+}
+
+// Export writes the archives of the eras [from, to] to the directory and returns their paths. Only finalized eras
+// can be exported.
+func Export(ctx context.Context, db iface.ReadOnlyDatabase, dir string, from, to Era) ([]string, error) {
+	if from > to {
+		return nil, errors.Errorf("start era %d is after end era %d", from, to)
+	}
+	last, err := LastFinalizedEra(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if to > last {
+		return nil, errors.Errorf("era %d is not finalized, the last finalized era is %d", to, last)
+	}
+	if err := os.MkdirAll(dir, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return nil, errors.Wrap(err, "could not create output directory")
+	}
+	history := &finalizedHistory{db: db, slot: last.StateSlot()}
+	ch := stategen.NewCanonicalHistory(db, history, history)
+	paths := make([]string, 0, to-from+1)
+	for e := from; e <= to; e++ {
+		path, err := exportEra(ctx, db, ch, dir, e)
+		if err != nil {
+			return paths, errors.Wrapf(err, "could not export era %d", e)
+		}
+		log.WithField("era", e).WithField("path", path).Info("Exported era")
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func exportEra(ctx context.Context, db iface.ReadOnlyDatabase, ch *stategen.CanonicalHistory, dir string, e Era) (string, error) {
+	st, err := eraState(ctx, db, ch, e)
+	if err != nil {
+		return "", err
+	}
+	stateSSZ, err := st.MarshalSSZ()
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal state")
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "could not compute state root")
+	}
+	path := filepath.Join(dir, FileName(params.BeaconConfig().ConfigName, e, stateRoot))
+
+	// The archive is written to a temporary file first so that an interrupted export does not leave a partial
+	// archive behind.
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return "", err
+	}
+	if err := writeEra(ctx, db, f, e, stateSSZ); err != nil {
+		if cerr := f.Close(); cerr != nil {
+			log.WithError(cerr).Error("Could not close archive")
+		}
+		if rerr := os.Remove(tmp); rerr != nil {
+			log.WithError(rerr).Error("Could not remove partial archive")
+		}
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return path, os.Rename(tmp, path)
+}
+
+func writeEra(ctx context.Context, db iface.ReadOnlyDatabase, f *os.File, e Era, stateSSZ []byte) error {
+	bw := bufio.NewWriter(f)
+	w, err := NewWriter(bw, e.StartSlot())
+	if err != nil {
+		return err
+	}
+	if e > 0 {
+		for slot := e.StartSlot(); slot < e.StateSlot(); slot++ {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// The genesis block is implied by the genesis state.
+			if slot == 0 {
+				continue
+			}
+			blk, err := finalizedBlock(ctx, db, slot)
+			if err != nil {
+				return err
+			}
+			if blk == nil {
+				continue
+			}
+			if blk.IsBlinded() {
+				return errors.Errorf("block at slot %d is stored without its execution payload, "+
+					"the node must run with --save-full-execution-payloads to export it", slot)
+			}
+			b, err := blk.MarshalSSZ()
+			if err != nil {
+				return errors.Wrapf(err, "could not marshal block at slot %d", slot)
+			}
+			if err := w.AddBlock(slot, b); err != nil {
+				return err
+			}
+		}
+	}
+	if err := w.Finish(e.StateSlot(), stateSSZ); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// eraState returns the finalized state at the state slot of the era.
+func eraState(ctx context.Context, db iface.ReadOnlyDatabase, ch *stategen.CanonicalHistory, e Era) (state.BeaconState, error) {
+	if e == 0 {
+		st, err := db.GenesisState(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get genesis state")
+		}
+		if st == nil || st.IsNil() {
+			return nil, errors.New("genesis state not found")
+		}
+		return st, nil
+	}
+	st, err := ch.ReplayerForSlot(e.StateSlot()-1).ReplayToSlot(ctx, e.StateSlot())
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not replay state to slot %d", e.StateSlot())
+	}
+	return st, nil
+}
+
+// finalizedBlock returns the finalized block of the slot, or nil when the slot was skipped.
+func finalizedBlock(ctx context.Context, db iface.ReadOnlyDatabase, slot primitives.Slot) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	_, roots, err := db.BlockRootsBySlot(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get block roots at slot %d", slot)
+	}
+	for _, root := range roots {
+		if !db.IsFinalizedBlock(ctx, root) {
+			continue
+		}
+		blk, err := db.Block(ctx, root)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get block %#x", root)
+		}
+		return blk, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,46 @@
+package era
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	testDB "github.com/theQRL/qrysm/beacon-chain/db/testing"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+func TestExport_Genesis(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	st, _ := util.DeterministicGenesisStateZond(t, 64)
+	require.NoError(t, db.SaveGenesisData(ctx, st))
+	dir := filepath.Join(t.TempDir(), "era")
+
+	_, err := Export(ctx, db, dir, 0, 1)
+	require.ErrorContains(t, "era 1 is not finalized", err)
+
+	paths, err := Export(ctx, db, dir, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(paths))
+	root, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, FileName(params.BeaconConfig().ConfigName, 0, root)), paths[0])
+
+	r, err := Open(paths[0])
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+	got, err := r.State()
+	require.NoError(t, err)
+	want, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	require.DeepEqual(t, want, got)
+
+	files, err := List(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	require.Equal(t, Era(0), files[0].Era)
+}
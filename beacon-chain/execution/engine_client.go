@@ -86,18 +86,29 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 		newPayloadLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
+	if s.multiplexed() {
+		return s.multiplexedNewPayload(ctx, payload)
+	}
 	d := time.Now().Add(time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue) * time.Second)
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
-	result := &pb.PayloadStatus{}
+	result, err := newPayloadCall(ctx, s.rpcClient, payload)
+	if err != nil {
+		return nil, err
+	}
+	return newPayloadVerdict(result)
+}
 
+// newPayloadCall sends the payload to a single execution client.
+func newPayloadCall(ctx context.Context, client RPCClient, payload interfaces.ExecutionData) (*pb.PayloadStatus, error) {
+	result := &pb.PayloadStatus{}
 	switch payload.Proto().(type) {
 	case *pb.ExecutionPayloadZond:
 		payloadPb, ok := payload.Proto().(*pb.ExecutionPayloadZond)
 		if !ok {
 			return nil, errors.New("execution data must be a Zond execution payload")
 		}
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV2, payloadPb)
+		err := client.CallContext(ctx, result, NewPayloadMethodV2, payloadPb)
 		if err != nil {
 			return nil, handleRPCError(err)
 		}
@@ -107,6 +118,12 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 	if result.ValidationError != "" {
 		log.WithError(errors.New(result.ValidationError)).Error("Got a validation error in newPayload")
 	}
+	return result, nil
+}
+
+// newPayloadVerdict converts the payload status returned by engine_newPayload to the latest valid hash and error
+// returned by NewPayload.
+func newPayloadVerdict(result *pb.PayloadStatus) ([]byte, error) {
 	switch result.Status {
 	case pb.PayloadStatus_INVALID_BLOCK_HASH:
 		return nil, ErrInvalidBlockHashPayloadStatus
@@ -132,34 +149,53 @@ func (s *Service) ForkchoiceUpdated(
 		forkchoiceUpdatedLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
+	if attrs == nil {
+		return nil, nil, errors.New("nil payload attributer")
+	}
+	if s.multiplexed() {
+		return s.multiplexedForkchoiceUpdated(ctx, state, attrs)
+	}
 	d := time.Now().Add(time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue) * time.Second)
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
-	result := &ForkchoiceUpdatedResponse{}
-
-	if attrs == nil {
-		return nil, nil, errors.New("nil payload attributer")
+	result, err := forkchoiceUpdatedCall(ctx, s.rpcClient, state, attrs)
+	if err != nil {
+		return nil, nil, err
 	}
+	return forkchoiceUpdatedVerdict(result)
+}
+
+// forkchoiceUpdatedCall sends the forkchoice state and payload attributes to a single execution client.
+func forkchoiceUpdatedCall(
+	ctx context.Context, client RPCClient, state *pb.ForkchoiceState, attrs payloadattribute.Attributer,
+) (*ForkchoiceUpdatedResponse, error) {
+	result := &ForkchoiceUpdatedResponse{}
 	switch attrs.Version() {
 	case version.Zond:
 		a, err := attrs.PbV2()
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethodV2, state, a)
+		err = client.CallContext(ctx, result, ForkchoiceUpdatedMethodV2, state, a)
 		if err != nil {
-			return nil, nil, handleRPCError(err)
+			return nil, handleRPCError(err)
 		}
 	default:
-		return nil, nil, fmt.Errorf("unknown payload attribute version: %v", attrs.Version())
+		return nil, fmt.Errorf("unknown payload attribute version: %v", attrs.Version())
 	}
 
 	if result.Status == nil {
-		return nil, nil, ErrNilResponse
+		return nil, ErrNilResponse
 	}
 	if result.ValidationError != "" {
 		log.WithError(errors.New(result.ValidationError)).Error("Got a validation error in forkChoiceUpdated")
 	}
+	return result, nil
+}
+
+// forkchoiceUpdatedVerdict converts the response of engine_forkchoiceUpdated to the payload ID, latest valid hash
+// and error returned by ForkchoiceUpdated.
+func forkchoiceUpdatedVerdict(result *ForkchoiceUpdatedResponse) (*pb.PayloadIDBytes, []byte, error) {
 	resp := result.Status
 	switch resp.Status {
 	case pb.PayloadStatus_SYNCING:
@@ -187,8 +223,18 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primit
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
 
+	client := s.rpcClient
+	var builder *engineEndpoint
+	if s.multiplexed() {
+		// The payload can only be retrieved from the execution client that builds it.
+		builder = s.payloadBuilder(payloadId)
+		client = builder.rpc()
+	}
 	result := &pb.ExecutionPayloadZondWithValue{}
-	err := s.rpcClient.CallContext(ctx, result, GetPayloadMethodV2, pb.PayloadIDBytes(payloadId))
+	err := client.CallContext(ctx, result, GetPayloadMethodV2, pb.PayloadIDBytes(payloadId))
+	if builder != nil {
+		builder.observe(GetPayloadMethodV2, err, time.Since(start))
+	}
 	if err != nil {
 		return nil, false, handleRPCError(err)
 	}
@@ -0,0 +1,412 @@
+package execution
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/go-qrl/qrlclient"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	payloadattribute "github.com/theQRL/qrysm/consensus-types/payload-attribute"
+	"github.com/theQRL/qrysm/io/logs"
+	"github.com/theQRL/qrysm/network"
+	pb "github.com/theQRL/qrysm/proto/engine/v1"
+)
+
+const (
+	// engineLatencyWeight is the weight of the latest call in the moving average of the latency of an endpoint.
+	engineLatencyWeight = 0.2
+	// maxPayloadBuilders is the number of payload IDs for which the building endpoint is remembered.
+	maxPayloadBuilders = 64
+)
+
+// engineEndpoint is an execution client that engine API calls are sent to, along with its health as observed from
+// the calls.
+type engineEndpoint struct {
+	endpoint network.Endpoint
+	// primary is set for the endpoint configured with --execution-endpoint, which uses the connection of the
+	// service. The fallback endpoints hold their own connection.
+	primary  bool
+	service  *Service
+	lock     sync.RWMutex
+	client   RPCClient
+	lastErr  error
+	failures uint64 // consecutive failed calls.
+	syncing  bool
+	latency  time.Duration
+}
+
+// engineResult is the response of a single execution client to an engine API call.
+type engineResult struct {
+	engine    *engineEndpoint
+	status    *pb.PayloadStatus
+	payloadID *pb.PayloadIDBytes
+	err       error
+}
+
+// decisive returns true if the result is a VALID or INVALID verdict.
+func (r *engineResult) decisive() bool {
+	if r.err != nil || r.status == nil {
+		return false
+	}
+	return r.status.Status == pb.PayloadStatus_VALID || r.status.Status == pb.PayloadStatus_INVALID
+}
+
+func (e *engineEndpoint) name() string {
+	return logs.MaskCredentialsLogging(e.endpoint.Url)
+}
+
+func (e *engineEndpoint) rpc() RPCClient {
+	if e.primary {
+		return e.service.rpcClient
+	}
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	if e.client == nil {
+		return RPCClientEmpty{}
+	}
+	return e.client
+}
+
+// observe records the outcome of a call to the endpoint.
+func (e *engineEndpoint) observe(method string, err error, elapsed time.Duration) {
+	engineEndpointLatency.WithLabelValues(e.name(), method).Observe(float64(elapsed.Milliseconds()))
+	if err != nil {
+		engineEndpointErrors.WithLabelValues(e.name(), method).Inc()
+	}
+	e.lock.Lock()
+	if err != nil {
+		e.failures++
+		e.lastErr = err
+	} else {
+		e.failures = 0
+		e.lastErr = nil
+		if e.latency == 0 {
+			e.latency = elapsed
+		} else {
+			e.latency = time.Duration(engineLatencyWeight*float64(elapsed) + (1-engineLatencyWeight)*float64(e.latency))
+		}
+	}
+	e.lock.Unlock()
+	e.updateHealthMetric()
+}
+
+// observeStatus records whether the execution client is still syncing, according to the payload status it returned.
+func (e *engineEndpoint) observeStatus(status pb.PayloadStatus_Status) {
+	e.lock.Lock()
+	e.syncing = status == pb.PayloadStatus_SYNCING || status == pb.PayloadStatus_ACCEPTED
+	e.lock.Unlock()
+	e.updateHealthMetric()
+}
+
+// healthy returns true if the last call to the endpoint succeeded and the execution client is synced.
+func (e *engineEndpoint) healthy() bool {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return e.failures == 0 && !e.syncing
+}
+
+func (e *engineEndpoint) updateHealthMetric() {
+	v := 0.0
+	if e.healthy() {
+		v = 1
+	}
+	engineEndpointHealthy.WithLabelValues(e.name()).Set(v)
+}
+
+// healthierThan returns true if the endpoint is in a better state than the other one. Endpoints with fewer
+// consecutive failures are preferred, then synced ones, then the ones with the lowest latency.
+func (e *engineEndpoint) healthierThan(other *engineEndpoint) bool {
+	e.lock.RLock()
+	failures, syncing, latency := e.failures, e.syncing, e.latency
+	e.lock.RUnlock()
+	other.lock.RLock()
+	defer other.lock.RUnlock()
+	if failures != other.failures {
+		return failures < other.failures
+	}
+	if syncing != other.syncing {
+		return !syncing
+	}
+	return latency < other.latency
+}
+
+// multiplexed returns true if engine API calls are sent to more than one execution client.
+func (s *Service) multiplexed() bool {
+	return len(s.engines) > 1
+}
+
+// healthiestEngine returns the endpoint payloads are built on. Ties go to the endpoint configured first.
+func (s *Service) healthiestEngine() *engineEndpoint {
+	best := s.engines[0]
+	for _, e := range s.engines[1:] {
+		if e.healthierThan(best) {
+			best = e
+		}
+	}
+	return best
+}
+
+// recordPayloadBuilder remembers the endpoint that builds the payload with the given ID, so that the payload is
+// retrieved from it.
+func (s *Service) recordPayloadBuilder(id pb.PayloadIDBytes, e *engineEndpoint) {
+	s.payloadBuildersLock.Lock()
+	defer s.payloadBuildersLock.Unlock()
+	if len(s.payloadBuilders) >= maxPayloadBuilders {
+		s.payloadBuilders = make(map[pb.PayloadIDBytes]*engineEndpoint)
+	}
+	s.payloadBuilders[id] = e
+}
+
+// payloadBuilder returns the endpoint that builds the payload with the given ID, or the healthiest endpoint if it
+// is not known.
+func (s *Service) payloadBuilder(id [8]byte) *engineEndpoint {
+	s.payloadBuildersLock.Lock()
+	e, ok := s.payloadBuilders[id]
+	s.payloadBuildersLock.Unlock()
+	if ok {
+		return e
+	}
+	return s.healthiestEngine()
+}
+
+// multiplexEngineCall sends an engine API call to every execution client. The first VALID or INVALID verdict is
+// returned without waiting for the other clients, except for waitFor, whose result is awaited and returned as the
+// second value. The verdicts that arrive later are compared with the returned one in the background. If no client
+// returns a verdict, the successful result of the endpoint configured first is returned, or an error.
+func (s *Service) multiplexEngineCall(
+	ctx context.Context,
+	method string,
+	waitFor *engineEndpoint,
+	call func(context.Context, *engineEndpoint) *engineResult,
+) (*engineResult, *engineResult) {
+	// The calls are not cancelled when the caller returns, so that every execution client receives them.
+	d := time.Now().Add(time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue) * time.Second)
+	callCtx, cancel := context.WithDeadline(context.WithoutCancel(ctx), d)
+	results := make(chan *engineResult, len(s.engines))
+	for _, e := range s.engines {
+		go func(e *engineEndpoint) {
+			start := time.Now()
+			r := call(callCtx, e)
+			r.engine = e
+			e.observe(method, r.err, time.Since(start))
+			if r.err == nil && r.status != nil {
+				e.observeStatus(r.status.Status)
+			}
+			results <- r
+		}(e)
+	}
+
+	var chosen, waited *engineResult
+	received := make([]*engineResult, 0, len(s.engines))
+	for len(received) < len(s.engines) && (chosen == nil || (waitFor != nil && waited == nil)) {
+		r := <-results
+		received = append(received, r)
+		if r.engine == waitFor {
+			waited = r
+		}
+		if chosen == nil && r.decisive() {
+			chosen = r
+		}
+	}
+	if chosen == nil {
+		cancel()
+		return s.firstSuccessfulResult(received), waited
+	}
+	for _, r := range received {
+		compareVerdicts(method, chosen, r)
+	}
+	remaining := len(s.engines) - len(received)
+	go func() {
+		defer cancel()
+		for range remaining {
+			compareVerdicts(method, chosen, <-results)
+		}
+	}()
+	return chosen, waited
+}
+
+// firstSuccessfulResult returns the successful result of the endpoint configured first, or the error of the
+// endpoint configured first if every call failed.
+func (s *Service) firstSuccessfulResult(results []*engineResult) *engineResult {
+	var first *engineResult
+	for _, e := range s.engines {
+		for _, r := range results {
+			if r.engine != e {
+				continue
+			}
+			if r.err == nil {
+				return r
+			}
+			if first == nil {
+				first = r
+			}
+		}
+	}
+	return first
+}
+
+// compareVerdicts flags a VALID or INVALID verdict that disagrees with the one that was used.
+func compareVerdicts(method string, chosen *engineResult, r *engineResult) {
+	if r == chosen || !r.decisive() || r.status.Status == chosen.status.Status {
+		return
+	}
+	engineVerdictDisagreements.WithLabelValues(method).Inc()
+	log.WithFields(logrus.Fields{
+		"method":        method,
+		"endpoint":      chosen.engine.name(),
+		"status":        chosen.status.Status.String(),
+		"otherEndpoint": r.engine.name(),
+		"otherStatus":   r.status.Status.String(),
+	}).Warn("Execution clients disagree on payload validity")
+}
+
+// multiplexedNewPayload sends the payload to every execution client and uses the first verdict.
+func (s *Service) multiplexedNewPayload(ctx context.Context, payload interfaces.ExecutionData) ([]byte, error) {
+	result, _ := s.multiplexEngineCall(ctx, NewPayloadMethodV2, nil, func(ctx context.Context, e *engineEndpoint) *engineResult {
+		status, err := newPayloadCall(ctx, e.rpc(), payload)
+		return &engineResult{status: status, err: err}
+	})
+	if result.err != nil {
+		return nil, result.err
+	}
+	return newPayloadVerdict(result.status)
+}
+
+// multiplexedForkchoiceUpdated sends the forkchoice state to every execution client and uses the first verdict.
+// The payload attributes are only sent to the healthiest client, which builds the payload.
+func (s *Service) multiplexedForkchoiceUpdated(
+	ctx context.Context, state *pb.ForkchoiceState, attrs payloadattribute.Attributer,
+) (*pb.PayloadIDBytes, []byte, error) {
+	a, err := attrs.PbV2()
+	if err != nil {
+		return nil, nil, err
+	}
+	var builder *engineEndpoint
+	if a != nil {
+		builder = s.healthiestEngine()
+	}
+	noAttrs := payloadattribute.EmptyWithVersion(attrs.Version())
+	result, built := s.multiplexEngineCall(ctx, ForkchoiceUpdatedMethodV2, builder, func(ctx context.Context, e *engineEndpoint) *engineResult {
+		engineAttrs := noAttrs
+		if e == builder {
+			engineAttrs = attrs
+		}
+		resp, err := forkchoiceUpdatedCall(ctx, e.rpc(), state, engineAttrs)
+		if err != nil {
+			return &engineResult{err: err}
+		}
+		return &engineResult{status: resp.Status, payloadID: resp.PayloadId}
+	})
+	if result.err != nil {
+		return nil, nil, result.err
+	}
+	payloadID, lvh, err := forkchoiceUpdatedVerdict(&ForkchoiceUpdatedResponse{Status: result.status, PayloadId: result.payloadID})
+	if err != nil || builder == nil {
+		return payloadID, lvh, err
+	}
+	// The payload ID is only valid on the execution client that builds the payload.
+	payloadID = nil
+	if built != nil && built.err == nil && built.payloadID != nil {
+		payloadID = built.payloadID
+		s.recordPayloadBuilder(*payloadID, builder)
+		log.WithField("endpoint", builder.name()).Debug("Building payload on execution client")
+	}
+	return payloadID, lvh, nil
+}
+
+// connectFallbackEngines dials the fallback engine endpoints that are not connected, or whose last call failed.
+func (s *Service) connectFallbackEngines(ctx context.Context) {
+	for _, e := range s.engines {
+		if e.primary {
+			continue
+		}
+		e.lock.RLock()
+		connected := e.client != nil && e.failures == 0
+		e.lock.RUnlock()
+		if connected {
+			continue
+		}
+		client, err := s.newRPCClientWithAuth(ctx, e.endpoint)
+		if err == nil {
+			if err = ensureCorrectExecutionChain(ctx, qrlclient.NewClient(client)); err != nil {
+				client.Close()
+			}
+		}
+		if err != nil {
+			e.observe("connect", err, 0)
+			log.WithError(err).WithField("endpoint", e.name()).Debug("Could not connect to fallback execution client")
+			continue
+		}
+		e.lock.Lock()
+		prev := e.client
+		e.client = client
+		e.lock.Unlock()
+		if prev != nil {
+			prev.Close()
+		}
+		e.observe("connect", nil, 0)
+		log.WithField("endpoint", e.name()).Info("Connected to fallback execution client")
+	}
+}
+
+// monitorFallbackEngines redials the fallback engine endpoints that are not reachable, so that the beacon node
+// switches back to them once they recover.
+func (s *Service) monitorFallbackEngines(ctx context.Context) {
+	ticker := time.NewTicker(backOffPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.connectFallbackEngines(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// closeFallbackEngines closes the connections to the fallback engine endpoints.
+func (s *Service) closeFallbackEngines() {
+	for _, e := range s.engines {
+		if e.primary {
+			continue
+		}
+		e.lock.Lock()
+		if e.client != nil {
+			e.client.Close()
+			e.client = nil
+		}
+		e.lock.Unlock()
+	}
+}
+
+// ExecutionEndpoints returns the URLs of all the execution endpoints.
+func (s *Service) ExecutionEndpoints() []string {
+	if !s.multiplexed() {
+		return []string{s.ExecutionClientEndpoint()}
+	}
+	urls := make([]string, len(s.engines))
+	for i, e := range s.engines {
+		urls[i] = e.endpoint.Url
+	}
+	return urls
+}
+
+// ExecutionConnectionErrors returns the last error of each execution endpoint, in the order of ExecutionEndpoints.
+func (s *Service) ExecutionConnectionErrors() []error {
+	if !s.multiplexed() {
+		return []error{s.ExecutionClientConnectionErr()}
+	}
+	errs := make([]error, len(s.engines))
+	for i, e := range s.engines {
+		e.lock.RLock()
+		errs[i] = e.lastErr
+		e.lock.RUnlock()
+		if errs[i] == nil && e.primary {
+			errs[i] = s.ExecutionClientConnectionErr()
+		}
+	}
+	return errs
+}
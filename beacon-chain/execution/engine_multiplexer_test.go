@@ -0,0 +1,181 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/theQRL/go-qrl/rpc"
+	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/consensus-types/blocks"
+	payloadattribute "github.com/theQRL/qrysm/consensus-types/payload-attribute"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	"github.com/theQRL/qrysm/network"
+	pb "github.com/theQRL/qrysm/proto/engine/v1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+// fakeEngineClient answers engine API calls with a fixed payload status, after an optional delay.
+type fakeEngineClient struct {
+	status    pb.PayloadStatus_Status
+	payloadID *pb.PayloadIDBytes
+	payload   *pb.ExecutionPayloadZondWithValue
+	err       error
+	delay     time.Duration
+
+	lock  sync.Mutex
+	calls map[string]int
+	attrs []*pb.PayloadAttributesV2
+}
+
+func (*fakeEngineClient) Close() {}
+
+func (*fakeEngineClient) BatchCall([]rpc.BatchElem) error {
+	return nil
+}
+
+func (c *fakeEngineClient) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	c.lock.Lock()
+	if c.calls == nil {
+		c.calls = make(map[string]int)
+	}
+	c.calls[method]++
+	if method == ForkchoiceUpdatedMethodV2 {
+		a, _ := args[1].(*pb.PayloadAttributesV2)
+		c.attrs = append(c.attrs, a)
+	}
+	c.lock.Unlock()
+
+	select {
+	case <-time.After(c.delay):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if c.err != nil {
+		return c.err
+	}
+	status := &pb.PayloadStatus{Status: c.status, LatestValidHash: bytesutil.PadTo([]byte{uint8(c.status)}, 32)}
+	switch r := result.(type) {
+	case *pb.PayloadStatus:
+		*r = *status
+	case *ForkchoiceUpdatedResponse:
+		r.Status = status
+		r.PayloadId = c.payloadID
+	case *pb.ExecutionPayloadZondWithValue:
+		r.Payload = c.payload.Payload
+		r.Value = c.payload.Value
+	}
+	return nil
+}
+
+func (c *fakeEngineClient) callCount(method string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.calls[method]
+}
+
+func (c *fakeEngineClient) payloadAttributes() []*pb.PayloadAttributesV2 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.attrs
+}
+
+func newMultiplexedService(clients ...*fakeEngineClient) *Service {
+	s := &Service{
+		cfg:             &config{},
+		rpcClient:       clients[0],
+		payloadBuilders: make(map[pb.PayloadIDBytes]*engineEndpoint),
+	}
+	for i, c := range clients {
+		e := &engineEndpoint{endpoint: network.HttpEndpoint("http://engine" + string(rune('a'+i))), primary: i == 0, service: s}
+		if i > 0 {
+			e.client = c
+		}
+		s.engines = append(s.engines, e)
+	}
+	return s
+}
+
+func TestMultiplexer_NewPayload(t *testing.T) {
+	ctx := context.Background()
+	payload, ok := fixtures()["ExecutionPayloadZond"].(*pb.ExecutionPayloadZond)
+	require.Equal(t, true, ok)
+	wrapped, err := blocks.WrappedExecutionPayloadZond(payload, 0)
+	require.NoError(t, err)
+
+	t.Run("first verdict wins", func(t *testing.T) {
+		slow := &fakeEngineClient{status: pb.PayloadStatus_INVALID, delay: 100 * time.Millisecond}
+		fast := &fakeEngineClient{status: pb.PayloadStatus_VALID}
+		s := newMultiplexedService(slow, fast)
+		lvh, err := s.NewPayload(ctx, wrapped)
+		require.NoError(t, err)
+		assert.DeepEqual(t, bytesutil.PadTo([]byte{uint8(pb.PayloadStatus_VALID)}, 32), lvh)
+	})
+	t.Run("syncing endpoint does not decide", func(t *testing.T) {
+		syncing := &fakeEngineClient{status: pb.PayloadStatus_SYNCING}
+		valid := &fakeEngineClient{status: pb.PayloadStatus_VALID, delay: 20 * time.Millisecond}
+		s := newMultiplexedService(syncing, valid)
+		_, err := s.NewPayload(ctx, wrapped)
+		require.NoError(t, err)
+		assert.Equal(t, false, s.engines[0].healthy())
+		assert.Equal(t, true, s.engines[1].healthy())
+	})
+	t.Run("no verdict", func(t *testing.T) {
+		down := &fakeEngineClient{err: errors.New("connection refused")}
+		syncing := &fakeEngineClient{status: pb.PayloadStatus_SYNCING}
+		s := newMultiplexedService(down, syncing)
+		_, err := s.NewPayload(ctx, wrapped)
+		require.ErrorIs(t, err, ErrAcceptedSyncingPayloadStatus)
+	})
+	t.Run("all endpoints fail", func(t *testing.T) {
+		s := newMultiplexedService(
+			&fakeEngineClient{err: errors.New("connection refused")},
+			&fakeEngineClient{err: errors.New("timeout")},
+		)
+		_, err := s.NewPayload(ctx, wrapped)
+		require.ErrorContains(t, "connection refused", err)
+		errs := s.ExecutionConnectionErrors()
+		require.Equal(t, 2, len(errs))
+		require.ErrorContains(t, "timeout", errs[1])
+	})
+}
+
+func TestMultiplexer_BuildsOnHealthiestEndpoint(t *testing.T) {
+	ctx := context.Background()
+	fix := fixtures()
+	withValue, ok := fix["ExecutionPayloadZondWithValue"].(*pb.ExecutionPayloadZondWithValue)
+	require.Equal(t, true, ok)
+	id := pb.PayloadIDBytes{1}
+
+	primary := &fakeEngineClient{status: pb.PayloadStatus_VALID, payloadID: &pb.PayloadIDBytes{2}, payload: withValue}
+	fallback := &fakeEngineClient{status: pb.PayloadStatus_VALID, payloadID: &id, payload: withValue, delay: 50 * time.Millisecond}
+	s := newMultiplexedService(primary, fallback)
+	// The primary endpoint failed its last call, so the payload is built on the fallback.
+	s.engines[0].observe(NewPayloadMethodV2, errors.New("connection refused"), 0)
+
+	attrs, err := payloadattribute.New(&pb.PayloadAttributesV2{
+		Timestamp:             1,
+		PrevRandao:            make([]byte, 32),
+		SuggestedFeeRecipient: make([]byte, fieldparams.FeeRecipientLength),
+		Withdrawals:           []*pb.Withdrawal{},
+	})
+	require.NoError(t, err)
+	payloadID, _, err := s.ForkchoiceUpdated(ctx, &pb.ForkchoiceState{}, attrs)
+	require.NoError(t, err)
+	require.DeepEqual(t, &id, payloadID)
+
+	// Only the endpoint that builds the payload receives the payload attributes.
+	primaryAttrs, fallbackAttrs := primary.payloadAttributes(), fallback.payloadAttributes()
+	require.Equal(t, 1, len(primaryAttrs))
+	assert.Equal(t, true, primaryAttrs[0] == nil)
+	require.Equal(t, 1, len(fallbackAttrs))
+	assert.NotNil(t, fallbackAttrs[0])
+
+	_, _, err = s.GetPayload(ctx, id, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fallback.callCount(GetPayloadMethodV2))
+	assert.Equal(t, 0, primary.callCount(GetPayloadMethodV2))
+}
@@ -71,4 +71,24 @@ var (
 		Name: "execution_payload_bodies_count",
 		Help: "The number of requested payload bodies is too large",
 	})
+	engineEndpointHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "execution_engine_endpoint_healthy",
+		Help: "Whether the last engine API call to the execution endpoint succeeded and the execution client is synced",
+	}, []string{"endpoint"})
+	engineEndpointLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "execution_engine_endpoint_latency_milliseconds",
+			Help:    "Captures engine API latency per execution endpoint in milliseconds",
+			Buckets: []float64{25, 50, 100, 200, 500, 1000, 2000, 4000},
+		},
+		[]string{"endpoint", "method"},
+	)
+	engineEndpointErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "execution_engine_endpoint_errors_total",
+		Help: "The number of failed engine API calls per execution endpoint",
+	}, []string{"endpoint", "method"})
+	engineVerdictDisagreements = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "execution_engine_verdict_disagreements_total",
+		Help: "The number of times execution endpoints returned disagreeing VALID and INVALID verdicts",
+	}, []string{"method"})
 )
@@ -37,6 +37,15 @@ func WithHttpEndpointAndJWTSecret(endpointString string, secret []byte) Option {
 	}
 }
 
+// WithFallbackEngineEndpoints adds execution endpoints that engine API calls are multiplexed to, along with the
+// endpoint set by WithHttpEndpoint.
+func WithFallbackEngineEndpoints(endpoints []network.Endpoint) Option {
+	return func(s *Service) error {
+		s.cfg.fallbackEndpoints = endpoints
+		return nil
+	}
+}
+
 // WithHeaders adds headers to the execution node JSON-RPC requests.
 func WithHeaders(headers []string) Option {
 	return func(s *Service) error {
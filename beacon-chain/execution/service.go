@@ -37,6 +37,7 @@ import (
 	"github.com/theQRL/qrysm/encoding/bytesutil"
 	"github.com/theQRL/qrysm/monitoring/clientstats"
 	"github.com/theQRL/qrysm/network"
+	pb "github.com/theQRL/qrysm/proto/engine/v1"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 	qrysmTime "github.com/theQRL/qrysm/time"
 	"github.com/theQRL/qrysm/time/slots"
@@ -76,6 +77,8 @@ type ChainInfoFetcher interface {
 	ExecutionClientConnected() bool
 	ExecutionClientEndpoint() string
 	ExecutionClientConnectionErr() error
+	ExecutionEndpoints() []string
+	ExecutionConnectionErrors() []error
 }
 
 // ExecutionBlockFetcher defines a struct that can retrieve execution chain blocks.
@@ -122,6 +125,7 @@ type config struct {
 	executionHeaderReqLimit uint64
 	beaconNodeStatsUpdater  BeaconNodeStatsUpdater
 	currHttpEndpoint        network.Endpoint
+	fallbackEndpoints       []network.Endpoint
 	headers                 []string
 	finalizedStateAtStartup state.BeaconState
 }
@@ -154,6 +158,9 @@ type Service struct {
 	runError                error
 	preGenesisState         state.BeaconState
 	httpLogger              bind.ContractFilterer
+	engines                 []*engineEndpoint // engine API endpoints, only set with fallback endpoints.
+	payloadBuildersLock     sync.Mutex
+	payloadBuilders         map[pb.PayloadIDBytes]*engineEndpoint
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
@@ -204,6 +211,13 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 			return nil, err
 		}
 	}
+	if len(s.cfg.fallbackEndpoints) > 0 {
+		s.engines = []*engineEndpoint{{endpoint: s.cfg.currHttpEndpoint, primary: true, service: s}}
+		for _, e := range s.cfg.fallbackEndpoints {
+			s.engines = append(s.engines, &engineEndpoint{endpoint: e, service: s})
+		}
+		s.payloadBuilders = make(map[pb.PayloadIDBytes]*engineEndpoint)
+	}
 
 	executionData, err := s.validExecutionChainData(ctx)
 	if err != nil {
@@ -221,6 +235,11 @@ func (s *Service) Start() {
 		log.WithError(err).Error("Could not connect to execution endpoint")
 	}
 
+	if s.multiplexed() {
+		s.connectFallbackEngines(s.ctx)
+		go s.monitorFallbackEngines(s.ctx)
+	}
+
 	s.isRunning = true
 
 	// Poll the execution client connection and fallback if errors occur.
@@ -237,6 +256,7 @@ func (s *Service) Stop() error {
 	if s.rpcClient != nil {
 		s.rpcClient.Close()
 	}
+	s.closeFallbackEngines()
 	return nil
 }
 
@@ -352,10 +372,10 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*qrysmpb.Deposit
 		}
 	}
 	validDepositsCount.Add(float64(currIndex))
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	// Only add pending deposits for the containers at or
+	// after the current index in state.
+	for _, c := range ctrs {
+		if uint64(c.Index) >= currIndex {
 			s.cfg.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.ExecutionBlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
@@ -708,14 +728,20 @@ func (s *Service) initializeExecutionData(ctx context.Context, executionDataInDB
 	if err != nil {
 		return err
 	}
-	s.chainStartData = executionDataInDB.ChainstartData
+	// The chainstart data is missing if only the finalized deposit snapshot was saved during
+	// checkpoint sync, and no genesis state is known yet to complete it.
+	if executionDataInDB.ChainstartData != nil {
+		s.chainStartData = executionDataInDB.ChainstartData
+	}
 	if !reflect.ValueOf(executionDataInDB.BeaconState).IsZero() {
 		s.preGenesisState, err = native.InitializeFromProtoZond(executionDataInDB.BeaconState)
 		if err != nil {
 			return errors.Wrap(err, "Could not initialize state trie")
 		}
 	}
-	s.latestExecutionData = executionDataInDB.CurrentExecutionData
+	if executionDataInDB.CurrentExecutionData != nil {
+		s.latestExecutionData = executionDataInDB.CurrentExecutionData
+	}
 	if features.Get().EnableEIP4881 {
 		ctrs := executionDataInDB.DepositContainers
 		// Look at previously finalized index, as we are building off a finalized
@@ -736,15 +762,38 @@ func (s *Service) initializeExecutionData(ctx context.Context, executionDataInDB
 	}
 	numOfItems := s.depositTrie.NumOfItems()
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
+	if err := s.initDepositCacheFromSnapshot(ctx, executionDataInDB); err != nil {
+		return errors.Wrap(err, "could not initialize deposit cache from snapshot")
+	}
 	if err := s.initDepositCaches(ctx, executionDataInDB.DepositContainers); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
 	return nil
 }
 
+// initDepositCacheFromSnapshot initializes the deposit cache from the finalized deposit snapshot when the
+// deposit containers do not cover the deposits of the snapshot, which is the case for a node initialized
+// with checkpoint sync.
+func (s *Service) initDepositCacheFromSnapshot(ctx context.Context, executionData *qrysmpb.ExecutionChainData) error {
+	if !features.Get().EnableEIP4881 || executionData.DepositSnapshot == nil || executionData.DepositSnapshot.DepositCount == 0 {
+		return nil
+	}
+	for _, c := range executionData.DepositContainers {
+		if c.Index == 0 {
+			return nil
+		}
+	}
+	dc, ok := s.cfg.depositCache.(*depositsnapshot.Cache)
+	if !ok {
+		return errors.New("deposit cache was not EIP4881 deposit cache")
+	}
+	return dc.InitializeFromSnapshot(ctx, executionData.DepositSnapshot)
+}
+
 // Validates that all deposit containers are valid and have their relevant indices
-// in order.
-func validateDepositContainers(ctrs []*qrysmpb.DepositContainer) bool {
+// in order. The containers may start after index 0, but not after the finalized
+// deposit count, when the node was initialized from a finalized deposit snapshot.
+func validateDepositContainers(ctrs []*qrysmpb.DepositContainer, finalizedCount uint64) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -755,6 +804,9 @@ func validateDepositContainers(ctrs []*qrysmpb.DepositContainer) bool {
 		return ctrs[i].Index < ctrs[j].Index
 	})
 	startIndex := int64(0)
+	if ctrs[0].Index > 0 && uint64(ctrs[0].Index) <= finalizedCount {
+		startIndex = ctrs[0].Index
+	}
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -780,7 +832,7 @@ func (s *Service) validExecutionChainData(ctx context.Context) (*qrysmpb.Executi
 	if genState == nil || genState.IsNil() {
 		return executionData, nil
 	}
-	if executionData == nil || !validateDepositContainers(executionData.DepositContainers) {
+	if executionData == nil || !validateDepositContainers(executionData.DepositContainers, snapshotDepositCount(executionData)) {
 		pbState, err := native.ProtobufBeaconStateZond(s.preGenesisState.ToProtoUnsafe())
 		if err != nil {
 			return nil, err
@@ -816,9 +868,32 @@ func (s *Service) validExecutionChainData(ctx context.Context) (*qrysmpb.Executi
 			return nil, err
 		}
 	}
+	// Only the finalized deposit snapshot is saved when the node is initialized with checkpoint sync.
+	if executionData.ChainstartData == nil {
+		s.chainStartData = &qrysmpb.ChainStartData{
+			GenesisTime:   genState.GenesisTime(),
+			GenesisBlock:  0,
+			ExecutionData: genState.ExecutionData(),
+		}
+		executionData.ChainstartData = s.chainStartData
+		if executionData.CurrentExecutionData == nil {
+			executionData.CurrentExecutionData = s.latestExecutionData
+		}
+		if err := s.cfg.beaconDB.SaveExecutionChainData(ctx, executionData); err != nil {
+			return nil, err
+		}
+	}
 	return executionData, nil
 }
 
+// snapshotDepositCount returns the number of deposits in the finalized deposit snapshot.
+func snapshotDepositCount(executionData *qrysmpb.ExecutionChainData) uint64 {
+	if !features.Get().EnableEIP4881 || executionData == nil || executionData.DepositSnapshot == nil {
+		return 0
+	}
+	return executionData.DepositSnapshot.DepositCount
+}
+
 func (s *Service) removeStartupState() {
 	s.cfg.finalizedStateAtStartup = nil
 }
@@ -581,9 +581,10 @@ func TestService_EnsureValidExecutionChainData(t *testing.T) {
 
 func TestService_ValidateDepositContainers(t *testing.T) {
 	var tt = []struct {
-		name        string
-		ctrsFunc    func() []*qrysmpb.DepositContainer
-		expectedRes bool
+		name           string
+		ctrsFunc       func() []*qrysmpb.DepositContainer
+		finalizedCount uint64
+		expectedRes    bool
 	}{
 		{
 			name: "zero containers",
@@ -628,10 +629,34 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 			},
 			expectedRes: false,
 		},
+		{
+			name: "containers after finalized snapshot",
+			ctrsFunc: func() []*qrysmpb.DepositContainer {
+				ctrs := make([]*qrysmpb.DepositContainer, 0)
+				for i := 5; i < 10; i++ {
+					ctrs = append(ctrs, &qrysmpb.DepositContainer{Index: int64(i), ExecutionBlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			finalizedCount: 5,
+			expectedRes:    true,
+		},
+		{
+			name: "containers missing after finalized snapshot",
+			ctrsFunc: func() []*qrysmpb.DepositContainer {
+				ctrs := make([]*qrysmpb.DepositContainer, 0)
+				for i := 5; i < 10; i++ {
+					ctrs = append(ctrs, &qrysmpb.DepositContainer{Index: int64(i), ExecutionBlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			finalizedCount: 3,
+			expectedRes:    false,
+		},
 	}
 
 	for _, test := range tt {
-		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc()))
+		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc(), test.finalizedCount))
 	}
 }
 
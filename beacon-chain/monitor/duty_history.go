@@ -0,0 +1,120 @@
+package monitor
+
+import (
+	"sync"
+
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+)
+
+// DefaultDutyHistorySize is the number of duty outcomes kept per tracked validator when none is configured.
+const DefaultDutyHistorySize = 512
+
+// DutyType is the kind of duty a duty outcome refers to.
+type DutyType string
+
+const (
+	// AttestationDuty is the attestation duty of a validator in an epoch.
+	AttestationDuty DutyType = "attestation"
+	// ProposalDuty is the block proposal duty of a validator in a slot.
+	ProposalDuty DutyType = "proposal"
+	// SyncCommitteeDuty is the sync committee duty of a validator in an epoch.
+	SyncCommitteeDuty DutyType = "sync_committee"
+	// Slashing records that a slashing of the validator was included in a block.
+	Slashing DutyType = "slashing"
+)
+
+// DutyOutcome is the outcome of a duty of a tracked validator.
+type DutyOutcome struct {
+	Type  DutyType
+	Epoch primitives.Epoch
+	// Slot is the slot of a proposal, or the inclusion slot of a slashing.
+	Slot   primitives.Slot
+	Missed bool
+	// The timely flags are only set for attestation duties.
+	TimelySource bool
+	TimelyTarget bool
+	TimelyHead   bool
+	// The contribution counts are only set for sync committee duties. Expected is the number of
+	// contributions that were expected from the validator over the epoch.
+	Contributions         uint64
+	ExpectedContributions uint64
+	Balance               uint64
+}
+
+// DutyHistoryFetcher retrieves the recent duty outcomes of tracked validators.
+type DutyHistoryFetcher interface {
+	TrackedValidatorIndices() []primitives.ValidatorIndex
+	DutyHistory(idx primitives.ValidatorIndex) ([]DutyOutcome, bool)
+}
+
+// syncDuty accumulates the sync committee contributions of a validator in an epoch.
+type syncDuty struct {
+	contributions uint64
+	expected      uint64
+	balance       uint64
+}
+
+// dutyTracker holds the duty outcomes of tracked validators and the state needed to compute them. The zero
+// value is ready to use.
+type dutyTracker struct {
+	sync.RWMutex
+
+	size    int
+	history map[primitives.ValidatorIndex][]DutyOutcome
+
+	// Consecutive missed attestations and the balance at the last attestation duty of each validator.
+	missedAttestations map[primitives.ValidatorIndex]uint64
+	epochBalances      map[primitives.ValidatorIndex]uint64
+
+	// The participation flags of the tracked validators in participationEpoch, as seen by the latest block.
+	// They become final once a block of a later epoch is processed.
+	participationEpoch primitives.Epoch
+	participation      map[primitives.ValidatorIndex]byte
+
+	syncEpoch         primitives.Epoch
+	syncParticipation map[primitives.ValidatorIndex]*syncDuty
+
+	lastBlockRoot [32]byte
+	lastBlockSlot primitives.Slot
+}
+
+// record appends the outcome to the history of the validator, dropping the oldest outcome once the history is
+// full. It assumes the caller holds the tracker lock.
+func (t *dutyTracker) record(idx primitives.ValidatorIndex, outcome DutyOutcome) {
+	if t.history == nil {
+		t.history = make(map[primitives.ValidatorIndex][]DutyOutcome)
+	}
+	size := t.size
+	if size <= 0 {
+		size = DefaultDutyHistorySize
+	}
+	h := append(t.history[idx], outcome)
+	if len(h) > size {
+		h = h[len(h)-size:]
+	}
+	t.history[idx] = h
+}
+
+// TrackedValidatorIndices returns the indices of the tracked validators.
+func (s *Service) TrackedValidatorIndices() []primitives.ValidatorIndex {
+	s.RLock()
+	defer s.RUnlock()
+	return s.trackedIndices()
+}
+
+// DutyHistory returns the duty outcomes of a tracked validator, oldest first. The second return value is false
+// when the validator is not tracked.
+func (s *Service) DutyHistory(idx primitives.ValidatorIndex) ([]DutyOutcome, bool) {
+	s.RLock()
+	tracked := s.trackedIndex(idx)
+	s.RUnlock()
+	if !tracked {
+		return nil, false
+	}
+	s.duties.RLock()
+	defer s.duties.RUnlock()
+	h := s.duties.history[idx]
+	outcomes := make([]DutyOutcome, len(h))
+	copy(outcomes, h)
+	return outcomes, true
+}
@@ -56,4 +56,28 @@ var (
 			"validator_index",
 		},
 	)
+	// missedDutiesCounter used to track missed duties
+	missedDutiesCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "missed_duties_total",
+			Help:      "Number of missed duties",
+		},
+		[]string{
+			"validator_index",
+			"duty",
+		},
+	)
+	// webhookNotificationsCounter used to track alerts sent to webhooks
+	webhookNotificationsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "webhook_notifications_total",
+			Help:      "Number of alerts sent to webhooks",
+		},
+		[]string{
+			"alert",
+			"result",
+		},
+	)
 )
@@ -54,6 +54,7 @@ func (s *Service) processBlock(ctx context.Context, b interfaces.ReadOnlySignedB
 		s.updateSyncCommitteeTrackedVals(st)
 	}
 
+	s.processDuties(ctx, st, root, blk)
 	s.processSyncAggregate(st, blk)
 	s.processProposedBlock(st, root, blk)
 	s.processAttestations(ctx, st, blk)
@@ -88,6 +89,15 @@ func (s *Service) processProposedBlock(state state.BeaconState, root [32]byte, b
 		aggPerf.totalProposedCount++
 		s.aggregatedPerformance[blk.ProposerIndex()] = aggPerf
 
+		s.duties.Lock()
+		s.duties.record(blk.ProposerIndex(), DutyOutcome{
+			Type:    ProposalDuty,
+			Epoch:   slots.ToEpoch(blk.Slot()),
+			Slot:    blk.Slot(),
+			Balance: balance,
+		})
+		s.duties.Unlock()
+
 		parentRoot := blk.ParentRoot()
 		log.WithFields(logrus.Fields{
 			"ProposerIndex": blk.ProposerIndex(),
@@ -101,7 +111,7 @@ func (s *Service) processProposedBlock(state state.BeaconState, root [32]byte, b
 	}
 }
 
-// processSlashings logs the event when tracked validators was slashed
+// processSlashings logs and records the event when tracked validators was slashed
 func (s *Service) processSlashings(blk interfaces.ReadOnlyBeaconBlock) {
 	s.RLock()
 	defer s.RUnlock()
@@ -115,6 +125,7 @@ func (s *Service) processSlashings(blk interfaces.ReadOnlyBeaconBlock) {
 				"BodyRoot1":     fmt.Sprintf("%#x", bytesutil.Trunc(slashing.Header_1.Header.BodyRoot)),
 				"BodyRoot2":     fmt.Sprintf("%#x", bytesutil.Trunc(slashing.Header_2.Header.BodyRoot)),
 			}).Info("Proposer slashing was included")
+			s.recordSlashing(idx, blk.Slot())
 		}
 	}
 
@@ -133,6 +144,7 @@ func (s *Service) processSlashings(blk interfaces.ReadOnlyBeaconBlock) {
 					"SourceEpoch2":       slashing.Attestation_2.Data.Source.Epoch,
 					"TargetEpoch2":       slashing.Attestation_2.Data.Target.Epoch,
 				}).Info("Attester slashing was included")
+				s.recordSlashing(primitives.ValidatorIndex(idx), blk.Slot())
 			}
 		}
 	}
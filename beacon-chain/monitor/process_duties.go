@@ -0,0 +1,235 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/theQRL/qrysm/beacon-chain/core/altair"
+	"github.com/theQRL/qrysm/beacon-chain/core/helpers"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/time/slots"
+)
+
+// processDuties records the duty outcomes of the tracked validators that become known with the block, and
+// raises the alerts they trigger. st is the post state of the block.
+func (s *Service) processDuties(ctx context.Context, st state.BeaconState, root [32]byte, blk interfaces.ReadOnlyBeaconBlock) {
+	s.RLock()
+	tracked := s.trackedIndices()
+	s.RUnlock()
+
+	s.processMissedProposals(ctx, st, root, blk)
+	s.processAttestationDuties(st, tracked)
+	s.processSyncCommitteeDuties(slots.ToEpoch(blk.Slot()))
+}
+
+// processMissedProposals records a missed proposal for every tracked validator that was the proposer of a slot
+// skipped between the parent of the block and the block. Gaps are only inspected when the parent is the last
+// processed block, so that slots filled on another fork are not reported.
+func (s *Service) processMissedProposals(ctx context.Context, st state.BeaconState, root [32]byte, blk interfaces.ReadOnlyBeaconBlock) {
+	s.duties.Lock()
+	lastRoot, lastSlot := s.duties.lastBlockRoot, s.duties.lastBlockSlot
+	s.duties.lastBlockRoot, s.duties.lastBlockSlot = root, blk.Slot()
+	s.duties.Unlock()
+	if blk.ParentRoot() != lastRoot {
+		return
+	}
+
+	stEpoch := slots.ToEpoch(st.Slot())
+	for slot := lastSlot + 1; slot < blk.Slot(); slot++ {
+		// The proposers can only be computed for the current and previous epochs of the state.
+		epoch := slots.ToEpoch(slot)
+		if epoch+1 < stEpoch {
+			continue
+		}
+		idx, err := helpers.BeaconProposerIndexAtSlot(ctx, st, slot)
+		if err != nil {
+			log.WithError(err).WithField("Slot", slot).Error("Could not get proposer index")
+			continue
+		}
+		s.RLock()
+		tracked := s.trackedIndex(idx)
+		s.RUnlock()
+		if !tracked {
+			continue
+		}
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			log.WithError(err).Error("Could not get balance")
+		}
+		s.duties.Lock()
+		s.duties.record(idx, DutyOutcome{Type: ProposalDuty, Epoch: epoch, Slot: slot, Missed: true, Balance: balance})
+		s.duties.Unlock()
+		missedDutiesCounter.WithLabelValues(fmt.Sprintf("%d", idx), string(ProposalDuty)).Inc()
+		s.alert(newAlert(MissedProposalAlert, idx, epoch,
+			fmt.Sprintf("Validator %d missed its block proposal at slot %d", idx, slot)).withSlot(slot))
+	}
+}
+
+// processAttestationDuties records the attestation outcomes of the tracked validators. The participation flags
+// of the previous epoch of the state are kept until a block of a later epoch is processed: attestations can be
+// included until the end of the epoch that follows their own, so the flags seen by the last block of that epoch
+// are final.
+func (s *Service) processAttestationDuties(st state.BeaconState, tracked []primitives.ValidatorIndex) {
+	epoch := slots.ToEpoch(st.Slot())
+	if epoch == 0 {
+		return
+	}
+	prevEpoch := epoch - 1
+
+	s.duties.Lock()
+	if s.duties.participation != nil && prevEpoch < s.duties.participationEpoch {
+		s.duties.Unlock()
+		return
+	}
+	var alerts []*Alert
+	if s.duties.participation != nil && prevEpoch > s.duties.participationEpoch {
+		alerts = s.finalizeAttestationDuties(st)
+	}
+	participation, err := st.PreviousEpochParticipation()
+	if err != nil {
+		s.duties.Unlock()
+		log.WithError(err).Error("Could not get previous epoch participation")
+		return
+	}
+	snapshot := make(map[primitives.ValidatorIndex]byte, len(tracked))
+	for _, idx := range tracked {
+		if uint64(idx) >= uint64(len(participation)) {
+			continue
+		}
+		v, err := st.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			log.WithError(err).WithField("ValidatorIndex", idx).Error("Could not get validator")
+			continue
+		}
+		if !helpers.IsActiveValidatorUsingTrie(v, prevEpoch) {
+			continue
+		}
+		snapshot[idx] = participation[idx]
+	}
+	s.duties.participationEpoch = prevEpoch
+	s.duties.participation = snapshot
+	s.duties.Unlock()
+
+	for _, a := range alerts {
+		s.alert(a)
+	}
+}
+
+// finalizeAttestationDuties records the attestation outcomes of the kept participation flags, using the balances
+// of st. It assumes the caller holds the duty tracker lock.
+func (s *Service) finalizeAttestationDuties(st state.BeaconState) []*Alert {
+	cfg := params.BeaconConfig()
+	if s.duties.missedAttestations == nil {
+		s.duties.missedAttestations = make(map[primitives.ValidatorIndex]uint64)
+	}
+	if s.duties.epochBalances == nil {
+		s.duties.epochBalances = make(map[primitives.ValidatorIndex]uint64)
+	}
+	var missedThreshold, balanceDropThreshold uint64
+	if s.config != nil {
+		missedThreshold = s.config.MissedAttestationsThreshold
+		balanceDropThreshold = s.config.BalanceDropThreshold
+	}
+
+	epoch := s.duties.participationEpoch
+	var alerts []*Alert
+	for idx, flags := range s.duties.participation {
+		outcome := DutyOutcome{Type: AttestationDuty, Epoch: epoch}
+		var err error
+		if outcome.TimelySource, err = altair.HasValidatorFlag(flags, cfg.TimelySourceFlagIndex); err != nil {
+			log.WithError(err).Error("Could not get timely source flag")
+			continue
+		}
+		if outcome.TimelyTarget, err = altair.HasValidatorFlag(flags, cfg.TimelyTargetFlagIndex); err != nil {
+			log.WithError(err).Error("Could not get timely target flag")
+			continue
+		}
+		if outcome.TimelyHead, err = altair.HasValidatorFlag(flags, cfg.TimelyHeadFlagIndex); err != nil {
+			log.WithError(err).Error("Could not get timely head flag")
+			continue
+		}
+		outcome.Missed = !outcome.TimelySource && !outcome.TimelyTarget && !outcome.TimelyHead
+		if outcome.Balance, err = st.BalanceAtIndex(idx); err != nil {
+			log.WithError(err).Error("Could not get balance")
+			continue
+		}
+		s.duties.record(idx, outcome)
+
+		if outcome.Missed {
+			missedDutiesCounter.WithLabelValues(fmt.Sprintf("%d", idx), string(AttestationDuty)).Inc()
+			s.duties.missedAttestations[idx]++
+			if missed := s.duties.missedAttestations[idx]; missedThreshold > 0 && missed == missedThreshold {
+				alerts = append(alerts, newAlert(MissedAttestationsAlert, idx, epoch,
+					fmt.Sprintf("Validator %d missed %d consecutive attestations", idx, missed)))
+			}
+		} else {
+			s.duties.missedAttestations[idx] = 0
+		}
+
+		if prev, ok := s.duties.epochBalances[idx]; ok && balanceDropThreshold > 0 &&
+			prev > outcome.Balance && prev-outcome.Balance >= balanceDropThreshold {
+			alerts = append(alerts, newAlert(BalanceDropAlert, idx, epoch,
+				fmt.Sprintf("Balance of validator %d dropped by %d Shor to %d Shor", idx, prev-outcome.Balance, outcome.Balance)))
+		}
+		s.duties.epochBalances[idx] = outcome.Balance
+	}
+	s.duties.participation = nil
+	return alerts
+}
+
+// recordSyncContribution adds the contributions of a tracked validator to a sync aggregate of the epoch.
+func (s *Service) recordSyncContribution(epoch primitives.Epoch, idx primitives.ValidatorIndex, contributions, expected, balance uint64) {
+	s.duties.Lock()
+	defer s.duties.Unlock()
+	if s.duties.syncParticipation == nil || epoch != s.duties.syncEpoch {
+		s.duties.syncEpoch = epoch
+		s.duties.syncParticipation = make(map[primitives.ValidatorIndex]*syncDuty)
+	}
+	d, ok := s.duties.syncParticipation[idx]
+	if !ok {
+		d = &syncDuty{}
+		s.duties.syncParticipation[idx] = d
+	}
+	d.contributions += contributions
+	d.expected += expected
+	d.balance = balance
+}
+
+// processSyncCommitteeDuties records the sync committee outcomes of the tracked validators once a block of a
+// later epoch is processed. A validator that did not contribute to any sync aggregate of the epoch missed its
+// duty.
+func (s *Service) processSyncCommitteeDuties(epoch primitives.Epoch) {
+	s.duties.Lock()
+	defer s.duties.Unlock()
+	if len(s.duties.syncParticipation) == 0 || epoch <= s.duties.syncEpoch {
+		return
+	}
+	for idx, d := range s.duties.syncParticipation {
+		missed := d.contributions == 0
+		if missed {
+			missedDutiesCounter.WithLabelValues(fmt.Sprintf("%d", idx), string(SyncCommitteeDuty)).Inc()
+		}
+		s.duties.record(idx, DutyOutcome{
+			Type:                  SyncCommitteeDuty,
+			Epoch:                 s.duties.syncEpoch,
+			Missed:                missed,
+			Contributions:         d.contributions,
+			ExpectedContributions: d.expected,
+			Balance:               d.balance,
+		})
+	}
+	s.duties.syncParticipation = nil
+}
+
+// recordSlashing records that a slashing of a tracked validator was included in the block at the slot.
+func (s *Service) recordSlashing(idx primitives.ValidatorIndex, slot primitives.Slot) {
+	epoch := slots.ToEpoch(slot)
+	s.duties.Lock()
+	s.duties.record(idx, DutyOutcome{Type: Slashing, Epoch: epoch, Slot: slot})
+	s.duties.Unlock()
+	s.alert(newAlert(SlashedAlert, idx, epoch,
+		fmt.Sprintf("A slashing of validator %d was included at slot %d", idx, slot)).withSlot(slot))
+}
@@ -0,0 +1,200 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/theQRL/qrysm/beacon-chain/core/helpers"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/blocks"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+// alertServer returns a webhook that forwards the alerts it receives to the returned channel.
+func alertServer(t *testing.T) (string, <-chan *Alert) {
+	alerts := make(chan *Alert, 16)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a := &Alert{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(a))
+		alerts <- a
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL, alerts
+}
+
+func receiveAlert(t *testing.T, alerts <-chan *Alert) *Alert {
+	select {
+	case a := <-alerts:
+		return a
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive alert")
+		return nil
+	}
+}
+
+func TestProcessAttestationDuties(t *testing.T) {
+	s := setupService(t)
+	url, alerts := alertServer(t)
+	s.config.WebhookURLs = []string{url}
+	s.config.MissedAttestationsThreshold = 2
+	s.config.BalanceDropThreshold = params.BeaconConfig().EffectiveBalanceIncrement
+	tracked := []primitives.ValidatorIndex{1, 15}
+
+	st, _ := util.DeterministicGenesisStateZond(t, 256)
+	participation := make([]byte, st.NumValidators())
+	participation[1] = 0b111
+	require.NoError(t, st.SetPreviousParticipationBits(participation))
+
+	// The participation of epoch 1 is only final once a block of epoch 3 is processed.
+	for epoch := primitives.Epoch(2); epoch <= 4; epoch++ {
+		require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*primitives.Slot(epoch)))
+		if epoch == 4 {
+			balance, err := st.BalanceAtIndex(1)
+			require.NoError(t, err)
+			require.NoError(t, st.UpdateBalancesAtIndex(1, balance-params.BeaconConfig().EffectiveBalanceIncrement))
+		}
+		s.processAttestationDuties(st, tracked)
+	}
+
+	h, ok := s.DutyHistory(1)
+	require.Equal(t, true, ok)
+	require.Equal(t, 2, len(h))
+	require.Equal(t, AttestationDuty, h[0].Type)
+	require.Equal(t, primitives.Epoch(1), h[0].Epoch)
+	require.Equal(t, false, h[0].Missed)
+	require.Equal(t, true, h[0].TimelyTarget)
+
+	h, ok = s.DutyHistory(15)
+	require.Equal(t, true, ok)
+	require.Equal(t, 2, len(h))
+	require.Equal(t, true, h[1].Missed)
+
+	got := map[AlertType]*Alert{}
+	for range 2 {
+		a := receiveAlert(t, alerts)
+		got[a.Type] = a
+	}
+	require.Equal(t, "15", got[MissedAttestationsAlert].ValidatorIndex)
+	require.Equal(t, "2", got[MissedAttestationsAlert].Epoch)
+	require.Equal(t, "1", got[BalanceDropAlert].ValidatorIndex)
+
+	_, ok = s.DutyHistory(2)
+	require.Equal(t, false, ok)
+}
+
+func TestProcessMissedProposals(t *testing.T) {
+	ctx := context.Background()
+	helpers.ClearCache()
+	s := setupService(t)
+	url, alerts := alertServer(t)
+	s.config.WebhookURLs = []string{url}
+
+	st, _ := util.DeterministicGenesisStateZond(t, 256)
+	require.NoError(t, st.SetSlot(4))
+	proposer, err := helpers.BeaconProposerIndexAtSlot(ctx, st, 2)
+	require.NoError(t, err)
+	s.TrackedValidators = map[primitives.ValidatorIndex]bool{proposer: true}
+
+	parentRoot := bytesutil.ToBytes32([]byte("parent"))
+	s.duties.lastBlockRoot, s.duties.lastBlockSlot = parentRoot, 1
+	b := util.NewBeaconBlockZond()
+	b.Block.Slot = 4
+	b.Block.ParentRoot = parentRoot[:]
+	wb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	root := bytesutil.ToBytes32([]byte("root"))
+	s.processMissedProposals(ctx, st, root, wb.Block())
+
+	h, ok := s.DutyHistory(proposer)
+	require.Equal(t, true, ok)
+	require.NotEqual(t, 0, len(h))
+	require.Equal(t, ProposalDuty, h[0].Type)
+	require.Equal(t, true, h[0].Missed)
+	a := receiveAlert(t, alerts)
+	require.Equal(t, MissedProposalAlert, a.Type)
+	require.Equal(t, root, s.duties.lastBlockRoot)
+
+	// A block that does not build on the last processed block does not report the skipped slots.
+	b.Block.Slot = 8
+	wb, err = blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	s.processMissedProposals(ctx, st, root, wb.Block())
+	h2, _ := s.DutyHistory(proposer)
+	require.Equal(t, len(h), len(h2))
+}
+
+func TestProcessSyncCommitteeDuties(t *testing.T) {
+	s := setupService(t)
+	s.recordSyncContribution(1, 1, 3, 4, 100)
+	s.recordSyncContribution(1, 1, 1, 4, 101)
+	s.recordSyncContribution(1, 44, 0, 2, 100)
+	s.processSyncCommitteeDuties(1)
+	h, _ := s.DutyHistory(1)
+	require.Equal(t, 0, len(h))
+
+	s.processSyncCommitteeDuties(2)
+	h, _ = s.DutyHistory(1)
+	require.Equal(t, 1, len(h))
+	require.DeepEqual(t, DutyOutcome{
+		Type:                  SyncCommitteeDuty,
+		Epoch:                 1,
+		Contributions:         4,
+		ExpectedContributions: 8,
+		Balance:               101,
+	}, h[0])
+	h, _ = s.DutyHistory(44)
+	require.Equal(t, 1, len(h))
+	require.Equal(t, true, h[0].Missed)
+}
+
+func TestDutyHistory_Size(t *testing.T) {
+	s := setupService(t)
+	s.duties.size = 2
+	for slot := primitives.Slot(1); slot <= 3; slot++ {
+		s.recordSlashing(1, slot)
+	}
+	h, ok := s.DutyHistory(1)
+	require.Equal(t, true, ok)
+	require.Equal(t, 2, len(h))
+	require.Equal(t, primitives.Slot(2), h[0].Slot)
+	require.Equal(t, primitives.Slot(3), h[1].Slot)
+}
+
+func TestProcessSlashings_RecordsDuty(t *testing.T) {
+	s := setupService(t)
+	url, alerts := alertServer(t)
+	s.config.WebhookURLs = []string{url}
+	wb, err := blocks.NewBeaconBlock(&qrysmpb.BeaconBlockZond{
+		Slot: 5,
+		Body: &qrysmpb.BeaconBlockBodyZond{
+			ProposerSlashings: []*qrysmpb.ProposerSlashing{
+				{
+					Header_1: &qrysmpb.SignedBeaconBlockHeader{
+						Header: &qrysmpb.BeaconBlockHeader{ProposerIndex: 44, Slot: 1, BodyRoot: bytesutil.PadTo([]byte("a"), 32)},
+					},
+					Header_2: &qrysmpb.SignedBeaconBlockHeader{
+						Header: &qrysmpb.BeaconBlockHeader{ProposerIndex: 44, Slot: 1, BodyRoot: bytesutil.PadTo([]byte("b"), 32)},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	s.processSlashings(wb)
+
+	h, _ := s.DutyHistory(44)
+	require.Equal(t, 1, len(h))
+	require.Equal(t, Slashing, h[0].Type)
+	a := receiveAlert(t, alerts)
+	require.Equal(t, SlashedAlert, a.Type)
+	require.Equal(t, "44", a.ValidatorIndex)
+	require.Equal(t, "5", a.Slot)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/theQRL/qrysm/beacon-chain/state"
 	"github.com/theQRL/qrysm/consensus-types/interfaces"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/time/slots"
 )
 
 // processSyncCommitteeContribution logs the event when tracked validators' aggregated sync contribution has been processed.
@@ -62,6 +63,8 @@ func (s *Service) processSyncAggregate(state state.BeaconState, blk interfaces.R
 			aggPerf.totalSyncCommitteeContributions += uint64(contrib)
 			s.aggregatedPerformance[validatorIdx] = aggPerf
 
+			s.recordSyncContribution(slots.ToEpoch(blk.Slot()), validatorIdx, uint64(contrib), uint64(len(committeeIndices)), balance)
+
 			syncCommitteeContributionCounter.WithLabelValues(
 				fmt.Sprintf("%d", validatorIdx)).Add(float64(contrib))
 
@@ -59,6 +59,16 @@ type ValidatorMonitorConfig struct {
 	HeadFetcher         blockchain.HeadFetcher
 	StateGen            stategen.StateManager
 	InitialSyncComplete chan struct{}
+	// DutyHistorySize is the number of duty outcomes kept per tracked validator.
+	DutyHistorySize int
+	// WebhookURLs receive a JSON POST for every alert.
+	WebhookURLs []string
+	// MissedAttestationsThreshold is the number of consecutive missed attestations that triggers an alert,
+	// zero disables the alert.
+	MissedAttestationsThreshold uint64
+	// BalanceDropThreshold is the balance drop in Shor over an epoch that triggers an alert, zero disables
+	// the alert.
+	BalanceDropThreshold uint64
 }
 
 // Service is the main structure that tracks validators and reports logs and
@@ -78,6 +88,9 @@ type Service struct {
 	aggregatedPerformance       map[primitives.ValidatorIndex]ValidatorAggregatedPerformance
 	trackedSyncCommitteeIndices map[primitives.ValidatorIndex][]primitives.CommitteeIndex
 	lastSyncedEpoch             primitives.Epoch
+
+	// duties has its own lock. It is never acquired before the service lock.
+	duties dutyTracker
 }
 
 // NewService sets up a new validator monitor service instance when given a list of validator indices to track.
@@ -93,6 +106,7 @@ func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []p
 		trackedSyncCommitteeIndices: make(map[primitives.ValidatorIndex][]primitives.CommitteeIndex),
 		isLogging:                   false,
 	}
+	r.duties.size = config.DutyHistorySize
 	for _, idx := range tracked {
 		r.TrackedValidators[idx] = true
 	}
@@ -104,14 +118,8 @@ func (s *Service) Start() {
 	s.Lock()
 	defer s.Unlock()
 
-	tracked := make([]primitives.ValidatorIndex, 0, len(s.TrackedValidators))
-	for idx := range s.TrackedValidators {
-		tracked = append(tracked, idx)
-	}
-	slices.Sort(tracked)
-
 	log.WithFields(logrus.Fields{
-		"ValidatorIndices": tracked,
+		"ValidatorIndices": s.trackedIndices(),
 	}).Info("Starting service")
 
 	go s.run()
@@ -268,6 +276,17 @@ func (s *Service) trackedIndex(idx primitives.ValidatorIndex) bool {
 	return ok
 }
 
+// trackedIndices returns the sorted indices of the tracked validators.
+// It assumes the caller holds the service Lock
+func (s *Service) trackedIndices() []primitives.ValidatorIndex {
+	tracked := make([]primitives.ValidatorIndex, 0, len(s.TrackedValidators))
+	for idx := range s.TrackedValidators {
+		tracked = append(tracked, idx)
+	}
+	slices.Sort(tracked)
+	return tracked
+}
+
 // updateSyncCommitteeTrackedVals updates the sync committee assignments of our
 // tracked validators. It gets called when we sync a block after the Sync Period changes.
 func (s *Service) updateSyncCommitteeTrackedVals(state state.BeaconState) {
@@ -0,0 +1,105 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+)
+
+const webhookTimeout = 10 * time.Second
+
+// AlertType is the condition that triggered an alert.
+type AlertType string
+
+const (
+	// MissedProposalAlert is sent when a tracked validator did not propose a block in its slot.
+	MissedProposalAlert AlertType = "missed_proposal"
+	// MissedAttestationsAlert is sent when a tracked validator missed the configured number of consecutive
+	// attestations.
+	MissedAttestationsAlert AlertType = "missed_attestations"
+	// BalanceDropAlert is sent when the balance of a tracked validator dropped by at least the configured amount
+	// over an epoch.
+	BalanceDropAlert AlertType = "balance_drop"
+	// SlashedAlert is sent when a slashing of a tracked validator was included in a block.
+	SlashedAlert AlertType = "slashed"
+)
+
+// Alert is the JSON body posted to the configured webhooks.
+type Alert struct {
+	Type           AlertType `json:"type"`
+	ValidatorIndex string    `json:"validator_index"`
+	Epoch          string    `json:"epoch"`
+	Slot           string    `json:"slot,omitempty"`
+	Message        string    `json:"message"`
+}
+
+func newAlert(t AlertType, idx primitives.ValidatorIndex, epoch primitives.Epoch, message string) *Alert {
+	return &Alert{
+		Type:           t,
+		ValidatorIndex: strconv.FormatUint(uint64(idx), 10),
+		Epoch:          strconv.FormatUint(uint64(epoch), 10),
+		Message:        message,
+	}
+}
+
+func (a *Alert) withSlot(slot primitives.Slot) *Alert {
+	a.Slot = strconv.FormatUint(uint64(slot), 10)
+	return a
+}
+
+// alert logs the alert and posts it to the configured webhooks in the background.
+func (s *Service) alert(a *Alert) {
+	log.WithFields(logrus.Fields{
+		"Alert":          a.Type,
+		"ValidatorIndex": a.ValidatorIndex,
+		"Epoch":          a.Epoch,
+	}).Warn(a.Message)
+	if s.config == nil || len(s.config.WebhookURLs) == 0 {
+		return
+	}
+	body, err := json.Marshal(a)
+	if err != nil {
+		log.WithError(err).Error("Could not marshal alert")
+		return
+	}
+	for _, url := range s.config.WebhookURLs {
+		go func(url string) {
+			if err := postWebhook(s.ctx, url, body); err != nil {
+				webhookNotificationsCounter.WithLabelValues(string(a.Type), "failure").Inc()
+				log.WithError(err).WithField("Alert", a.Type).Error("Could not send alert to webhook")
+				return
+			}
+			webhookNotificationsCounter.WithLabelValues(string(a.Type), "success").Inc()
+		}(url)
+	}
+}
+
+func postWebhook(ctx context.Context, url string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/theQRL/qrysm/beacon-chain/cache/depositsnapshot"
 	"github.com/theQRL/qrysm/beacon-chain/db"
 	"github.com/theQRL/qrysm/beacon-chain/db/kv"
+	"github.com/theQRL/qrysm/beacon-chain/db/pruner"
 	"github.com/theQRL/qrysm/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/theQRL/qrysm/beacon-chain/deterministic-genesis"
 	"github.com/theQRL/qrysm/beacon-chain/execution"
@@ -109,6 +110,8 @@ type BeaconNode struct {
 	forkChoicer             forkchoice.ForkChoicer
 	clockWaiter             startup.ClockWaiter
 	initialSyncComplete     chan struct{}
+	BackfillOpts            []backfill.ServiceOption
+	backfillStatus          *backfill.Status
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -222,6 +225,7 @@ func New(cliCtx *cli.Context, optFuncs []func(*cli.Context) (Option, error), opt
 	if err := bfs.Reload(ctx); err != nil {
 		return nil, errors.Wrap(err, "backfill status initialization error")
 	}
+	beacon.backfillStatus = bfs
 
 	log.Debugln("Starting State Gen")
 	if err := beacon.startStateGen(ctx, bfs, beacon.forkChoicer); err != nil {
@@ -268,6 +272,16 @@ func New(cliCtx *cli.Context, optFuncs []func(*cli.Context) (Option, error), opt
 		return nil, err
 	}
 
+	log.Debugln("Registering Backfill Service")
+	if err := beacon.registerBackfillService(); err != nil {
+		return nil, err
+	}
+
+	log.Debugln("Registering Pruner Service")
+	if err := beacon.registerPrunerService(cliCtx); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering Slasher Service")
 	if err := beacon.registerSlasherService(); err != nil {
 		return nil, err
@@ -278,6 +292,11 @@ func New(cliCtx *cli.Context, optFuncs []func(*cli.Context) (Option, error), opt
 		return nil, err
 	}
 
+	log.Debugln("Registering Validator Monitoring Service")
+	if err := beacon.registerValidatorMonitorService(beacon.initialSyncComplete); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering RPC Service")
 	router := mux.NewRouter()
 	router.Use(middleware)
@@ -290,11 +309,6 @@ func New(cliCtx *cli.Context, optFuncs []func(*cli.Context) (Option, error), opt
 		return nil, err
 	}
 
-	log.Debugln("Registering Validator Monitoring Service")
-	if err := beacon.registerValidatorMonitorService(beacon.initialSyncComplete); err != nil {
-		return nil, err
-	}
-
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		log.Debugln("Registering Prometheus Service")
 		if err := beacon.registerPrometheusService(cliCtx); err != nil {
@@ -578,6 +592,7 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		PrivateKey:        cliCtx.String(cmd.P2PPrivKey.Name),
 		StaticPeerID:      cliCtx.Bool(cmd.P2PStaticID.Name),
 		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
+		QUICPort:          cliCtx.Uint(cmd.P2PQUICPort.Name),
 		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
 		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
 		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
@@ -753,6 +768,28 @@ func (b *BeaconNode) registerInitialSyncService(complete chan struct{}) error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService() error {
+	opts := []backfill.ServiceOption{backfill.WithInitialSyncComplete(b.initialSyncComplete)}
+	opts = append(opts, b.BackfillOpts...)
+	bf, err := backfill.NewService(b.ctx, b.backfillStatus, b.db, b.fetchP2P(), b.clockWaiter, opts...)
+	if err != nil {
+		return errors.Wrap(err, "error initializing backfill service")
+	}
+	return b.services.RegisterService(bf)
+}
+
+func (b *BeaconNode) registerPrunerService(cliCtx *cli.Context) error {
+	retention := cliCtx.Uint64(flags.HistoryRetentionEpochs.Name)
+	if retention == 0 {
+		return nil
+	}
+	p, err := pruner.New(b.ctx, b.db, b.clockWaiter, primitives.Epoch(retention), pruner.WithPruneTracker(b.backfillStatus))
+	if err != nil {
+		return errors.Wrap(err, "error initializing pruner service")
+	}
+	return b.services.RegisterService(p)
+}
+
 func (b *BeaconNode) registerSlasherService() error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -766,6 +803,10 @@ func (b *BeaconNode) registerSlasherService() error {
 		return err
 	}
 
+	auditLogPath := b.cliCtx.String(flags.SlasherAuditLogFlag.Name)
+	if auditLogPath == "" {
+		auditLogPath = filepath.Join(b.slasherDB.DatabasePath(), "audit.log")
+	}
 	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
 		IndexedAttestationsFeed: b.slasherAttestationsFeed,
 		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
@@ -777,6 +818,9 @@ func (b *BeaconNode) registerSlasherService() error {
 		SyncChecker:             syncService,
 		HeadStateFetcher:        chainService,
 		ClockWaiter:             b.clockWaiter,
+		BeaconDB:                b.db,
+		BackfillEpochs:          primitives.Epoch(b.cliCtx.Uint64(flags.SlasherBackfillEpochs.Name)),
+		AuditLogPath:            auditLogPath,
 	})
 	if err != nil {
 		return err
@@ -801,10 +845,22 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 	}
 
 	var slasherService *slasher.Service
+	var slashingRecordsFetcher slasher.SlashingRecordsFetcher
 	if features.Get().EnableSlasher {
 		if err := b.services.FetchService(&slasherService); err != nil {
 			return err
 		}
+		slashingRecordsFetcher = slasherService
+	}
+
+	// The validator monitor is only registered when validators are tracked.
+	var dutyHistoryFetcher monitor.DutyHistoryFetcher
+	if b.cliCtx.IntSlice(cmd.ValidatorMonitorIndicesFlag.Name) != nil {
+		var monitorService *monitor.Service
+		if err := b.services.FetchService(&monitorService); err != nil {
+			return err
+		}
+		dutyHistoryFetcher = monitorService
 	}
 
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
@@ -832,6 +888,10 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
+	maxAttestationBytes := b.cliCtx.Uint64(flags.MaxBlockAttestationBytes.Name)
+	historicalReplayBudget := b.cliCtx.Uint64(flags.HistoricalStateReplayBudget.Name)
+	historicalReplayWorkers := b.cliCtx.Int(flags.HistoricalStateReplayWorkers.Name)
+	historicalStateCacheSize := b.cliCtx.Int(flags.HistoricalStateCacheSize.Name)
 
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
@@ -863,6 +923,7 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		ExitPool:                      b.exitPool,
 		SlashingsPool:                 b.slashingsPool,
 		SlashingChecker:               slasherService,
+		SlashingRecordsFetcher:        slashingRecordsFetcher,
 		SyncCommitteeObjectPool:       b.syncCommitteePool,
 		ExecutionChainService:         web3Service,
 		ExecutionChainInfoFetcher:     web3Service,
@@ -877,10 +938,17 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		StateGen:                      b.stateGen,
 		EnableDebugRPCEndpoints:       enableDebugRPCEndpoints,
 		MaxMsgSize:                    maxMsgSize,
+		MaxAttestationBytes:           maxAttestationBytes,
 		ProposerIdsCache:              b.proposerIdsCache,
 		BlockBuilder:                  b.fetchBuilderService(),
 		Router:                        router,
 		ClockWaiter:                   b.clockWaiter,
+		BackfillStatus:                b.backfillStatus,
+		LightClientUpdateFetcher:      chainService,
+		DutyHistoryFetcher:            dutyHistoryFetcher,
+		HistoricalReplayBudget:        primitives.Slot(historicalReplayBudget),
+		HistoricalReplayWorkers:       historicalReplayWorkers,
+		HistoricalStateCacheSize:      historicalStateCacheSize,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -1000,6 +1068,11 @@ func (b *BeaconNode) registerValidatorMonitorService(initialSyncComplete chan st
 		StateGen:            b.stateGen,
 		HeadFetcher:         chainService,
 		InitialSyncComplete: initialSyncComplete,
+
+		DutyHistorySize:             b.cliCtx.Int(flags.MonitorDutyHistorySize.Name),
+		WebhookURLs:                 b.cliCtx.StringSlice(flags.MonitorWebhookURLs.Name),
+		MissedAttestationsThreshold: b.cliCtx.Uint64(flags.MonitorMissedAttestationsThreshold.Name),
+		BalanceDropThreshold:        b.cliCtx.Uint64(flags.MonitorBalanceDropThreshold.Name),
 	}
 	svc, err := monitor.NewService(b.ctx, monitorConfig, tracked)
 	if err != nil {
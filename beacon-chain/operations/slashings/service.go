@@ -132,6 +132,7 @@ func (p *Pool) InsertAttesterSlashing(
 	slashedVal := slice.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
 	cantSlash := make([]uint64, 0, len(slashedVal))
 	slashingReason := ""
+	allPending := true
 	for _, val := range slashedVal {
 		// Has this validator index been included recently?
 		ok, err := p.validatorSlashingPreconditionCheck(state, primitives.ValidatorIndex(val))
@@ -142,6 +143,7 @@ func (p *Pool) InsertAttesterSlashing(
 		// has been recently included in the pool of slashings, skip including this indice.
 		if !ok {
 			slashingReason = "validator already exited/slashed or already recently included in slashings pool"
+			allPending = false
 			cantSlash = append(cantSlash, val)
 			continue
 		}
@@ -152,7 +154,7 @@ func (p *Pool) InsertAttesterSlashing(
 			return uint64(p.pendingAttesterSlashing[i].validatorToSlash) >= val
 		})
 		if found != len(p.pendingAttesterSlashing) && uint64(p.pendingAttesterSlashing[found].validatorToSlash) == val {
-			slashingReason = "validator already exist in list of pending slashings, no need to attempt to slash again"
+			slashingReason = ErrAttesterSlashingPending.Error()
 			cantSlash = append(cantSlash, val)
 			continue
 		}
@@ -169,6 +171,9 @@ func (p *Pool) InsertAttesterSlashing(
 		numPendingAttesterSlashings.Set(float64(len(p.pendingAttesterSlashing)))
 	}
 	if len(cantSlash) == len(slashedVal) {
+		if allPending && len(slashedVal) > 0 {
+			return errors.Wrapf(ErrAttesterSlashingPending, "could not slash any of %d validators in submitted slashing", len(slashedVal))
+		}
 		return fmt.Errorf(
 			"could not slash any of %d validators in submitted slashing: %s",
 			len(slashedVal),
@@ -213,7 +218,7 @@ func (p *Pool) InsertProposerSlashing(
 	})
 	if found != len(p.pendingProposerSlashing) && p.pendingProposerSlashing[found].Header_1.Header.ProposerIndex ==
 		slashing.Header_1.Header.ProposerIndex {
-		return errors.New("slashing object already exists in pending proposer slashings")
+		return ErrProposerSlashingPending
 	}
 
 	// Insert into pending list and sort again.
@@ -4,11 +4,21 @@ import (
 	"context"
 	"sync"
 
+	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/beacon-chain/state"
 	"github.com/theQRL/qrysm/consensus-types/primitives"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 )
 
+var (
+	// ErrAttesterSlashingPending is returned when inserting an attester slashing whose validators are all
+	// already in the list of pending slashings.
+	ErrAttesterSlashingPending = errors.New("validator already exist in list of pending slashings, no need to attempt to slash again")
+	// ErrProposerSlashingPending is returned when inserting a proposer slashing whose validator is already
+	// in the list of pending slashings.
+	ErrProposerSlashingPending = errors.New("slashing object already exists in pending proposer slashings")
+)
+
 // PoolInserter is capable of inserting new slashing objects into the operations pool.
 type PoolInserter interface {
 	InsertAttesterSlashing(
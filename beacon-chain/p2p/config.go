@@ -31,6 +31,7 @@ type Config struct {
 	PrivateKey          string
 	DataDir             string
 	TCPPort             uint
+	QUICPort            uint
 	UDPPort             uint
 	MaxPeers            uint
 	AllowListCIDR       string
@@ -16,11 +16,15 @@ import (
 	"github.com/theQRL/go-qrl/p2p/qnode"
 	"github.com/theQRL/go-qrl/p2p/qnr"
 	"github.com/theQRL/qrysm/cmd/beacon-chain/flags"
+	"github.com/theQRL/qrysm/config/features"
 	ecdsaqrysm "github.com/theQRL/qrysm/crypto/ecdsa"
 	"github.com/theQRL/qrysm/runtime/version"
 	"github.com/theQRL/qrysm/time/slots"
 )
 
+// quicQNRKey is the QNR key of the UDP port that a node listens on for QUIC connections.
+const quicQNRKey = "quic"
+
 // Listener defines the discovery V5 network interface that is used
 // to communicate with other peers.
 type Listener interface {
@@ -211,6 +215,7 @@ func (s *Service) createListener(
 		ipAddr,
 		int(s.cfg.UDPPort),
 		int(s.cfg.TCPPort),
+		int(s.cfg.QUICPort),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create local node")
@@ -259,7 +264,7 @@ func (s *Service) createListener(
 func (s *Service) createLocalNode(
 	privKey *ecdsa.PrivateKey,
 	ipAddr net.IP,
-	udpPort, tcpPort int,
+	udpPort, tcpPort, quicPort int,
 ) (*qnode.LocalNode, error) {
 	db, err := qnode.OpenDB("")
 	if err != nil {
@@ -273,6 +278,9 @@ func (s *Service) createLocalNode(
 	localNode.Set(ipEntry)
 	localNode.Set(udpEntry)
 	localNode.Set(tcpEntry)
+	if features.Get().EnableQUIC {
+		localNode.Set(qnr.WithEntry(quicQNRKey, uint16(quicPort)))
+	}
 	localNode.SetFallbackIP(ipAddr)
 	localNode.SetFallbackUDP(udpPort)
 
@@ -394,11 +402,11 @@ func PeersFromStringAddrs(addrs []string) ([]ma.Multiaddr, error) {
 		if err != nil {
 			return nil, errors.Wrapf(err, "Could not get qnode from string")
 		}
-		addr, err := convertToSingleMultiAddr(qnodeAddr)
+		nodeAddrs, err := retrieveMultiAddrsFromNode(qnodeAddr)
 		if err != nil {
 			return nil, errors.Wrapf(err, "Could not get multiaddr")
 		}
-		allAddrs = append(allAddrs, addr)
+		allAddrs = append(allAddrs, nodeAddrs...)
 	}
 	return allAddrs, nil
 }
@@ -439,26 +447,54 @@ func convertToMultiAddr(nodes []*qnode.Node) []ma.Multiaddr {
 		if node.IP() == nil {
 			continue
 		}
-		multiAddr, err := convertToSingleMultiAddr(node)
+		nodeAddrs, err := retrieveMultiAddrsFromNode(node)
 		if err != nil {
 			log.WithError(err).Error("Could not convert to multiAddr")
 			continue
 		}
-		multiAddrs = append(multiAddrs, multiAddr)
+		multiAddrs = append(multiAddrs, nodeAddrs...)
 	}
 	return multiAddrs
 }
 
+// convertToAddrInfo returns the address info used to dial the node, along with its preferred multiaddr.
 func convertToAddrInfo(node *qnode.Node) (*peer.AddrInfo, ma.Multiaddr, error) {
-	multiAddr, err := convertToSingleMultiAddr(node)
+	multiAddrs, err := retrieveMultiAddrsFromNode(node)
 	if err != nil {
 		return nil, nil, err
 	}
-	info, err := peer.AddrInfoFromP2pAddr(multiAddr)
+	infos, err := peer.AddrInfosFromP2pAddrs(multiAddrs...)
 	if err != nil {
 		return nil, nil, err
 	}
-	return info, multiAddr, nil
+	if len(infos) != 1 {
+		return nil, nil, errors.Errorf("expected the addresses of a single peer, got %d peers", len(infos))
+	}
+	return &infos[0], multiAddrs[0], nil
+}
+
+// retrieveMultiAddrsFromNode returns the multiaddrs to dial the node on, in order of preference. When both nodes
+// support QUIC its QUIC address comes first, and libp2p falls back to the TCP address if the QUIC dial fails.
+func retrieveMultiAddrsFromNode(node *qnode.Node) ([]ma.Multiaddr, error) {
+	tcpAddr, err := convertToSingleMultiAddr(node)
+	if err != nil {
+		return nil, err
+	}
+	if !features.Get().EnableQUIC {
+		return []ma.Multiaddr{tcpAddr}, nil
+	}
+	var quicPort uint16
+	if err := node.Record().Load(qnr.WithEntry(quicQNRKey, &quicPort)); err != nil {
+		if !qnr.IsNotFound(err) {
+			log.WithError(err).Debug("Could not retrieve quic port")
+		}
+		return []ma.Multiaddr{tcpAddr}, nil
+	}
+	quicAddr, err := convertToQuicMultiAddr(node, quicPort)
+	if err != nil {
+		return nil, err
+	}
+	return []ma.Multiaddr{quicAddr, tcpAddr}, nil
 }
 
 func convertToSingleMultiAddr(node *qnode.Node) (ma.Multiaddr, error) {
@@ -474,6 +510,23 @@ func convertToSingleMultiAddr(node *qnode.Node) (ma.Multiaddr, error) {
 	return multiAddressBuilderWithID(node.IP().String(), "tcp", uint(node.TCP()), id)
 }
 
+func convertToQuicMultiAddr(node *qnode.Node, port uint16) (ma.Multiaddr, error) {
+	pubkey := node.Pubkey()
+	assertedKey, err := ecdsaqrysm.ConvertToInterfacePubkey(pubkey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pubkey")
+	}
+	id, err := peer.IDFromPublicKey(assertedKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get peer id")
+	}
+	addr, err := QUICMultiAddressBuilder(node.IP().String(), uint(port))
+	if err != nil {
+		return nil, err
+	}
+	return addr.Encapsulate(ma.StringCast("/p2p/" + id.String())), nil
+}
+
 func convertToUdpMultiAddr(node *qnode.Node) ([]ma.Multiaddr, error) {
 	pubkey := node.Pubkey()
 	assertedKey, err := ecdsaqrysm.ConvertToInterfacePubkey(pubkey)
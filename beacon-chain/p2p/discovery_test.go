@@ -29,6 +29,7 @@ import (
 	testp2p "github.com/theQRL/qrysm/beacon-chain/p2p/testing"
 	"github.com/theQRL/qrysm/beacon-chain/startup"
 	"github.com/theQRL/qrysm/cmd/beacon-chain/flags"
+	"github.com/theQRL/qrysm/config/features"
 	"github.com/theQRL/qrysm/config/params"
 	"github.com/theQRL/qrysm/consensus-types/wrapper"
 	leakybucket "github.com/theQRL/qrysm/container/leaky-bucket"
@@ -157,12 +158,56 @@ func TestMultiAddrsConversion_InvalidIPAddr(t *testing.T) {
 		genesisTime:           time.Now(),
 		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
 	}
-	node, err := s.createLocalNode(pkey, addr, 0, 0)
+	node, err := s.createLocalNode(pkey, addr, 0, 0, 0)
 	require.NoError(t, err)
 	multiAddr := convertToMultiAddr([]*qnode.Node{node.Node()})
 	assert.Equal(t, 0, len(multiAddr), "Invalid ip address converted successfully")
 }
 
+func TestRetrieveMultiAddrsFromNode_QUIC(t *testing.T) {
+	ipAddr := net.ParseIP("192.168.0.1")
+	_, pkey := createAddrAndPrivKey(t)
+	s := &Service{
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+	}
+
+	t.Run("quic disabled", func(t *testing.T) {
+		node, err := s.createLocalNode(pkey, ipAddr, 3000, 3001, 3002)
+		require.NoError(t, err)
+		addrs, err := retrieveMultiAddrsFromNode(node.Node())
+		require.NoError(t, err)
+		require.Equal(t, 1, len(addrs))
+		assert.Equal(t, true, strings.HasPrefix(addrs[0].String(), "/ip4/192.168.0.1/tcp/3001/p2p/"))
+	})
+	t.Run("quic enabled", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{EnableQUIC: true})
+		defer resetCfg()
+		node, err := s.createLocalNode(pkey, ipAddr, 3000, 3001, 3002)
+		require.NoError(t, err)
+		addrs, err := retrieveMultiAddrsFromNode(node.Node())
+		require.NoError(t, err)
+		require.Equal(t, 2, len(addrs))
+		assert.Equal(t, true, strings.HasPrefix(addrs[0].String(), "/ip4/192.168.0.1/udp/3002/quic-v1/p2p/"))
+		assert.Equal(t, true, strings.HasPrefix(addrs[1].String(), "/ip4/192.168.0.1/tcp/3001/p2p/"))
+
+		info, preferred, err := convertToAddrInfo(node.Node())
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(info.Addrs))
+		assert.Equal(t, addrs[0].String(), preferred.String())
+	})
+	t.Run("peer without quic", func(t *testing.T) {
+		node, err := s.createLocalNode(pkey, ipAddr, 3000, 3001, 3002)
+		require.NoError(t, err)
+		resetCfg := features.InitWithReset(&features.Flags{EnableQUIC: true})
+		defer resetCfg()
+		addrs, err := retrieveMultiAddrsFromNode(node.Node())
+		require.NoError(t, err)
+		require.Equal(t, 1, len(addrs))
+		assert.Equal(t, transportTCP, transportFromAddr(addrs[0]))
+	})
+}
+
 func TestMultiAddrConversion_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ipAddr, pkey := createAddrAndPrivKey(t)
@@ -508,7 +553,7 @@ func TestRefreshQNR_ForkBoundaries(t *testing.T) {
 					genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
 					cfg:                   &Config{UDPPort: uint(port)},
 				}
-				localNode, err := s.createLocalNode(pkey, ipAddr, port, port)
+				localNode, err := s.createLocalNode(pkey, ipAddr, port, port, port)
 				assert.NoError(t, err)
 
 				s.dv5Listener = mockListener{localNode: localNode}
@@ -3,6 +3,7 @@ package p2p
 import (
 	"reflect"
 
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 	"google.golang.org/protobuf/proto"
 )
@@ -22,6 +23,8 @@ var gossipTopicMappings = map[string]func() proto.Message{
 	AggregateAndProofSubnetTopicFormat:        func() proto.Message { return &qrysmpb.SignedAggregateAttestationAndProof{} },
 	SyncContributionAndProofSubnetTopicFormat: func() proto.Message { return &qrysmpb.SignedContributionAndProof{} },
 	SyncCommitteeSubnetTopicFormat:            func() proto.Message { return &qrysmpb.SyncCommitteeMessage{} },
+	LightClientFinalityUpdateTopicFormat:      func() proto.Message { return &qrlpb.LightClientFinalityUpdate{} },
+	LightClientOptimisticUpdateTopicFormat:    func() proto.Message { return &qrlpb.LightClientOptimisticUpdate{} },
 }
 
 // GossipTopicMappings returns a freshly-allocated zero-valued protobuf message
@@ -5,10 +5,16 @@ import (
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/peerstore"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+const (
+	transportTCP  = "tcp"
+	transportQUIC = "quic"
+)
+
 var (
 	knownAgentVersions = []string{
 		"qrysm",
@@ -24,6 +30,12 @@ var (
 	},
 		[]string{"agent"},
 	)
+	connectedPeersCountByTransport = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "connected_libp2p_peers_by_transport",
+		Help: "Tracks the total number of connected libp2p peers by the transport of their connection",
+	},
+		[]string{"transport"},
+	)
 	avgScoreConnectedClients = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "connected_libp2p_peers_average_scores",
 		Help: "Tracks the overall p2p scores of connected libp2p peers by agent string",
@@ -144,6 +156,7 @@ func (s *Service) updateMetrics() {
 
 	store := s.Host().Peerstore()
 	numConnectedPeersByClient := make(map[string]float64)
+	numConnectedPeersByTransport := map[string]float64{transportTCP: 0, transportQUIC: 0}
 	peerScoresByClient := make(map[string][]float64)
 	for i := range connectedPeers {
 		p := connectedPeers[i]
@@ -155,6 +168,9 @@ func (s *Service) updateMetrics() {
 
 		foundName := agentFromPid(pid, store)
 		numConnectedPeersByClient[foundName] += 1
+		if conns := s.host.Network().ConnsToPeer(pid); len(conns) > 0 {
+			numConnectedPeersByTransport[transportFromAddr(conns[0].RemoteMultiaddr())] += 1
+		}
 
 		// Get peer scoring data.
 		overallScore := s.peers.Scorers().Score(pid)
@@ -164,6 +180,10 @@ func (s *Service) updateMetrics() {
 	for agent, total := range numConnectedPeersByClient {
 		connectedPeersCount.WithLabelValues(agent).Set(total)
 	}
+	connectedPeersCountByTransport.Reset() // Clear out previous results.
+	for transport, total := range numConnectedPeersByTransport {
+		connectedPeersCountByTransport.WithLabelValues(transport).Set(total)
+	}
 	avgScoreConnectedClients.Reset() // Clear out previous results.
 	for agent, scoringData := range peerScoresByClient {
 		avgScore := average(scoringData)
@@ -182,6 +202,17 @@ func average(xs []float64) float64 {
 	return total / float64(len(xs))
 }
 
+// transportFromAddr returns the transport of a connection to the remote address.
+func transportFromAddr(addr ma.Multiaddr) string {
+	if _, err := addr.ValueForProtocol(ma.P_QUIC_V1); err == nil {
+		return transportQUIC
+	}
+	if _, err := addr.ValueForProtocol(ma.P_TCP); err == nil {
+		return transportTCP
+	}
+	return "unknown"
+}
+
 func agentFromPid(pid peer.ID, store peerstore.Peerstore) string {
 	// Get the agent data.
 	rawAgent, err := store.Get(pid, "AgentVersion")
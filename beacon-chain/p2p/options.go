@@ -12,6 +12,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
 	"github.com/libp2p/go-libp2p/p2p/security/noise"
+	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
 	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	gomplex "github.com/libp2p/go-mplex"
 	ma "github.com/multiformats/go-multiaddr"
@@ -46,10 +47,39 @@ func MultiAddressBuilder(ipAddr string, port uint) (ma.Multiaddr, error) {
 	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/tcp/%d", ipAddr, port))
 }
 
+// QUICMultiAddressBuilder takes in an ip address string and UDP port to produce a go multiaddr format for QUIC.
+func QUICMultiAddressBuilder(ipAddr string, port uint) (ma.Multiaddr, error) {
+	parsedIP := net.ParseIP(ipAddr)
+	if parsedIP.To4() == nil && parsedIP.To16() == nil {
+		return nil, errors.Errorf("invalid ip address provided: %s", ipAddr)
+	}
+	if parsedIP.To4() != nil {
+		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/udp/%d/quic-v1", ipAddr, port))
+	}
+	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/udp/%d/quic-v1", ipAddr, port))
+}
+
+// hostMultiAddresses returns the TCP multiaddr of the libp2p host for the ip address, followed by its QUIC
+// multiaddr when the QUIC transport is enabled.
+func (cfg *Config) hostMultiAddresses(ipAddr string) ([]ma.Multiaddr, error) {
+	tcpAddr, err := MultiAddressBuilder(ipAddr, cfg.TCPPort)
+	if err != nil {
+		return nil, err
+	}
+	if !features.Get().EnableQUIC {
+		return []ma.Multiaddr{tcpAddr}, nil
+	}
+	quicAddr, err := QUICMultiAddressBuilder(ipAddr, cfg.QUICPort)
+	if err != nil {
+		return nil, err
+	}
+	return []ma.Multiaddr{tcpAddr, quicAddr}, nil
+}
+
 // buildOptions for the libp2p host.
 func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Option {
 	cfg := s.cfg
-	listen, err := MultiAddressBuilder(ip.String(), cfg.TCPPort)
+	listen, err := cfg.hostMultiAddresses(ip.String())
 	if err != nil {
 		log.WithError(err).Fatal("Failed to p2p listen")
 	}
@@ -57,7 +87,7 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 		if net.ParseIP(cfg.LocalIP) == nil {
 			log.Fatalf("Invalid local ip provided: %s", cfg.LocalIP)
 		}
-		listen, err = MultiAddressBuilder(cfg.LocalIP, cfg.TCPPort)
+		listen, err = cfg.hostMultiAddresses(cfg.LocalIP)
 		if err != nil {
 			log.WithError(err).Fatal("Failed to p2p listen")
 		}
@@ -74,7 +104,7 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 
 	options := []libp2p.Option{
 		privKeyOption(priKey),
-		libp2p.ListenAddrs(listen),
+		libp2p.ListenAddrs(listen...),
 		libp2p.UserAgent(version.BuildData()),
 		libp2p.ConnectionGater(s),
 		libp2p.Transport(tcp.NewTCPTransport),
@@ -82,6 +112,12 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 		libp2p.Muxer("/mplex/6.7.0", mplex.DefaultTransport),
 	}
 
+	// QUIC brings its own security and stream multiplexing, and avoids the head-of-line blocking that large blocks
+	// suffer from over TCP.
+	if features.Get().EnableQUIC {
+		options = append(options, libp2p.Transport(libp2pquic.NewTransport))
+	}
+
 	options = append(options, libp2p.Security(noise.ID, noise.New))
 	options = append(options, setConnManagerOption(cfg))
 
@@ -96,11 +132,11 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 	}
 	if cfg.HostAddress != "" {
 		options = append(options, libp2p.AddrsFactory(func(addrs []ma.Multiaddr) []ma.Multiaddr {
-			external, err := MultiAddressBuilder(cfg.HostAddress, cfg.TCPPort)
+			external, err := cfg.hostMultiAddresses(cfg.HostAddress)
 			if err != nil {
 				log.WithError(err).Error("Unable to create external multiaddress")
 			} else {
-				addrs = append(addrs, external)
+				addrs = append(addrs, external...)
 			}
 			return addrs
 		}))
@@ -113,6 +149,14 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 			} else {
 				addrs = append(addrs, external)
 			}
+			if features.Get().EnableQUIC {
+				external, err := ma.NewMultiaddr(fmt.Sprintf("/dns4/%s/udp/%d/quic-v1", cfg.HostDNS, cfg.QUICPort))
+				if err != nil {
+					log.WithError(err).Error("Unable to create external QUIC multiaddress")
+				} else {
+					addrs = append(addrs, external)
+				}
+			}
 			return addrs
 		}))
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/theQRL/go-qrl/p2p/qnode"
 	"github.com/theQRL/go-qrl/p2p/qnr"
 	mock "github.com/theQRL/qrysm/beacon-chain/blockchain/testing"
+	"github.com/theQRL/qrysm/config/features"
 	"github.com/theQRL/qrysm/config/params"
 	ecdsaqrysm "github.com/theQRL/qrysm/crypto/ecdsa"
 	"github.com/theQRL/qrysm/network"
@@ -129,6 +130,44 @@ func TestDefaultMultiplexers(t *testing.T) {
 
 }
 
+func TestQUICMultiAddressBuilder(t *testing.T) {
+	addr, err := QUICMultiAddressBuilder("192.168.0.1", 3000)
+	require.NoError(t, err)
+	assert.Equal(t, "/ip4/192.168.0.1/udp/3000/quic-v1", addr.String())
+	assert.Equal(t, transportQUIC, transportFromAddr(addr))
+
+	addr, err = QUICMultiAddressBuilder("::1", 3000)
+	require.NoError(t, err)
+	assert.Equal(t, "/ip6/::1/udp/3000/quic-v1", addr.String())
+
+	_, err = QUICMultiAddressBuilder("invalid", 3000)
+	assert.ErrorContains(t, "invalid ip address provided", err)
+}
+
+func TestBuildOptions_QUIC(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableQUIC: true})
+	defer resetCfg()
+
+	p2pCfg := &Config{
+		TCPPort:       2000,
+		UDPPort:       2000,
+		QUICPort:      2001,
+		LocalIP:       "127.0.0.1",
+		StateNotifier: &mock.MockStateNotifier{},
+	}
+	svc := &Service{cfg: p2pCfg}
+	var err error
+	svc.privKey, err = privKey(svc.cfg)
+	require.NoError(t, err)
+	opts := svc.buildOptions(network.IPAddr(), svc.privKey)
+	var cfg libp2p.Config
+	require.NoError(t, cfg.Apply(append(opts, libp2p.FallbackDefaults)...))
+
+	require.Equal(t, 2, len(cfg.ListenAddrs))
+	assert.Equal(t, "/ip4/127.0.0.1/tcp/2000", cfg.ListenAddrs[0].String())
+	assert.Equal(t, "/ip4/127.0.0.1/udp/2001/quic-v1", cfg.ListenAddrs[1].String())
+}
+
 func TestSetConnManagerOption(t *testing.T) {
 	cases := []struct {
 		name      string
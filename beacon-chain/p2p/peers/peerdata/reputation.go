@@ -0,0 +1,46 @@
+package peerdata
+
+import (
+	"time"
+)
+
+// MaxBanHistory is the number of bans kept in the ban history of a peer.
+const MaxBanHistory = 16
+
+// Ban is a period during which a peer is considered bad.
+type Ban struct {
+	Reason string
+	// Manual is true for bans set by the operator. Other bans are recorded when the peer scorers mark the peer as bad.
+	Manual bool
+	Start  time.Time
+	// End is the time at which the ban ended or ends. A zero end means the ban has no end set.
+	End time.Time
+}
+
+// Active reports whether the ban is in force at the given time.
+func (b *Ban) Active(now time.Time) bool {
+	return b.End.IsZero() || now.Before(b.End)
+}
+
+// ActiveBan returns the most recent ban of the peer that is in force at the given time, if any. Only manual bans
+// are considered when manual is true.
+func (d *PeerData) ActiveBan(now time.Time, manual bool) *Ban {
+	for i := len(d.Bans) - 1; i >= 0; i-- {
+		b := d.Bans[i]
+		if manual && !b.Manual {
+			continue
+		}
+		if b.Active(now) {
+			return b
+		}
+	}
+	return nil
+}
+
+// AddBan appends the ban to the ban history of the peer, dropping the oldest bans beyond MaxBanHistory.
+func (d *PeerData) AddBan(b *Ban) {
+	d.Bans = append(d.Bans, b)
+	if len(d.Bans) > MaxBanHistory {
+		d.Bans = d.Bans[len(d.Bans)-MaxBanHistory:]
+	}
+}
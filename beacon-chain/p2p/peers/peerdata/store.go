@@ -42,6 +42,7 @@ type Store struct {
 	config       *StoreConfig
 	peers        map[peer.ID]*PeerData
 	trustedPeers map[peer.ID]bool
+	allowedPeers map[peer.ID]bool
 }
 
 // PeerData aggregates protocol and application level info about a single peer.
@@ -65,6 +66,8 @@ type PeerData struct {
 	TopicScores      map[string]*qrysmpb.TopicScoreSnapshot
 	GossipScore      float64
 	BehaviourPenalty float64
+	// Bans is the ban history of the peer, oldest first.
+	Bans []*Ban
 }
 
 // NewStore creates new peer data store.
@@ -74,6 +77,7 @@ func NewStore(ctx context.Context, config *StoreConfig) *Store {
 		config:       config,
 		peers:        make(map[peer.ID]*PeerData),
 		trustedPeers: make(map[peer.ID]bool),
+		allowedPeers: make(map[peer.ID]bool),
 	}
 }
 
@@ -133,6 +137,37 @@ func (s *Store) DeleteTrustedPeers(peers []peer.ID) {
 	}
 }
 
+// SetAllowedPeers adds peers to the allow-list.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) SetAllowedPeers(peers []peer.ID) {
+	for _, p := range peers {
+		s.allowedPeers[p] = true
+	}
+}
+
+// GetAllowedPeers gets the ids of the allow-listed peers.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) GetAllowedPeers() []peer.ID {
+	peers := []peer.ID{}
+	for p := range s.allowedPeers {
+		peers = append(peers, p)
+	}
+	return peers
+}
+
+// DeleteAllowedPeers removes peers from the allow-list.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) DeleteAllowedPeers(peers []peer.ID) {
+	for _, p := range peers {
+		delete(s.allowedPeers, p)
+	}
+}
+
+// IsAllowedPeer checks that the provided peer is in the allow-list.
+func (s *Store) IsAllowedPeer(p peer.ID) bool {
+	return s.allowedPeers[p]
+}
+
 // Peers returns map of peer data objects.
 // Important: it is assumed that store mutex is locked when calling this method.
 func (s *Store) Peers() map[peer.ID]*PeerData {
@@ -0,0 +1,243 @@
+package peers
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/theQRL/qrysm/beacon-chain/p2p/peers/peerdata"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+)
+
+// Ban bans the peer for the given duration, or until it is unbanned when the duration is 0. A banned peer is bad,
+// even when it is trusted, and it is removed from the allow-list.
+func (p *Status) Ban(pid peer.ID, reason string, duration time.Duration) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	now := time.Now()
+	ban := &peerdata.Ban{
+		Reason: reason,
+		Manual: true,
+		Start:  now,
+	}
+	if duration > 0 {
+		ban.End = now.Add(duration)
+	}
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	if active := peerData.ActiveBan(now, true); active != nil {
+		active.End = now
+	}
+	peerData.AddBan(ban)
+	p.store.DeleteAllowedPeers([]peer.ID{pid})
+}
+
+// Unban ends the bans of the peer set by the operator. It returns false if the peer was not banned.
+func (p *Status) Unban(pid peer.ID) bool {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData, ok := p.store.PeerData(pid)
+	if !ok {
+		return false
+	}
+	now := time.Now()
+	unbanned := false
+	for _, b := range peerData.Bans {
+		if b.Manual && b.Active(now) {
+			b.End = now
+			unbanned = true
+		}
+	}
+	return unbanned
+}
+
+// ActiveBans returns the bans in force, by peer.
+func (p *Status) ActiveBans() map[peer.ID]*peerdata.Ban {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	now := time.Now()
+	bans := make(map[peer.ID]*peerdata.Ban)
+	for pid, peerData := range p.store.Peers() {
+		if b := peerData.ActiveBan(now, false); b != nil {
+			bans[pid] = copyBan(b)
+		}
+	}
+	return bans
+}
+
+// BanHistory returns the ban history of the peer, oldest first.
+func (p *Status) BanHistory(pid peer.ID) []*peerdata.Ban {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	peerData, ok := p.store.PeerData(pid)
+	if !ok {
+		return nil
+	}
+	bans := make([]*peerdata.Ban, len(peerData.Bans))
+	for i, b := range peerData.Bans {
+		bans[i] = copyBan(b)
+	}
+	return bans
+}
+
+// isManuallyBanned is the lock-free check of whether the operator banned the peer.
+func (p *Status) isManuallyBanned(pid peer.ID) bool {
+	peerData, ok := p.store.PeerData(pid)
+	if !ok {
+		return false
+	}
+	return peerData.ActiveBan(time.Now(), true) != nil
+}
+
+// AllowPeers adds peers to the allow-list. Allowed peers are never considered bad by the peer scorers, but unlike
+// trusted peers they are not dialed or kept connected.
+func (p *Status) AllowPeers(peers []peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.SetAllowedPeers(peers)
+}
+
+// GetAllowedPeers returns the ids of the allow-listed peers.
+func (p *Status) GetAllowedPeers() []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.store.GetAllowedPeers()
+}
+
+// DisallowPeers removes peers from the allow-list.
+func (p *Status) DisallowPeers(peers []peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.DeleteAllowedPeers(peers)
+}
+
+// IsAllowedPeer returns whether the peer is allow-listed.
+func (p *Status) IsAllowedPeer(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.store.IsAllowedPeer(pid)
+}
+
+// Reputations returns the reputation of every peer that has one worth keeping across restarts. It records a ban in
+// the ban history of the peers that the scorers newly consider as bad, and ends the recorded bans of the peers that
+// are no longer bad.
+func (p *Status) Reputations() map[peer.ID]*qrysmpb.PeerReputation {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	now := time.Now()
+	reputations := make(map[peer.ID]*qrysmpb.PeerReputation)
+	for pid, peerData := range p.store.Peers() {
+		p.updateScorerBan(pid, peerData, now)
+		allowed := p.store.IsAllowedPeer(pid)
+		if peerData.BadResponses == 0 && peerData.GossipScore >= 0 && peerData.BehaviourPenalty == 0 &&
+			len(peerData.Bans) == 0 && !allowed {
+			// Nothing worth keeping.
+			continue
+		}
+		r := &qrysmpb.PeerReputation{
+			BadResponses:     uint64(peerData.BadResponses),
+			GossipScore:      peerData.GossipScore,
+			BehaviourPenalty: peerData.BehaviourPenalty,
+			Allowed:          allowed,
+		}
+		for _, b := range peerData.Bans {
+			r.Bans = append(r.Bans, banToProto(b))
+		}
+		reputations[pid] = r
+	}
+	for _, pid := range p.store.GetAllowedPeers() {
+		if _, ok := reputations[pid]; !ok {
+			reputations[pid] = &qrysmpb.PeerReputation{Allowed: true}
+		}
+	}
+	return reputations
+}
+
+// updateScorerBan keeps the ban history of the peer in line with the verdict of the scorers.
+func (p *Status) updateScorerBan(pid peer.ID, peerData *peerdata.PeerData, now time.Time) {
+	var active *peerdata.Ban
+	for _, b := range peerData.Bans {
+		if !b.Manual && b.Active(now) {
+			active = b
+		}
+	}
+	reason := ""
+	if !p.store.IsTrustedPeer(pid) && !p.store.IsAllowedPeer(pid) {
+		reason = p.scorers.BadReasonNoLock(pid)
+	}
+	switch {
+	case reason != "" && active == nil:
+		peerData.AddBan(&peerdata.Ban{Reason: reason, Start: now})
+	case reason == "" && active != nil:
+		active.End = now
+	}
+}
+
+// RestoreReputations loads reputations saved by an earlier run of the node, so that known bad peers are not
+// reconnected to right away.
+func (p *Status) RestoreReputations(reputations map[peer.ID]*qrysmpb.PeerReputation) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	now := time.Now()
+	for pid, r := range reputations {
+		if r == nil {
+			continue
+		}
+		peerData := p.store.PeerDataGetOrCreate(pid)
+		peerData.BadResponses = int(r.BadResponses) // lint:ignore uintcast -- Bad responses are bounded by the scorer threshold.
+		peerData.GossipScore = r.GossipScore
+		peerData.BehaviourPenalty = r.BehaviourPenalty
+		peerData.Bans = nil
+		for _, pb := range r.Bans {
+			b := banFromProto(pb)
+			// Bans recorded from the scorers end with the run that recorded them, the restored scores decide
+			// whether the peer is still bad.
+			if !b.Manual && b.End.IsZero() {
+				b.End = now
+			}
+			peerData.AddBan(b)
+		}
+		if r.Allowed {
+			p.store.SetAllowedPeers([]peer.ID{pid})
+		}
+	}
+}
+
+func copyBan(b *peerdata.Ban) *peerdata.Ban {
+	c := *b
+	return &c
+}
+
+// banToProto converts the ban to its database record. Zero times are stored as 0.
+func banToProto(b *peerdata.Ban) *qrysmpb.PeerBan {
+	pb := &qrysmpb.PeerBan{
+		Reason: b.Reason,
+		Manual: b.Manual,
+	}
+	if !b.Start.IsZero() {
+		pb.StartUnixNano = b.Start.UnixNano()
+	}
+	if !b.End.IsZero() {
+		pb.EndUnixNano = b.End.UnixNano()
+	}
+	return pb
+}
+
+// banFromProto converts the database record of a ban back to a ban.
+func banFromProto(pb *qrysmpb.PeerBan) *peerdata.Ban {
+	b := &peerdata.Ban{
+		Reason: pb.Reason,
+		Manual: pb.Manual,
+	}
+	if pb.StartUnixNano != 0 {
+		b.Start = time.Unix(0, pb.StartUnixNano)
+	}
+	if pb.EndUnixNano != 0 {
+		b.End = time.Unix(0, pb.EndUnixNano)
+	}
+	return b
+}
@@ -0,0 +1,146 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/theQRL/qrysm/beacon-chain/p2p/peers"
+	"github.com/theQRL/qrysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/theQRL/qrysm/beacon-chain/p2p/peers/scorers"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func newReputationTestStatus() *peers.Status {
+	return peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+}
+
+func TestStatus_Ban(t *testing.T) {
+	p := newReputationTestStatus()
+	pid := addPeer(t, p, peers.PeerConnected)
+	trusted := addPeer(t, p, peers.PeerConnected)
+	p.SetTrustedPeers([]peer.ID{trusted})
+	p.AllowPeers([]peer.ID{pid})
+
+	p.Ban(pid, "spam", 0)
+	p.Ban(trusted, "misbehaving", 0)
+	assert.Equal(t, true, p.IsBad(pid))
+	assert.Equal(t, true, p.IsBad(trusted), "Banned trusted peer is not bad")
+	assert.Equal(t, false, p.IsAllowedPeer(pid), "Banned peer is still allowed")
+	bans := p.ActiveBans()
+	require.Equal(t, 2, len(bans))
+	assert.Equal(t, "spam", bans[pid].Reason)
+	assert.Equal(t, true, bans[pid].Manual)
+	assert.Equal(t, true, bans[pid].End.IsZero())
+
+	// Banning again replaces the ban in force.
+	p.Ban(pid, "more spam", time.Hour)
+	history := p.BanHistory(pid)
+	require.Equal(t, 2, len(history))
+	assert.Equal(t, false, history[0].Active(time.Now()))
+	assert.Equal(t, true, history[1].Active(time.Now()))
+	assert.Equal(t, "more spam", p.ActiveBans()[pid].Reason)
+
+	assert.Equal(t, true, p.Unban(pid))
+	assert.Equal(t, false, p.Unban(pid), "Unbanned peer twice")
+	assert.Equal(t, false, p.IsBad(pid))
+	assert.Equal(t, 2, len(p.BanHistory(pid)))
+	_, ok := p.ActiveBans()[pid]
+	assert.Equal(t, false, ok)
+}
+
+func TestStatus_BanExpires(t *testing.T) {
+	p := newReputationTestStatus()
+	pid := addPeer(t, p, peers.PeerConnected)
+
+	p.Ban(pid, "spam", time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	assert.Equal(t, false, p.IsBad(pid))
+	assert.Equal(t, 0, len(p.ActiveBans()))
+}
+
+func TestStatus_AllowedPeers(t *testing.T) {
+	p := newReputationTestStatus()
+	pid := addPeer(t, p, peers.PeerConnected)
+	scorer := p.Scorers().BadResponsesScorer()
+	scorer.Increment(pid)
+	scorer.Increment(pid)
+	require.Equal(t, true, p.IsBad(pid))
+
+	p.AllowPeers([]peer.ID{pid})
+	assert.Equal(t, true, p.IsAllowedPeer(pid))
+	assert.Equal(t, false, p.IsBad(pid), "Allowed peer is bad")
+	assert.DeepEqual(t, []peer.ID{pid}, p.GetAllowedPeers())
+
+	p.DisallowPeers([]peer.ID{pid})
+	assert.Equal(t, false, p.IsAllowedPeer(pid))
+	assert.Equal(t, true, p.IsBad(pid))
+}
+
+func TestStatus_Reputations(t *testing.T) {
+	p := newReputationTestStatus()
+	bad := addPeer(t, p, peers.PeerConnected)
+	banned := addPeer(t, p, peers.PeerConnected)
+	allowed := addPeer(t, p, peers.PeerConnected)
+	addPeer(t, p, peers.PeerConnected)
+
+	scorer := p.Scorers().BadResponsesScorer()
+	scorer.Increment(bad)
+	scorer.Increment(bad)
+	p.Ban(banned, "spam", 0)
+	p.AllowPeers([]peer.ID{allowed})
+
+	reputations := p.Reputations()
+	require.Equal(t, 3, len(reputations))
+	assert.Equal(t, uint64(2), reputations[bad].BadResponses)
+	require.Equal(t, 1, len(reputations[bad].Bans))
+	assert.Equal(t, "too many bad responses", reputations[bad].Bans[0].Reason)
+	assert.Equal(t, false, reputations[bad].Bans[0].Manual)
+	assert.Equal(t, true, reputations[banned].Bans[0].Manual)
+	assert.Equal(t, true, reputations[allowed].Allowed)
+
+	// The scorer ban is recorded once, and ends when the peer is no longer bad.
+	require.Equal(t, 1, len(p.Reputations()[bad].Bans))
+	scorer.Decay()
+	reputations = p.Reputations()
+	require.Equal(t, 1, len(reputations[bad].Bans))
+	assert.NotEqual(t, int64(0), reputations[bad].Bans[0].EndUnixNano)
+	assert.Equal(t, false, time.Now().Before(time.Unix(0, reputations[bad].Bans[0].EndUnixNano)))
+}
+
+func TestStatus_RestoreReputations(t *testing.T) {
+	p := newReputationTestStatus()
+	bad := addPeer(t, p, peers.PeerConnected)
+	banned := addPeer(t, p, peers.PeerConnected)
+	allowed := addPeer(t, p, peers.PeerConnected)
+	scorer := p.Scorers().BadResponsesScorer()
+	scorer.Increment(bad)
+	scorer.Increment(bad)
+	p.Ban(banned, "spam", 0)
+	p.AllowPeers([]peer.ID{allowed})
+	reputations := p.Reputations()
+
+	restored := newReputationTestStatus()
+	restored.RestoreReputations(reputations)
+	assert.Equal(t, true, restored.IsBad(bad))
+	assert.Equal(t, true, restored.IsBad(banned))
+	assert.Equal(t, true, restored.IsAllowedPeer(allowed))
+	assert.Equal(t, false, restored.IsBad(allowed))
+
+	// Scorer bans of the earlier run are closed, while bans set by the operator carry over.
+	history := restored.BanHistory(bad)
+	require.Equal(t, 1, len(history))
+	assert.Equal(t, false, history[0].End.IsZero())
+	bans := restored.ActiveBans()
+	require.Equal(t, 1, len(bans))
+	assert.DeepEqual(t, &peerdata.Ban{Reason: "spam", Manual: true, Start: time.Unix(0, reputations[banned].Bans[0].StartUnixNano)}, bans[banned])
+}
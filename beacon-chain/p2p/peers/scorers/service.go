@@ -123,6 +123,75 @@ func (s *Service) ScoreNoLock(pid peer.ID) float64 {
 	return math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
 }
 
+// ComponentScore is the score given to a peer by a single scorer.
+type ComponentScore struct {
+	Score  float64
+	Weight float64
+	IsBad  bool
+}
+
+// ScoreBreakdown holds the overall score of a peer along with the score given by every scorer.
+type ScoreBreakdown struct {
+	Score         float64
+	BadResponses  ComponentScore
+	BlockProvider ComponentScore
+	PeerStatus    ComponentScore
+	Gossip        ComponentScore
+}
+
+// ScoreBreakdown returns the overall score of the peer and the score given by every scorer.
+func (s *Service) ScoreBreakdown(pid peer.ID) *ScoreBreakdown {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.ScoreBreakdownNoLock(pid)
+}
+
+// ScoreBreakdownNoLock is a lock-free version of ScoreBreakdown.
+func (s *Service) ScoreBreakdownNoLock(pid peer.ID) *ScoreBreakdown {
+	if _, ok := s.store.PeerData(pid); !ok {
+		return &ScoreBreakdown{}
+	}
+	return &ScoreBreakdown{
+		Score: s.ScoreNoLock(pid),
+		BadResponses: ComponentScore{
+			Score:  s.scorers.badResponsesScorer.score(pid),
+			Weight: s.scorerWeight(s.scorers.badResponsesScorer),
+			IsBad:  s.scorers.badResponsesScorer.isBadPeer(pid),
+		},
+		BlockProvider: ComponentScore{
+			Score:  s.scorers.blockProviderScorer.score(pid),
+			Weight: s.scorerWeight(s.scorers.blockProviderScorer),
+		},
+		PeerStatus: ComponentScore{
+			Score:  s.scorers.peerStatusScorer.score(pid),
+			Weight: s.scorerWeight(s.scorers.peerStatusScorer),
+			IsBad:  s.scorers.peerStatusScorer.isBadPeer(pid),
+		},
+		Gossip: ComponentScore{
+			Score:  s.scorers.gossipScorer.score(pid),
+			Weight: s.scorerWeight(s.scorers.gossipScorer),
+			IsBad:  features.Get().EnablePeerScorer && s.scorers.gossipScorer.isBadPeer(pid),
+		},
+	}
+}
+
+// BadReasonNoLock returns why the scorers consider the peer as bad, or an empty string when the peer is not bad.
+func (s *Service) BadReasonNoLock(pid peer.ID) string {
+	if s.scorers.badResponsesScorer.isBadPeer(pid) {
+		return "too many bad responses"
+	}
+	if s.scorers.peerStatusScorer.isBadPeer(pid) {
+		if peerData, ok := s.store.PeerData(pid); ok && peerData.ChainStateValidationError != nil {
+			return "invalid chain status: " + peerData.ChainStateValidationError.Error()
+		}
+		return "invalid chain status"
+	}
+	if features.Get().EnablePeerScorer && s.scorers.gossipScorer.isBadPeer(pid) {
+		return "gossip score below threshold"
+	}
+	return ""
+}
+
 // IsBadPeer traverses all the scorers to see if any of them classifies peer as bad.
 func (s *Service) IsBadPeer(pid peer.ID) bool {
 	s.store.RLock()
@@ -344,8 +344,12 @@ func (p *Status) IsBad(pid peer.ID) bool {
 
 // isBad is the lock-free version of IsBad.
 func (p *Status) isBad(pid peer.ID) bool {
-	// Do not disconnect from trusted peers.
-	if p.store.IsTrustedPeer(pid) {
+	// Peers banned by the operator are bad, even when trusted or allowed.
+	if p.isManuallyBanned(pid) {
+		return true
+	}
+	// Do not disconnect from trusted or allowed peers.
+	if p.store.IsTrustedPeer(pid) || p.store.IsAllowedPeer(pid) {
 		return false
 	}
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeerNoLock(pid)
@@ -623,8 +627,9 @@ func (p *Status) deprecatedPrune() {
 	//	return
 	//}
 
+	now := time.Now()
 	notBadPeer := func(peerData *peerdata.PeerData) bool {
-		return peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold
+		return peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold && peerData.ActiveBan(now, true) == nil
 	}
 	notTrustedPeer := func(pid peer.ID) bool {
 		return !p.isTrustedPeers(pid)
@@ -0,0 +1,34 @@
+package p2p
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// restorePeerReputations loads the peer scores and bans saved by the previous run of the node.
+func (s *Service) restorePeerReputations() {
+	if s.cfg.DB == nil {
+		return
+	}
+	reputations, err := s.cfg.DB.PeerReputations(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not load peer reputations")
+		return
+	}
+	s.peers.RestoreReputations(reputations)
+	if len(reputations) > 0 {
+		log.WithFields(logrus.Fields{
+			"peers":     len(reputations),
+			"bannedNow": len(s.peers.ActiveBans()),
+		}).Info("Restored peer reputations")
+	}
+}
+
+// savePeerReputations saves the peer scores and bans so that they survive a restart.
+func (s *Service) savePeerReputations() {
+	if s.cfg.DB == nil || s.peers == nil {
+		return
+	}
+	if err := s.cfg.DB.SavePeerReputations(s.ctx, s.peers.Reputations()); err != nil {
+		log.WithError(err).Error("Could not save peer reputations")
+	}
+}
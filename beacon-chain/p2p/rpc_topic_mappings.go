@@ -36,6 +36,18 @@ const PingMessageName = "/ping"
 // MetadataMessageName specifies the name for the metadata message topic.
 const MetadataMessageName = "/metadata"
 
+// LightClientBootstrapMessageName specifies the name for the light client bootstrap message topic.
+const LightClientBootstrapMessageName = "/light_client_bootstrap"
+
+// LightClientUpdatesByRangeMessageName specifies the name for the light client updates by range message topic.
+const LightClientUpdatesByRangeMessageName = "/light_client_updates_by_range"
+
+// LightClientFinalityUpdateMessageName specifies the name for the light client finality update message topic.
+const LightClientFinalityUpdateMessageName = "/light_client_finality_update"
+
+// LightClientOptimisticUpdateMessageName specifies the name for the light client optimistic update message topic.
+const LightClientOptimisticUpdateMessageName = "/light_client_optimistic_update"
+
 const (
 	// V1 RPC Topics
 	// RPCStatusTopicV1 defines the v1 topic for the status rpc method.
@@ -44,6 +56,14 @@ const (
 	RPCGoodByeTopicV1 = protocolPrefix + GoodbyeMessageName + SchemaVersionV1
 	// RPCPingTopicV1 defines the v1 topic for the ping rpc method.
 	RPCPingTopicV1 = protocolPrefix + PingMessageName + SchemaVersionV1
+	// RPCLightClientBootstrapTopicV1 defines the v1 topic for the light client bootstrap rpc method.
+	RPCLightClientBootstrapTopicV1 = protocolPrefix + LightClientBootstrapMessageName + SchemaVersionV1
+	// RPCLightClientUpdatesByRangeTopicV1 defines the v1 topic for the light client updates by range rpc method.
+	RPCLightClientUpdatesByRangeTopicV1 = protocolPrefix + LightClientUpdatesByRangeMessageName + SchemaVersionV1
+	// RPCLightClientFinalityUpdateTopicV1 defines the v1 topic for the light client finality update rpc method.
+	RPCLightClientFinalityUpdateTopicV1 = protocolPrefix + LightClientFinalityUpdateMessageName + SchemaVersionV1
+	// RPCLightClientOptimisticUpdateTopicV1 defines the v1 topic for the light client optimistic update rpc method.
+	RPCLightClientOptimisticUpdateTopicV1 = protocolPrefix + LightClientOptimisticUpdateMessageName + SchemaVersionV1
 
 	// V2 RPC Topics
 	// RPCBlocksByRangeTopicV2 defines v2 the topic for the blocks by range rpc method.
@@ -73,6 +93,14 @@ var RPCTopicMappings = map[string]any{
 	RPCPingTopicV1: new(primitives.SSZUint64),
 	// RPC Metadata Message
 	RPCMetaDataTopicV2: new(any),
+	// RPC Light Client Bootstrap Message
+	RPCLightClientBootstrapTopicV1: new(p2ptypes.LightClientBootstrapReq),
+	// RPC Light Client Updates By Range Message
+	RPCLightClientUpdatesByRangeTopicV1: new(p2ptypes.LightClientUpdatesByRangeReq),
+	// RPC Light Client Finality Update Message
+	RPCLightClientFinalityUpdateTopicV1: new(any),
+	// RPC Light Client Optimistic Update Message
+	RPCLightClientOptimisticUpdateTopicV1: new(any),
 }
 
 // Maps all registered protocol prefixes.
@@ -89,6 +117,11 @@ var messageMapping = map[string]bool{
 	BeaconBlocksByRootsMessageName: true,
 	PingMessageName:                true,
 	MetadataMessageName:            true,
+
+	LightClientBootstrapMessageName:        true,
+	LightClientUpdatesByRangeMessageName:   true,
+	LightClientFinalityUpdateMessageName:   true,
+	LightClientOptimisticUpdateMessageName: true,
 }
 
 // Maps all the RPC messages which are to updated in altair.
@@ -54,6 +54,9 @@ var refreshRate = slots.DivideSlotBy(2)
 // maxBadResponses is the maximum number of bad responses from a peer before we stop talking to it.
 const maxBadResponses = 5
 
+// peerReputationSaveInterval is how often peer scores and bans are saved to the database.
+var peerReputationSaveInterval = time.Minute
+
 // pubsubQueueSize is the size that we assign to our validation queue and outbound message queue for
 // gossipsub.
 const pubsubQueueSize = 600
@@ -168,6 +171,8 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		},
 	})
 
+	s.restorePeerReputations()
+
 	// Initialize Data maps.
 	types.InitializeDataMaps()
 
@@ -236,6 +241,7 @@ func (s *Service) Start() {
 		ensurePeerConnections(s.ctx, s.host, s.peers, relayNodes...)
 	})
 	async.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	async.RunEvery(s.ctx, peerReputationSaveInterval, s.savePeerReputations)
 	async.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	async.RunEvery(s.ctx, refreshRate, s.RefreshQNR)
 	async.RunEvery(s.ctx, 1*time.Minute, func() {
@@ -271,6 +277,7 @@ func (s *Service) Stop() error {
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
+	s.savePeerReputations()
 	return nil
 }
 
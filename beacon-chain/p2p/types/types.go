@@ -120,3 +120,68 @@ func (m *ErrorMessage) UnmarshalSSZ(buf []byte) error {
 	*m = errMsg
 	return nil
 }
+
+// LightClientBootstrapReq specifies the light client bootstrap request type, which is the
+// root of the trusted block to bootstrap from.
+type LightClientBootstrapReq [rootLength]byte
+
+// MarshalSSZTo marshals the light client bootstrap request with the provided byte slice.
+func (r *LightClientBootstrapReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, r[:]...), nil
+}
+
+// MarshalSSZ Marshals the light client bootstrap request type into the serialized object.
+func (r *LightClientBootstrapReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, r.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (*LightClientBootstrapReq) SizeSSZ() int {
+	return rootLength
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// light client bootstrap request object.
+func (r *LightClientBootstrapReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != rootLength {
+		return ssz.ErrSize
+	}
+	copy(r[:], buf)
+	return nil
+}
+
+const lightClientUpdatesByRangeReqLength = 16
+
+// LightClientUpdatesByRangeReq specifies the light client updates by range request type.
+type LightClientUpdatesByRangeReq struct {
+	StartPeriod uint64
+	Count       uint64
+}
+
+// MarshalSSZTo marshals the light client updates by range request with the provided byte slice.
+func (r *LightClientUpdatesByRangeReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = ssz.MarshalUint64(dst, r.StartPeriod)
+	dst = ssz.MarshalUint64(dst, r.Count)
+	return dst, nil
+}
+
+// MarshalSSZ Marshals the light client updates by range request type into the serialized object.
+func (r *LightClientUpdatesByRangeReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, r.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (*LightClientUpdatesByRangeReq) SizeSSZ() int {
+	return lightClientUpdatesByRangeReqLength
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// light client updates by range request object.
+func (r *LightClientUpdatesByRangeReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != lightClientUpdatesByRangeReqLength {
+		return ssz.ErrSize
+	}
+	r.StartPeriod = ssz.UnmarshallUint64(buf[0:8])
+	r.Count = ssz.UnmarshallUint64(buf[8:16])
+	return nil
+}
@@ -124,3 +124,23 @@ func hexDecodeOrDie(t *testing.T, str string) []byte {
 	require.NoError(t, err)
 	return decoded
 }
+
+func TestLightClientReqs_RoundTrip(t *testing.T) {
+	bootstrapReq := LightClientBootstrapReq{'a', 'b'}
+	enc, err := bootstrapReq.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, 32, len(enc))
+	newBootstrapReq := LightClientBootstrapReq{}
+	require.NoError(t, newBootstrapReq.UnmarshalSSZ(enc))
+	assert.Equal(t, bootstrapReq, newBootstrapReq)
+	require.ErrorContains(t, "incorrect size", newBootstrapReq.UnmarshalSSZ(enc[:31]))
+
+	rangeReq := LightClientUpdatesByRangeReq{StartPeriod: 5, Count: 10}
+	enc, err = rangeReq.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, 16, len(enc))
+	newRangeReq := LightClientUpdatesByRangeReq{}
+	require.NoError(t, newRangeReq.UnmarshalSSZ(enc))
+	assert.Equal(t, rangeReq, newRangeReq)
+	require.ErrorContains(t, "incorrect size", newRangeReq.UnmarshalSSZ(enc[:8]))
+}
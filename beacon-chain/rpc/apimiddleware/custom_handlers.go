@@ -282,6 +282,10 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &EventChainReorgJson{}
 			case events.SyncCommitteeContributionTopic:
 				data = &SignedContributionAndProofJson{}
+			case events.LightClientFinalityUpdateTopic:
+				data = &EventLightClientFinalityUpdateJson{}
+			case events.LightClientOptimisticUpdateTopic:
+				data = &EventLightClientOptimisticUpdateJson{}
 			case events.PayloadAttributesTopic:
 				dataSubset := &dataSubset{}
 				if err := json.Unmarshal(msg.Data, dataSubset); err != nil {
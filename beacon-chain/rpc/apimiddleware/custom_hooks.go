@@ -342,50 +342,3 @@ func serializeProducedBlindedBlock(response any) (apimiddleware.RunDefault, []by
 	}
 	return false, j, nil
 }
-
-func prepareForkChoiceResponse(response any) (apimiddleware.RunDefault, []byte, apimiddleware.ErrorJson) {
-	dump, ok := response.(*ForkChoiceDumpJson)
-	if !ok {
-		return false, nil, apimiddleware.InternalServerError(errors.New("response is not of the correct type"))
-	}
-
-	nodes := make([]*ForkChoiceNodeResponseJson, len(dump.ForkChoiceNodes))
-	for i, n := range dump.ForkChoiceNodes {
-		nodes[i] = &ForkChoiceNodeResponseJson{
-			Slot:               n.Slot,
-			BlockRoot:          n.BlockRoot,
-			ParentRoot:         n.ParentRoot,
-			JustifiedEpoch:     n.JustifiedEpoch,
-			FinalizedEpoch:     n.FinalizedEpoch,
-			Weight:             n.Weight,
-			Validity:           n.Validity,
-			ExecutionBlockHash: n.ExecutionBlockHash,
-			ExtraData: &ForkChoiceNodeExtraDataJson{
-				UnrealizedJustifiedEpoch: n.UnrealizedJustifiedEpoch,
-				UnrealizedFinalizedEpoch: n.UnrealizedFinalizedEpoch,
-				Balance:                  n.Balance,
-				ExecutionOptimistic:      n.ExecutionOptimistic,
-				TimeStamp:                n.TimeStamp,
-			},
-		}
-	}
-	forkChoice := &ForkChoiceResponseJson{
-		JustifiedCheckpoint: dump.JustifiedCheckpoint,
-		FinalizedCheckpoint: dump.FinalizedCheckpoint,
-		ForkChoiceNodes:     nodes,
-		ExtraData: &ForkChoiceResponseExtraDataJson{
-			BestJustifiedCheckpoint:       dump.BestJustifiedCheckpoint,
-			UnrealizedJustifiedCheckpoint: dump.UnrealizedJustifiedCheckpoint,
-			UnrealizedFinalizedCheckpoint: dump.UnrealizedFinalizedCheckpoint,
-			ProposerBoostRoot:             dump.ProposerBoostRoot,
-			PreviousProposerBoostRoot:     dump.PreviousProposerBoostRoot,
-			HeadRoot:                      dump.HeadRoot,
-		},
-	}
-
-	result, err := json.Marshal(forkChoice)
-	if err != nil {
-		return false, nil, apimiddleware.InternalServerError(errors.New("could not marshal fork choice to JSON"))
-	}
-	return false, result, nil
-}
@@ -382,113 +382,3 @@ func TestSerializeProduceBlindedBlock(t *testing.T) {
 		assert.Equal(t, true, strings.Contains(errJson.Msg(), "unsupported block version"))
 	})
 }
-
-func TestPrepareForkChoiceResponse(t *testing.T) {
-	dump := &ForkChoiceDumpJson{
-		JustifiedCheckpoint: &CheckpointJson{
-			Epoch: "justified",
-			Root:  "justified",
-		},
-		FinalizedCheckpoint: &CheckpointJson{
-			Epoch: "finalized",
-			Root:  "finalized",
-		},
-		BestJustifiedCheckpoint: &CheckpointJson{
-			Epoch: "best_justified",
-			Root:  "best_justified",
-		},
-		UnrealizedJustifiedCheckpoint: &CheckpointJson{
-			Epoch: "unrealized_justified",
-			Root:  "unrealized_justified",
-		},
-		UnrealizedFinalizedCheckpoint: &CheckpointJson{
-			Epoch: "unrealized_finalized",
-			Root:  "unrealized_finalized",
-		},
-		ProposerBoostRoot:         "proposer_boost_root",
-		PreviousProposerBoostRoot: "previous_proposer_boost_root",
-		HeadRoot:                  "head_root",
-		ForkChoiceNodes: []*ForkChoiceNodeJson{
-			{
-				Slot:                     "node1_slot",
-				BlockRoot:                "node1_block_root",
-				ParentRoot:               "node1_parent_root",
-				JustifiedEpoch:           "node1_justified_epoch",
-				FinalizedEpoch:           "node1_finalized_epoch",
-				UnrealizedJustifiedEpoch: "node1_unrealized_justified_epoch",
-				UnrealizedFinalizedEpoch: "node1_unrealized_finalized_epoch",
-				Balance:                  "node1_balance",
-				Weight:                   "node1_weight",
-				ExecutionOptimistic:      false,
-				ExecutionBlockHash:       "node1_execution_block_hash",
-				TimeStamp:                "node1_time_stamp",
-				Validity:                 "node1_validity",
-			},
-			{
-				Slot:                     "node2_slot",
-				BlockRoot:                "node2_block_root",
-				ParentRoot:               "node2_parent_root",
-				JustifiedEpoch:           "node2_justified_epoch",
-				FinalizedEpoch:           "node2_finalized_epoch",
-				UnrealizedJustifiedEpoch: "node2_unrealized_justified_epoch",
-				UnrealizedFinalizedEpoch: "node2_unrealized_finalized_epoch",
-				Balance:                  "node2_balance",
-				Weight:                   "node2_weight",
-				ExecutionOptimistic:      true,
-				ExecutionBlockHash:       "node2_execution_block_hash",
-				TimeStamp:                "node2_time_stamp",
-				Validity:                 "node2_validity",
-			},
-		},
-	}
-	runDefault, j, errorJson := prepareForkChoiceResponse(dump)
-	assert.Equal(t, nil, errorJson)
-	assert.Equal(t, apimiddleware.RunDefault(false), runDefault)
-	result := &ForkChoiceResponseJson{}
-	require.NoError(t, json.Unmarshal(j, result))
-	require.NotNil(t, result)
-	assert.Equal(t, "justified", result.JustifiedCheckpoint.Epoch)
-	assert.Equal(t, "justified", result.JustifiedCheckpoint.Root)
-	assert.Equal(t, "finalized", result.FinalizedCheckpoint.Epoch)
-	assert.Equal(t, "finalized", result.FinalizedCheckpoint.Root)
-	assert.Equal(t, "best_justified", result.ExtraData.BestJustifiedCheckpoint.Epoch)
-	assert.Equal(t, "best_justified", result.ExtraData.BestJustifiedCheckpoint.Root)
-	assert.Equal(t, "unrealized_justified", result.ExtraData.UnrealizedJustifiedCheckpoint.Epoch)
-	assert.Equal(t, "unrealized_justified", result.ExtraData.UnrealizedJustifiedCheckpoint.Root)
-	assert.Equal(t, "unrealized_finalized", result.ExtraData.UnrealizedFinalizedCheckpoint.Epoch)
-	assert.Equal(t, "unrealized_finalized", result.ExtraData.UnrealizedFinalizedCheckpoint.Root)
-	assert.Equal(t, "proposer_boost_root", result.ExtraData.ProposerBoostRoot)
-	assert.Equal(t, "previous_proposer_boost_root", result.ExtraData.PreviousProposerBoostRoot)
-	assert.Equal(t, "head_root", result.ExtraData.HeadRoot)
-	require.Equal(t, 2, len(result.ForkChoiceNodes))
-	node1 := result.ForkChoiceNodes[0]
-	require.NotNil(t, node1)
-	assert.Equal(t, "node1_slot", node1.Slot)
-	assert.Equal(t, "node1_block_root", node1.BlockRoot)
-	assert.Equal(t, "node1_parent_root", node1.ParentRoot)
-	assert.Equal(t, "node1_justified_epoch", node1.JustifiedEpoch)
-	assert.Equal(t, "node1_finalized_epoch", node1.FinalizedEpoch)
-	assert.Equal(t, "node1_unrealized_justified_epoch", node1.ExtraData.UnrealizedJustifiedEpoch)
-	assert.Equal(t, "node1_unrealized_finalized_epoch", node1.ExtraData.UnrealizedFinalizedEpoch)
-	assert.Equal(t, "node1_balance", node1.ExtraData.Balance)
-	assert.Equal(t, "node1_weight", node1.Weight)
-	assert.Equal(t, false, node1.ExtraData.ExecutionOptimistic)
-	assert.Equal(t, "node1_execution_block_hash", node1.ExecutionBlockHash)
-	assert.Equal(t, "node1_time_stamp", node1.ExtraData.TimeStamp)
-	assert.Equal(t, "node1_validity", node1.Validity)
-	node2 := result.ForkChoiceNodes[1]
-	require.NotNil(t, node2)
-	assert.Equal(t, "node2_slot", node2.Slot)
-	assert.Equal(t, "node2_block_root", node2.BlockRoot)
-	assert.Equal(t, "node2_parent_root", node2.ParentRoot)
-	assert.Equal(t, "node2_justified_epoch", node2.JustifiedEpoch)
-	assert.Equal(t, "node2_finalized_epoch", node2.FinalizedEpoch)
-	assert.Equal(t, "node2_unrealized_justified_epoch", node2.ExtraData.UnrealizedJustifiedEpoch)
-	assert.Equal(t, "node2_unrealized_finalized_epoch", node2.ExtraData.UnrealizedFinalizedEpoch)
-	assert.Equal(t, "node2_balance", node2.ExtraData.Balance)
-	assert.Equal(t, "node2_weight", node2.Weight)
-	assert.Equal(t, true, node2.ExtraData.ExecutionOptimistic)
-	assert.Equal(t, "node2_execution_block_hash", node2.ExecutionBlockHash)
-	assert.Equal(t, "node2_time_stamp", node2.ExtraData.TimeStamp)
-	assert.Equal(t, "node2_validity", node2.Validity)
-}
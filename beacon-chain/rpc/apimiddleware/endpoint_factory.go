@@ -33,7 +33,6 @@ func (_ *BeaconEndpointFactory) Paths() []string {
 		"/qrl/v1/node/health",
 		"/qrl/v1/debug/beacon/states/{state_id}",
 		"/qrl/v1/debug/beacon/heads",
-		"/qrl/v1/debug/fork_choice",
 		"/qrl/v1/config/fork_schedule",
 		"/qrl/v1/config/spec",
 		"/qrl/v1/events",
@@ -101,11 +100,6 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.CustomHandlers = []apimiddleware.CustomHandler{handleGetBeaconStateSSZ}
 	case "/qrl/v1/debug/beacon/heads":
 		endpoint.GetResponse = &ForkChoiceHeadsResponseJson{}
-	case "/qrl/v1/debug/fork_choice":
-		endpoint.GetResponse = &ForkChoiceDumpJson{}
-		endpoint.Hooks = apimiddleware.HookCollection{
-			OnPreSerializeMiddlewareResponseIntoJson: prepareForkChoiceResponse,
-		}
 	case "/qrl/v1/config/fork_schedule":
 		endpoint.GetResponse = &ForkScheduleResponseJson{}
 	case "/qrl/v1/config/spec":
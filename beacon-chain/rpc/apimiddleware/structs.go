@@ -159,42 +159,6 @@ type ProduceSyncCommitteeContributionResponseJson struct {
 	Data *SyncCommitteeContributionJson `json:"data"`
 }
 
-type ForkChoiceNodeResponseJson struct {
-	Slot               string                       `json:"slot"`
-	BlockRoot          string                       `json:"block_root" hex:"true"`
-	ParentRoot         string                       `json:"parent_root" hex:"true"`
-	JustifiedEpoch     string                       `json:"justified_epoch"`
-	FinalizedEpoch     string                       `json:"finalized_epoch"`
-	Weight             string                       `json:"weight"`
-	Validity           string                       `json:"validity" enum:"true"`
-	ExecutionBlockHash string                       `json:"execution_block_hash" hex:"true"`
-	ExtraData          *ForkChoiceNodeExtraDataJson `json:"extra_data"`
-}
-
-type ForkChoiceNodeExtraDataJson struct {
-	UnrealizedJustifiedEpoch string `json:"unrealized_justified_epoch"`
-	UnrealizedFinalizedEpoch string `json:"unrealized_finalized_epoch"`
-	Balance                  string `json:"balance"`
-	ExecutionOptimistic      bool   `json:"execution_optimistic"`
-	TimeStamp                string `json:"timestamp"`
-}
-
-type ForkChoiceResponseJson struct {
-	JustifiedCheckpoint *CheckpointJson                  `json:"justified_checkpoint"`
-	FinalizedCheckpoint *CheckpointJson                  `json:"finalized_checkpoint"`
-	ForkChoiceNodes     []*ForkChoiceNodeResponseJson    `json:"fork_choice_nodes"`
-	ExtraData           *ForkChoiceResponseExtraDataJson `json:"extra_data"`
-}
-
-type ForkChoiceResponseExtraDataJson struct {
-	BestJustifiedCheckpoint       *CheckpointJson `json:"best_justified_checkpoint"`
-	UnrealizedJustifiedCheckpoint *CheckpointJson `json:"unrealized_justified_checkpoint"`
-	UnrealizedFinalizedCheckpoint *CheckpointJson `json:"unrealized_finalized_checkpoint"`
-	ProposerBoostRoot             string          `json:"proposer_boost_root" hex:"true"`
-	PreviousProposerBoostRoot     string          `json:"previous_proposer_boost_root" hex:"true"`
-	HeadRoot                      string          `json:"head_root" hex:"true"`
-}
-
 //----------------
 // Reusable types.
 //----------------
@@ -560,34 +524,6 @@ type SyncCommitteeContributionJson struct {
 	Signatures        []string `json:"signatures" hex:"true"`
 }
 
-type ForkChoiceNodeJson struct {
-	Slot                     string `json:"slot"`
-	BlockRoot                string `json:"block_root" hex:"true"`
-	ParentRoot               string `json:"parent_root" hex:"true"`
-	JustifiedEpoch           string `json:"justified_epoch"`
-	FinalizedEpoch           string `json:"finalized_epoch"`
-	UnrealizedJustifiedEpoch string `json:"unrealized_justified_epoch"`
-	UnrealizedFinalizedEpoch string `json:"unrealized_finalized_epoch"`
-	Balance                  string `json:"balance"`
-	Weight                   string `json:"weight"`
-	ExecutionOptimistic      bool   `json:"execution_optimistic"`
-	ExecutionBlockHash       string `json:"execution_block_hash" hex:"true"`
-	TimeStamp                string `json:"timestamp"`
-	Validity                 string `json:"validity" enum:"true"`
-}
-
-type ForkChoiceDumpJson struct {
-	JustifiedCheckpoint           *CheckpointJson       `json:"justified_checkpoint"`
-	FinalizedCheckpoint           *CheckpointJson       `json:"finalized_checkpoint"`
-	BestJustifiedCheckpoint       *CheckpointJson       `json:"best_justified_checkpoint"`
-	UnrealizedJustifiedCheckpoint *CheckpointJson       `json:"unrealized_justified_checkpoint"`
-	UnrealizedFinalizedCheckpoint *CheckpointJson       `json:"unrealized_finalized_checkpoint"`
-	ProposerBoostRoot             string                `json:"proposer_boost_root" hex:"true"`
-	PreviousProposerBoostRoot     string                `json:"previous_proposer_boost_root" hex:"true"`
-	HeadRoot                      string                `json:"head_root" hex:"true"`
-	ForkChoiceNodes               []*ForkChoiceNodeJson `json:"fork_choice_nodes"`
-}
-
 type HistoricalSummaryJson struct {
 	BlockSummaryRoot string `json:"block_summary_root" hex:"true"`
 	StateSummaryRoot string `json:"state_summary_root" hex:"true"`
@@ -689,6 +625,30 @@ type EventFinalizedCheckpointJson struct {
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
 }
 
+type EventLightClientFinalityUpdateJson struct {
+	Version string                         `json:"version" enum:"true"`
+	Data    *LightClientFinalityUpdateJson `json:"data"`
+}
+
+type LightClientFinalityUpdateJson struct {
+	AttestedHeader  *BeaconBlockHeaderJson `json:"attested_header"`
+	FinalizedHeader *BeaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch  []string               `json:"finality_branch" hex:"true"`
+	SyncAggregate   *SyncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot   string                 `json:"signature_slot"`
+}
+
+type EventLightClientOptimisticUpdateJson struct {
+	Version string                           `json:"version" enum:"true"`
+	Data    *LightClientOptimisticUpdateJson `json:"data"`
+}
+
+type LightClientOptimisticUpdateJson struct {
+	AttestedHeader *BeaconBlockHeaderJson `json:"attested_header"`
+	SyncAggregate  *SyncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot  string                 `json:"signature_slot"`
+}
+
 type EventChainReorgJson struct {
 	Slot                string `json:"slot"`
 	Depth               string `json:"depth"`
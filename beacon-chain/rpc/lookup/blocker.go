@@ -2,6 +2,7 @@ package lookup
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/theQRL/go-qrl/common/hexutil"
 	"github.com/theQRL/qrysm/beacon-chain/blockchain"
 	"github.com/theQRL/qrysm/beacon-chain/db"
+	"github.com/theQRL/qrysm/beacon-chain/sync/backfill/coverage"
 	"github.com/theQRL/qrysm/consensus-types/interfaces"
 	"github.com/theQRL/qrysm/consensus-types/primitives"
 	"github.com/theQRL/qrysm/encoding/bytesutil"
@@ -50,6 +52,24 @@ func (e BlockIdParseError) Error() string {
 	return e.message
 }
 
+// BlockNotAvailableError represents an error scenario where the block history for the requested slot is not in the
+// database, either because the node was checkpoint synced and has not backfilled it yet, or because it was pruned.
+type BlockNotAvailableError struct {
+	message string
+}
+
+// NewBlockNotAvailableError creates a new error instance.
+func NewBlockNotAvailableError(slot primitives.Slot) *BlockNotAvailableError {
+	return &BlockNotAvailableError{
+		message: fmt.Sprintf("block history for slot %d is not available", slot),
+	}
+}
+
+// Error returns the underlying error message.
+func (e *BlockNotAvailableError) Error() string {
+	return e.message
+}
+
 // Blocker is responsible for retrieving blocks.
 type Blocker interface {
 	Block(ctx context.Context, id []byte) (interfaces.ReadOnlySignedBeaconBlock, error)
@@ -59,6 +79,7 @@ type Blocker interface {
 type BeaconDbBlocker struct {
 	BeaconDB         db.ReadOnlyDatabase
 	ChainInfoFetcher blockchain.ChainInfoFetcher
+	AvailableBlocker coverage.AvailableBlocker
 }
 
 // Block returns the beacon block for a given identifier. The identifier can be one of:
@@ -126,6 +147,9 @@ func (p *BeaconDbBlocker) Block(ctx context.Context, id []byte) (interfaces.Read
 				e := NewBlockIdParseError(err)
 				return nil, &e
 			}
+			if p.AvailableBlocker != nil && !p.AvailableBlocker.SlotCovered(primitives.Slot(slot)) {
+				return nil, NewBlockNotAvailableError(primitives.Slot(slot))
+			}
 			blks, err := p.BeaconDB.BlocksBySlot(ctx, primitives.Slot(slot))
 			if err != nil {
 				return nil, errors.Wrapf(err, "could not retrieve blocks for slot %d", slot)
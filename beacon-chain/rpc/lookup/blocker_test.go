@@ -2,6 +2,7 @@ package lookup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/theQRL/qrysm/beacon-chain/rpc/testutil"
 	"github.com/theQRL/qrysm/consensus-types/blocks"
 	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
 	"github.com/theQRL/qrysm/encoding/bytesutil"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 	"github.com/theQRL/qrysm/testing/assert"
@@ -201,3 +203,29 @@ func TestGetBlock_NilCheckpoints(t *testing.T) {
 		})
 	}
 }
+
+type mockAvailableBlocker struct {
+	below primitives.Slot
+}
+
+func (m *mockAvailableBlocker) SlotCovered(slot primitives.Slot) bool {
+	return slot == 0 || slot >= m.below
+}
+
+func TestGetBlock_SlotNotAvailable(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtesting.SetupDB(t)
+	b := &BeaconDbBlocker{
+		BeaconDB:         beaconDB,
+		ChainInfoFetcher: &mock.ChainService{},
+		AvailableBlocker: &mockAvailableBlocker{below: 64},
+	}
+	_, err := b.Block(ctx, []byte("10"))
+	var notAvailableErr *BlockNotAvailableError
+	require.Equal(t, true, errors.As(err, &notAvailableErr))
+	require.ErrorContains(t, "block history for slot 10 is not available", err)
+
+	blk, err := b.Block(ctx, []byte("64"))
+	require.NoError(t, err)
+	require.Equal(t, nil, blk)
+}
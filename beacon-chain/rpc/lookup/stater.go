@@ -107,6 +107,7 @@ type Stater interface {
 	StateRoot(ctx context.Context, id []byte) ([]byte, error)
 	StateBySlot(ctx context.Context, slot primitives.Slot) (state.BeaconState, error)
 	StateByEpoch(ctx context.Context, epoch primitives.Epoch) (state.BeaconState, error)
+	StateSlot(ctx context.Context, id []byte) (primitives.Slot, error)
 }
 
 // BeaconDbStater is an implementation of Stater. It retrieves states from the beacon chain database.
@@ -116,6 +117,8 @@ type BeaconDbStater struct {
 	GenesisTimeFetcher blockchain.TimeFetcher
 	StateGenService    stategen.StateManager
 	ReplayerBuilder    stategen.ReplayerBuilder
+	// HistoricalStates, when set, regenerates states by slot within a replay budget instead of ReplayerBuilder.
+	HistoricalStates stategen.HistoricalStateFetcher
 }
 
 // State returns the BeaconState for a given identifier. The identifier can be one of:
@@ -149,7 +152,7 @@ func (p *BeaconDbStater) State(ctx context.Context, stateId []byte) (state.Beaco
 		if checkpoint == nil {
 			return nil, errors.New("received nil finalized checkpoint")
 		}
-		s, err = p.StateGenService.StateByRoot(ctx, bytesutil.ToBytes32(checkpoint.Root))
+		s, err = p.stateByBlockRoot(ctx, bytesutil.ToBytes32(checkpoint.Root))
 		if err != nil {
 			return nil, errors.Wrap(err, "could not get finalized state")
 		}
@@ -158,7 +161,7 @@ func (p *BeaconDbStater) State(ctx context.Context, stateId []byte) (state.Beaco
 		if checkpoint == nil {
 			return nil, errors.New("received nil justified checkpoint")
 		}
-		s, err = p.StateGenService.StateByRoot(ctx, bytesutil.ToBytes32(checkpoint.Root))
+		s, err = p.stateByBlockRoot(ctx, bytesutil.ToBytes32(checkpoint.Root))
 		if err != nil {
 			return nil, errors.Wrap(err, "could not get justified state")
 		}
@@ -222,20 +225,96 @@ func (p *BeaconDbStater) StateRoot(ctx context.Context, stateId []byte) (root []
 	return root, err
 }
 
+// StateSlot returns the slot of the state that State returns for the same identifier, without fetching the state.
+func (p *BeaconDbStater) StateSlot(ctx context.Context, stateId []byte) (primitives.Slot, error) {
+	stateIdString := strings.ToLower(string(stateId))
+	switch stateIdString {
+	case "head":
+		return p.ChainInfoFetcher.HeadSlot(), nil
+	case "genesis":
+		return params.BeaconConfig().GenesisSlot, nil
+	case "finalized":
+		checkpoint := p.ChainInfoFetcher.FinalizedCheckpt()
+		if checkpoint == nil {
+			return 0, errors.New("received nil finalized checkpoint")
+		}
+		return p.blockSlot(ctx, bytesutil.ToBytes32(checkpoint.Root))
+	case "justified":
+		checkpoint := p.ChainInfoFetcher.CurrentJustifiedCheckpt()
+		if checkpoint == nil {
+			return 0, errors.New("received nil justified checkpoint")
+		}
+		return p.blockSlot(ctx, bytesutil.ToBytes32(checkpoint.Root))
+	}
+	var stateRoot []byte
+	if len(stateIdString) >= 2 && stateIdString[:2] == "0x" {
+		decoded, parseErr := hexutil.Decode(string(stateId))
+		if parseErr != nil {
+			e := NewStateIdParseError(parseErr)
+			return 0, &e
+		}
+		stateRoot = decoded
+	} else if len(stateId) == 32 {
+		stateRoot = stateId
+	} else {
+		slotNumber, parseErr := strconv.ParseUint(stateIdString, 10, 64)
+		if parseErr != nil {
+			// ID format does not match any valid options.
+			e := NewStateIdParseError(parseErr)
+			return 0, &e
+		}
+		return primitives.Slot(slotNumber), nil
+	}
+	blockRoot, err := p.blockRootForStateRoot(ctx, stateRoot)
+	if err != nil {
+		return 0, err
+	}
+	return p.blockSlot(ctx, blockRoot)
+}
+
 func (p *BeaconDbStater) stateByRoot(ctx context.Context, stateRoot []byte) (state.BeaconState, error) {
+	blockRoot, err := p.blockRootForStateRoot(ctx, stateRoot)
+	if err != nil {
+		return nil, err
+	}
+	return p.stateByBlockRoot(ctx, blockRoot)
+}
+
+// blockRootForStateRoot looks the state root up in the state roots of the head state, and returns the block root
+// at the same index.
+func (p *BeaconDbStater) blockRootForStateRoot(ctx context.Context, stateRoot []byte) ([32]byte, error) {
 	headState, err := p.ChainInfoFetcher.HeadStateReadOnly(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get head state")
+		return [32]byte{}, errors.Wrap(err, "could not get head state")
 	}
 	for i, root := range headState.StateRoots() {
 		if bytes.Equal(root, stateRoot) {
-			blockRoot := headState.BlockRoots()[i]
-			return p.StateGenService.StateByRoot(ctx, bytesutil.ToBytes32(blockRoot))
+			return bytesutil.ToBytes32(headState.BlockRoots()[i]), nil
 		}
 	}
 
 	stateNotFoundErr := NewStateNotFoundError(len(headState.StateRoots()))
-	return nil, &stateNotFoundErr
+	return [32]byte{}, &stateNotFoundErr
+}
+
+// stateByBlockRoot returns the post-state of the block. Historical states are regenerated within the replay budget
+// of the node.
+func (p *BeaconDbStater) stateByBlockRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	if p.HistoricalStates != nil {
+		return p.HistoricalStates.StateByBlockRoot(ctx, blockRoot)
+	}
+	return p.StateGenService.StateByRoot(ctx, blockRoot)
+}
+
+func (p *BeaconDbStater) blockSlot(ctx context.Context, blockRoot [32]byte) (primitives.Slot, error) {
+	b, err := p.BeaconDB.Block(ctx, blockRoot)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get block")
+	}
+	if err := blocks.BeaconBlockIsNil(b); err != nil {
+		return 0, err
+	}
+	return b.Block().Slot(), nil
 }
 
 // StateBySlot returns the post-state for the requested slot. To generate the state, it uses the
@@ -251,6 +330,13 @@ func (p *BeaconDbStater) StateBySlot(ctx context.Context, target primitives.Slot
 		return nil, errors.New("requested slot is in the future")
 	}
 
+	if p.HistoricalStates != nil {
+		st, err := p.HistoricalStates.StateBySlot(ctx, target)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not regenerate state at slot=%d", target)
+		}
+		return st, nil
+	}
 	st, err := p.ReplayerBuilder.ReplayerForSlot(target).ReplayBlocks(ctx)
 	if err != nil {
 		msg := fmt.Sprintf("error while replaying history to slot=%d", target)
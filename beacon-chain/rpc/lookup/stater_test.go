@@ -10,6 +10,7 @@ import (
 	"github.com/theQRL/go-qrl/common/hexutil"
 	chainMock "github.com/theQRL/qrysm/beacon-chain/blockchain/testing"
 	testDB "github.com/theQRL/qrysm/beacon-chain/db/testing"
+	"github.com/theQRL/qrysm/beacon-chain/state"
 	statenative "github.com/theQRL/qrysm/beacon-chain/state/state-native"
 	"github.com/theQRL/qrysm/beacon-chain/state/stategen"
 	mockstategen "github.com/theQRL/qrysm/beacon-chain/state/stategen/mock"
@@ -442,3 +443,114 @@ func TestStateBySlot_AfterHeadSlot(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, primitives.Slot(101), st.Slot())
 }
+
+type mockHistoricalStates struct {
+	st        state.BeaconState
+	err       error
+	blockRoot [32]byte
+}
+
+func (m *mockHistoricalStates) StateBySlot(context.Context, primitives.Slot) (state.BeaconState, error) {
+	return m.st, m.err
+}
+
+func (m *mockHistoricalStates) StateByBlockRoot(_ context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	m.blockRoot = blockRoot
+	return m.st, m.err
+}
+
+func (m *mockHistoricalStates) RegenCost(_ context.Context, slot primitives.Slot) (*stategen.RegenCost, error) {
+	return &stategen.RegenCost{Slot: slot}, m.err
+}
+
+func TestStateBySlot_HistoricalStates(t *testing.T) {
+	slotSt, err := statenative.InitializeFromProtoZond(&qrysmpb.BeaconStateZond{Slot: 50})
+	require.NoError(t, err)
+	currentSlot := primitives.Slot(102)
+	mock := &chainMock.ChainService{Slot: &currentSlot}
+	hs := &mockHistoricalStates{st: slotSt}
+	p := BeaconDbStater{GenesisTimeFetcher: mock, HistoricalStates: hs}
+	st, err := p.StateBySlot(context.Background(), 50)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(50), st.Slot())
+
+	hs.err = stategen.ErrReplayBudgetExceeded
+	_, err = p.StateBySlot(context.Background(), 50)
+	require.ErrorIs(t, err, stategen.ErrReplayBudgetExceeded)
+}
+
+func TestState_HistoricalStates(t *testing.T) {
+	ctx := context.Background()
+	st, err := statenative.InitializeFromProtoZond(&qrysmpb.BeaconStateZond{Slot: 50})
+	require.NoError(t, err)
+	blockRoot := bytesutil.ToBytes32([]byte("finalized-block-root"))
+	hs := &mockHistoricalStates{st: st}
+	p := BeaconDbStater{
+		ChainInfoFetcher: &chainMock.ChainService{
+			FinalizedCheckPoint: &qrysmpb.Checkpoint{Root: blockRoot[:], Epoch: 1},
+		},
+		// The historical states must be used instead of the state gen service.
+		StateGenService:  mockstategen.NewMockService(),
+		HistoricalStates: hs,
+	}
+	s, err := p.State(ctx, []byte("finalized"))
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(50), s.Slot())
+	assert.Equal(t, blockRoot, hs.blockRoot)
+
+	hs.err = stategen.ErrReplayBudgetExceeded
+	_, err = p.State(ctx, []byte("finalized"))
+	require.ErrorIs(t, err, stategen.ErrReplayBudgetExceeded)
+}
+
+func TestStateSlot(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	blk := util.NewBeaconBlockZond()
+	blk.Block.Slot = 40
+	util.SaveBlock(t, ctx, db, blk)
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	headSlot := primitives.Slot(123)
+	headState, err := util.NewBeaconStateZond(func(state *qrysmpb.BeaconStateZond) error {
+		state.Slot = headSlot
+		state.StateRoots[7] = bytesutil.PadTo([]byte("state-root"), 32)
+		state.BlockRoots[7] = blockRoot[:]
+		return nil
+	})
+	require.NoError(t, err)
+	p := BeaconDbStater{
+		BeaconDB: db,
+		ChainInfoFetcher: &chainMock.ChainService{
+			State:               headState,
+			FinalizedCheckPoint: &qrysmpb.Checkpoint{Root: blockRoot[:], Epoch: 1},
+		},
+	}
+
+	tests := []struct {
+		id   string
+		want primitives.Slot
+	}{
+		{id: "head", want: headSlot},
+		{id: "genesis", want: params.BeaconConfig().GenesisSlot},
+		{id: "finalized", want: 40},
+		{id: hexutil.Encode(bytesutil.PadTo([]byte("state-root"), 32)), want: 40},
+		{id: "77", want: 77},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			slot, err := p.StateSlot(ctx, []byte(tt.id))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, slot)
+		})
+	}
+	t.Run("state root not found", func(t *testing.T) {
+		_, err := p.StateSlot(ctx, []byte("0x"+strings.Repeat("f", 64)))
+		require.ErrorContains(t, "state not found in the last", err)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := p.StateSlot(ctx, []byte("foo"))
+		require.ErrorContains(t, "could not parse state ID", err)
+	})
+}
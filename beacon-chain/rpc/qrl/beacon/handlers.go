@@ -53,6 +53,10 @@ func (s *Server) PublishBlindedBlock(w http.ResponseWriter, r *http.Request) {
 	if shared.IsSyncing(r.Context(), w, s.SyncChecker, s.HeadFetcher, s.TimeFetcher, s.OptimisticModeFetcher) {
 		return
 	}
+	if !http2.IsRequestContentTypeSupported(r) {
+		http2.HandleError(w, "Unsupported Content-Type "+r.Header.Get("Content-Type"), http.StatusUnsupportedMediaType)
+		return
+	}
 	if http2.IsRequestSsz(r) {
 		s.publishBlindedBlockSSZ(ctx, w, r)
 	} else {
@@ -128,6 +132,10 @@ func (s *Server) PublishBlock(w http.ResponseWriter, r *http.Request) {
 	if shared.IsSyncing(r.Context(), w, s.SyncChecker, s.HeadFetcher, s.TimeFetcher, s.OptimisticModeFetcher) {
 		return
 	}
+	if !http2.IsRequestContentTypeSupported(r) {
+		http2.HandleError(w, "Unsupported Content-Type "+r.Header.Get("Content-Type"), http.StatusUnsupportedMediaType)
+		return
+	}
 	if http2.IsRequestSsz(r) {
 		s.publishBlockSSZ(ctx, w, r)
 	} else {
@@ -555,6 +563,58 @@ func (*Server) GetDepositContract(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetDepositSnapshot retrieves the finalized deposit snapshot as defined in EIP-4881. The snapshot can be
+// used by a checkpoint synced node to initialize its deposit cache without scanning all deposit contract logs.
+func (s *Server) GetDepositSnapshot(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetDepositSnapshot")
+	defer span.End()
+
+	if s.BeaconDB == nil {
+		http2.HandleError(w, "Could not retrieve beaconDB", http.StatusInternalServerError)
+		return
+	}
+	chainData, err := s.BeaconDB.ExecutionChainData(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not retrieve execution chain data: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if chainData == nil {
+		http2.HandleError(w, "Could not retrieve execution chain data: empty execution chain data", http.StatusInternalServerError)
+		return
+	}
+	snapshot := chainData.DepositSnapshot
+	if snapshot == nil || len(snapshot.Finalized) == 0 {
+		http2.HandleError(w, "No finalized snapshot available", http.StatusNotFound)
+		return
+	}
+	if len(snapshot.Finalized) > int(params.BeaconConfig().DepositContractTreeDepth) {
+		http2.HandleError(w, "Retrieved invalid deposit snapshot", http.StatusInternalServerError)
+		return
+	}
+	if http2.RespondWithSsz(r) {
+		sszData, err := snapshot.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, "Could not marshal deposit snapshot into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszData, "deposit_snapshot.ssz")
+		return
+	}
+	finalized := make([]string, len(snapshot.Finalized))
+	for i, f := range snapshot.Finalized {
+		finalized[i] = hexutil.Encode(f)
+	}
+	http2.WriteJson(w, &GetDepositSnapshotResponse{
+		Data: &DepositSnapshot{
+			Finalized:            finalized,
+			DepositRoot:          hexutil.Encode(snapshot.DepositRoot),
+			DepositCount:         strconv.FormatUint(snapshot.DepositCount, 10),
+			ExecutionBlockHash:   hexutil.Encode(snapshot.ExecutionHash),
+			ExecutionBlockHeight: strconv.FormatUint(snapshot.ExecutionDepth, 10),
+		},
+	})
+}
+
 // GetBlockHeaders retrieves block headers matching given query. By default it will fetch current head slot blocks.
 func (s *Server) GetBlockHeaders(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.GetBlockHeaders")
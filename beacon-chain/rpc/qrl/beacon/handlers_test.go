@@ -16,9 +16,11 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
+	"github.com/theQRL/go-qrl/common"
 	"github.com/theQRL/go-qrl/common/hexutil"
 	"github.com/theQRL/qrysm/api"
 	chainMock "github.com/theQRL/qrysm/beacon-chain/blockchain/testing"
+	"github.com/theQRL/qrysm/beacon-chain/cache/depositsnapshot"
 	"github.com/theQRL/qrysm/beacon-chain/core/transition"
 	dbTest "github.com/theQRL/qrysm/beacon-chain/db/testing"
 	doublylinkedtree "github.com/theQRL/qrysm/beacon-chain/forkchoice/doubly-linked-tree"
@@ -96,6 +98,19 @@ func TestPublishBlock(t *testing.T) {
 		assert.Equal(t, true, strings.Contains(body, "Body does not represent a valid block type"))
 		assert.Equal(t, true, strings.Contains(body, fmt.Sprintf("could not decode %s request body into consensus block:", version.String(version.Zond))))
 	})
+	t.Run("unsupported content type", func(t *testing.T) {
+		server := &Server{
+			SyncChecker: &mockSync.Sync{IsSyncing: false},
+		}
+
+		request := httptest.NewRequest(http.MethodPost, "http://foo.example", bytes.NewReader([]byte(rpctesting.ZondBlock)))
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.PublishBlock(writer, request)
+		assert.Equal(t, http.StatusUnsupportedMediaType, writer.Code)
+		assert.Equal(t, true, strings.Contains(writer.Body.String(), "Unsupported Content-Type"))
+	})
 	t.Run("syncing", func(t *testing.T) {
 		chainService := &chainMock.ChainService{}
 		server := &Server{
@@ -1458,3 +1473,61 @@ func TestGetDepositContract(t *testing.T) {
 	assert.Equal(t, "10", response.Data.ChainId)
 	assert.Equal(t, "Q42424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242424242", response.Data.Address)
 }
+
+func TestGetDepositSnapshot(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	mockTrie := depositsnapshot.NewDepositTree()
+	finalized := 100
+	for i := 0; i < finalized; i++ {
+		require.NoError(t, mockTrie.Insert(bytesutil.PadTo([]byte{byte(i)}, 32), i))
+	}
+	require.NoError(t, mockTrie.Finalize(int64(finalized-1), common.BytesToHash(bytesutil.PadTo([]byte{0xAB}, 32)), 10))
+	snapshot, err := mockTrie.GetSnapshot()
+	require.NoError(t, err)
+	root, err := snapshot.CalculateRoot()
+	require.NoError(t, err)
+	chainData := &qrysmpb.ExecutionChainData{
+		DepositSnapshot: snapshot.ToProto(),
+	}
+	require.NoError(t, beaconDB.SaveExecutionChainData(context.Background(), chainData))
+	s := Server{BeaconDB: beaconDB}
+
+	t.Run("JSON response", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/qrl/v1/beacon/deposit_snapshot", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDepositSnapshot(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetDepositSnapshotResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, hexutil.Encode(root[:]), resp.Data.DepositRoot)
+		assert.Equal(t, strconv.Itoa(finalized), resp.Data.DepositCount)
+		assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{0xAB}, 32)), resp.Data.ExecutionBlockHash)
+		assert.Equal(t, "10", resp.Data.ExecutionBlockHeight)
+		assert.Equal(t, len(chainData.DepositSnapshot.Finalized), len(resp.Data.Finalized))
+	})
+	t.Run("SSZ response", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/qrl/v1/beacon/deposit_snapshot", nil)
+		request.Header.Set("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDepositSnapshot(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &qrysmpb.DepositSnapshot{}
+		require.NoError(t, resp.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.DeepEqual(t, chainData.DepositSnapshot, resp)
+	})
+	t.Run("no snapshot", func(t *testing.T) {
+		emptyDB := dbTest.SetupDB(t)
+		require.NoError(t, emptyDB.SaveExecutionChainData(context.Background(), &qrysmpb.ExecutionChainData{}))
+		request := httptest.NewRequest(http.MethodGet, "/qrl/v1/beacon/deposit_snapshot", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		(&Server{BeaconDB: emptyDB}).GetDepositSnapshot(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
@@ -276,6 +276,10 @@ func (m *futureSyncMockFetcher) StateByEpoch(context.Context, primitives.Epoch)
 	return m.BeaconState, nil
 }
 
+func (m *futureSyncMockFetcher) StateSlot(context.Context, []byte) (primitives.Slot, error) {
+	return m.BeaconState.Slot(), nil
+}
+
 func TestListSyncCommitteesFuture(t *testing.T) {
 	ctx := context.Background()
 	st, _ := util.DeterministicGenesisStateZond(t, params.BeaconConfig().SyncCommitteeSize)
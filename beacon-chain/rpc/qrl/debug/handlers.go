@@ -0,0 +1,72 @@
+package debug
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+
+	"github.com/theQRL/go-qrl/common/hexutil"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/shared"
+	http2 "github.com/theQRL/qrysm/network/http"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	"go.opencensus.io/trace"
+)
+
+// GetForkChoiceDump is an HTTP handler that serves the GET /qrl/v1/debug/fork_choice endpoint.
+// It dumps every node of the fork choice tree, in depth-first order from the tree root, together with the
+// checkpoints, the proposer boost and the head of the store.
+func (s *Server) GetForkChoiceDump(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetForkChoiceDump")
+	defer span.End()
+
+	dump, err := s.ForkchoiceFetcher.ForkChoiceDump(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get fork choice dump: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	nodes := make([]*ForkChoiceNode, len(dump.ForkChoiceNodes))
+	for i, n := range dump.ForkChoiceNodes {
+		nodes[i] = &ForkChoiceNode{
+			Slot:               strconv.FormatUint(uint64(n.Slot), 10),
+			BlockRoot:          hexutil.Encode(n.BlockRoot),
+			ParentRoot:         hexutil.Encode(n.ParentRoot),
+			JustifiedEpoch:     strconv.FormatUint(uint64(n.JustifiedEpoch), 10),
+			FinalizedEpoch:     strconv.FormatUint(uint64(n.FinalizedEpoch), 10),
+			Weight:             strconv.FormatUint(n.Weight, 10),
+			Validity:           n.Validity.String(),
+			ExecutionBlockHash: hexutil.Encode(n.ExecutionBlockHash),
+			ExtraData: &ForkChoiceNodeExtraData{
+				UnrealizedJustifiedEpoch: strconv.FormatUint(uint64(n.UnrealizedJustifiedEpoch), 10),
+				UnrealizedFinalizedEpoch: strconv.FormatUint(uint64(n.UnrealizedFinalizedEpoch), 10),
+				Balance:                  strconv.FormatUint(n.Balance, 10),
+				ExecutionOptimistic:      n.ExecutionOptimistic,
+				Timestamp:                strconv.FormatUint(n.Timestamp, 10),
+				ProposerBoost:            bytes.Equal(n.BlockRoot, dump.ProposerBoostRoot),
+				Head:                     bytes.Equal(n.BlockRoot, dump.HeadRoot),
+			},
+		}
+	}
+	http2.WriteJson(w, &GetForkChoiceDumpResponse{
+		JustifiedCheckpoint: checkpoint(dump.JustifiedCheckpoint),
+		FinalizedCheckpoint: checkpoint(dump.FinalizedCheckpoint),
+		ForkChoiceNodes:     nodes,
+		ExtraData: &ForkChoiceDumpExtraData{
+			UnrealizedJustifiedCheckpoint: checkpoint(dump.UnrealizedJustifiedCheckpoint),
+			UnrealizedFinalizedCheckpoint: checkpoint(dump.UnrealizedFinalizedCheckpoint),
+			ProposerBoostRoot:             hexutil.Encode(dump.ProposerBoostRoot),
+			PreviousProposerBoostRoot:     hexutil.Encode(dump.PreviousProposerBoostRoot),
+			HeadRoot:                      hexutil.Encode(dump.HeadRoot),
+		},
+	})
+}
+
+func checkpoint(cp *qrlpb.Checkpoint) *shared.Checkpoint {
+	if cp == nil {
+		return nil
+	}
+	return &shared.Checkpoint{
+		Epoch: strconv.FormatUint(uint64(cp.Epoch), 10),
+		Root:  hexutil.Encode(cp.Root),
+	}
+}
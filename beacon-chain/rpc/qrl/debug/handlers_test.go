@@ -0,0 +1,123 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/theQRL/go-qrl/common/hexutil"
+	blockchainmock "github.com/theQRL/qrysm/beacon-chain/blockchain/testing"
+	doublylinkedtree "github.com/theQRL/qrysm/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	state_native "github.com/theQRL/qrysm/beacon-chain/state/state-native"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/blocks"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	enginev1 "github.com/theQRL/qrysm/proto/engine/v1"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func TestGetForkChoiceDump(t *testing.T) {
+	ctx := context.Background()
+	fcs := doublylinkedtree.New()
+	// The block of the current slot is received in time for the proposer boost.
+	fcs.SetGenesisTime(uint64(time.Now().Unix()) - 2*params.BeaconConfig().SecondsPerSlot)
+	cp := &qrysmpb.Checkpoint{Epoch: 0, Root: params.BeaconConfig().ZeroHash[:]}
+	rootA, rootB, rootC := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}
+	hashC := [32]byte{'C'}
+	for _, n := range []struct {
+		slot        primitives.Slot
+		root        [32]byte
+		parent      [32]byte
+		payloadHash [32]byte
+	}{
+		{slot: 0, root: rootA, payloadHash: [32]byte{'A'}},
+		{slot: 1, root: rootB, parent: rootA, payloadHash: [32]byte{'B'}},
+		{slot: 2, root: rootC, parent: rootA, payloadHash: hashC},
+	} {
+		st, blk, err := prepareForkchoiceState(n.slot, n.root, n.parent, n.payloadHash, cp, cp)
+		require.NoError(t, err)
+		require.NoError(t, fcs.InsertNode(ctx, st, blk))
+	}
+	s := &Server{ForkchoiceFetcher: &blockchainmock.ChainService{ForkChoiceStore: fcs}}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/qrl/v1/debug/fork_choice", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetForkChoiceDump(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &GetForkChoiceDumpResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+
+	require.Equal(t, "0", resp.FinalizedCheckpoint.Epoch)
+	require.Equal(t, hexutil.Encode(rootC[:]), resp.ExtraData.ProposerBoostRoot)
+	require.Equal(t, 3, len(resp.ForkChoiceNodes))
+	root := resp.ForkChoiceNodes[0]
+	require.Equal(t, hexutil.Encode(rootA[:]), root.BlockRoot)
+	require.Equal(t, "VALID", root.Validity)
+	require.Equal(t, false, root.ExtraData.ProposerBoost)
+	for _, n := range resp.ForkChoiceNodes[1:] {
+		require.Equal(t, root.BlockRoot, n.ParentRoot)
+	}
+	boosted := resp.ForkChoiceNodes[2]
+	require.Equal(t, "2", boosted.Slot)
+	require.Equal(t, hexutil.Encode(hashC[:]), boosted.ExecutionBlockHash)
+	require.Equal(t, true, boosted.ExtraData.ProposerBoost)
+	require.Equal(t, false, resp.ForkChoiceNodes[1].ExtraData.ProposerBoost)
+}
+
+func prepareForkchoiceState(
+	slot primitives.Slot,
+	blockRoot [32]byte,
+	parentRoot [32]byte,
+	payloadHash [32]byte,
+	justified *qrysmpb.Checkpoint,
+	finalized *qrysmpb.Checkpoint,
+) (state.BeaconState, blocks.ROBlock, error) {
+	blockHeader := &qrysmpb.BeaconBlockHeader{
+		ParentRoot: parentRoot[:],
+	}
+
+	executionHeader := &enginev1.ExecutionPayloadHeaderZond{
+		BlockHash: payloadHash[:],
+	}
+
+	base := &qrysmpb.BeaconStateZond{
+		Slot:                         slot,
+		RandaoMixes:                  make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		BlockRoots:                   make([][]byte, 1),
+		CurrentJustifiedCheckpoint:   justified,
+		FinalizedCheckpoint:          finalized,
+		LatestExecutionPayloadHeader: executionHeader,
+		LatestBlockHeader:            blockHeader,
+	}
+
+	base.BlockRoots[0] = append(base.BlockRoots[0], blockRoot[:]...)
+	st, err := state_native.InitializeFromProtoZond(base)
+	if err != nil {
+		return nil, blocks.ROBlock{}, err
+	}
+
+	blk := &qrysmpb.SignedBeaconBlockZond{
+		Block: &qrysmpb.BeaconBlockZond{
+			Slot:       slot,
+			ParentRoot: parentRoot[:],
+			Body: &qrysmpb.BeaconBlockBodyZond{
+				ExecutionPayload: &enginev1.ExecutionPayloadZond{
+					BlockHash: payloadHash[:],
+				},
+			},
+		},
+	}
+	signed, err := blocks.NewSignedBeaconBlock(blk)
+	if err != nil {
+		return nil, blocks.ROBlock{}, err
+	}
+	roblock, err := blocks.NewROBlockWithRoot(signed, blockRoot)
+	return st, roblock, err
+}
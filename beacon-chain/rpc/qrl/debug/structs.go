@@ -0,0 +1,42 @@
+package debug
+
+import "github.com/theQRL/qrysm/beacon-chain/rpc/qrl/shared"
+
+type GetForkChoiceDumpResponse struct {
+	JustifiedCheckpoint *shared.Checkpoint       `json:"justified_checkpoint"`
+	FinalizedCheckpoint *shared.Checkpoint       `json:"finalized_checkpoint"`
+	ForkChoiceNodes     []*ForkChoiceNode        `json:"fork_choice_nodes"`
+	ExtraData           *ForkChoiceDumpExtraData `json:"extra_data"`
+}
+
+type ForkChoiceNode struct {
+	Slot               string                   `json:"slot"`
+	BlockRoot          string                   `json:"block_root"`
+	ParentRoot         string                   `json:"parent_root"`
+	JustifiedEpoch     string                   `json:"justified_epoch"`
+	FinalizedEpoch     string                   `json:"finalized_epoch"`
+	Weight             string                   `json:"weight"`
+	Validity           string                   `json:"validity"`
+	ExecutionBlockHash string                   `json:"execution_block_hash"`
+	ExtraData          *ForkChoiceNodeExtraData `json:"extra_data"`
+}
+
+type ForkChoiceNodeExtraData struct {
+	UnrealizedJustifiedEpoch string `json:"unrealized_justified_epoch"`
+	UnrealizedFinalizedEpoch string `json:"unrealized_finalized_epoch"`
+	// Balance is the effective balance of the validators whose latest vote is for this node, while Weight also
+	// includes the votes for its descendants and the proposer boost.
+	Balance             string `json:"balance"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+	Timestamp           string `json:"timestamp"`
+	ProposerBoost       bool   `json:"proposer_boost"`
+	Head                bool   `json:"head"`
+}
+
+type ForkChoiceDumpExtraData struct {
+	UnrealizedJustifiedCheckpoint *shared.Checkpoint `json:"unrealized_justified_checkpoint"`
+	UnrealizedFinalizedCheckpoint *shared.Checkpoint `json:"unrealized_finalized_checkpoint"`
+	ProposerBoostRoot             string             `json:"proposer_boost_root"`
+	PreviousProposerBoostRoot     string             `json:"previous_proposer_boost_root"`
+	HeadRoot                      string             `json:"head_root"`
+}
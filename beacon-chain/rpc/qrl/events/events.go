@@ -42,17 +42,23 @@ const (
 	SyncCommitteeContributionTopic = "contribution_and_proof"
 	// PayloadAttributesTopic represents a new payload attributes for execution payload building event topic.
 	PayloadAttributesTopic = "payload_attributes"
+	// LightClientFinalityUpdateTopic represents a new light client finality update event topic.
+	LightClientFinalityUpdateTopic = "light_client_finality_update"
+	// LightClientOptimisticUpdateTopic represents a new light client optimistic update event topic.
+	LightClientOptimisticUpdateTopic = "light_client_optimistic_update"
 )
 
 var casesHandled = map[string]bool{
-	HeadTopic:                      true,
-	BlockTopic:                     true,
-	AttestationTopic:               true,
-	VoluntaryExitTopic:             true,
-	FinalizedCheckpointTopic:       true,
-	ChainReorgTopic:                true,
-	SyncCommitteeContributionTopic: true,
-	PayloadAttributesTopic:         true,
+	HeadTopic:                        true,
+	BlockTopic:                       true,
+	AttestationTopic:                 true,
+	VoluntaryExitTopic:               true,
+	FinalizedCheckpointTopic:         true,
+	ChainReorgTopic:                  true,
+	SyncCommitteeContributionTopic:   true,
+	PayloadAttributesTopic:           true,
+	LightClientFinalityUpdateTopic:   true,
+	LightClientOptimisticUpdateTopic: true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the QRL consensus API standard.
@@ -221,6 +227,24 @@ func (s *Server) handleStateEvents(
 			ExecutionOptimistic: blkData.Optimistic,
 		}
 		return streamData(stream, BlockTopic, eventBlock)
+	case statefeed.LightClientFinalityUpdate:
+		if _, ok := requestedTopics[LightClientFinalityUpdateTopic]; !ok {
+			return nil
+		}
+		update, ok := event.Data.(*qrlpb.LightClientFinalityUpdateWithVersion)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, LightClientFinalityUpdateTopic, update)
+	case statefeed.LightClientOptimisticUpdate:
+		if _, ok := requestedTopics[LightClientOptimisticUpdateTopic]; !ok {
+			return nil
+		}
+		update, ok := event.Data.(*qrlpb.LightClientOptimisticUpdateWithVersion)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, LightClientOptimisticUpdateTopic, update)
 	default:
 		return nil
 	}
@@ -483,6 +483,71 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 			feed: srv.StateNotifier.StateFeed(),
 		})
 	})
+	t.Run(LightClientFinalityUpdateTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedUpdate := &qrlpb.LightClientFinalityUpdateWithVersion{
+			Version: qrlpb.Version_ZOND,
+			Data: &qrlpb.LightClientFinalityUpdate{
+				AttestedHeader:  &qrlpb.BeaconBlockHeader{Slot: 9},
+				FinalizedHeader: &qrlpb.BeaconBlockHeader{Slot: 8},
+				SignatureSlot:   10,
+			},
+		}
+		genericResponse, err := anypb.New(wantedUpdate)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: LightClientFinalityUpdateTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{LightClientFinalityUpdateTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.LightClientFinalityUpdate,
+				Data: wantedUpdate,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
+	t.Run(LightClientOptimisticUpdateTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedUpdate := &qrlpb.LightClientOptimisticUpdateWithVersion{
+			Version: qrlpb.Version_ZOND,
+			Data: &qrlpb.LightClientOptimisticUpdate{
+				AttestedHeader: &qrlpb.BeaconBlockHeader{Slot: 9},
+				SignatureSlot:  10,
+			},
+		}
+		genericResponse, err := anypb.New(wantedUpdate)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: LightClientOptimisticUpdateTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{LightClientOptimisticUpdateTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.LightClientOptimisticUpdate,
+				Data: wantedUpdate,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
 	t.Run(ChainReorgTopic, func(t *testing.T) {
 		ctx := context.Background()
 		srv, ctrl, mockStream := setupServer(ctx, t)
@@ -39,6 +39,9 @@ func PrepareStateFetchGRPCError(err error) error {
 	if errors.Is(err, stategen.ErrNoDataForSlot) {
 		return status.Errorf(codes.NotFound, "lacking historical data needed to fulfill request")
 	}
+	if errors.Is(err, stategen.ErrReplayBudgetExceeded) {
+		return status.Errorf(codes.Unavailable, "Could not get state: %v", err)
+	}
 	if stateNotFoundErr, ok := err.(*lookup.StateNotFoundError); ok {
 		return status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
 	}
@@ -63,6 +66,10 @@ func HandleGetBlockError(blk interfaces.ReadOnlySignedBeaconBlock, err error) er
 	if invalidBlockIdErr, ok := err.(*lookup.BlockIdParseError); ok {
 		return status.Errorf(codes.InvalidArgument, "Invalid block ID: %v", invalidBlockIdErr)
 	}
+	var notAvailableErr *lookup.BlockNotAvailableError
+	if errors.As(err, &notAvailableErr) {
+		return status.Errorf(codes.NotFound, "lacking historical data needed to fulfill request: %v", notAvailableErr)
+	}
 	if err != nil {
 		return status.Errorf(codes.Internal, "Could not get block from block ID: %v", err)
 	}
@@ -0,0 +1,271 @@
+package lightclient
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/theQRL/go-qrl/common/hexutil"
+	"github.com/theQRL/qrysm/beacon-chain/blockchain"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/shared"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/blocks"
+	"github.com/theQRL/qrysm/network/forks"
+	http2 "github.com/theQRL/qrysm/network/http"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	"github.com/theQRL/qrysm/runtime/version"
+	"github.com/theQRL/qrysm/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// GetLightClientBootstrap returns the light client bootstrap of the requested block root.
+func (s *Server) GetLightClientBootstrap(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "lightclient.GetLightClientBootstrap")
+	defer span.End()
+
+	rawRoot, valid := shared.ValidateHex(w, "block_root", mux.Vars(r)["block_root"], 32)
+	if !valid {
+		return
+	}
+	root := [32]byte(rawRoot)
+	blk, err := s.BeaconDB.Block(ctx, root)
+	if err != nil {
+		http2.HandleError(w, "Could not get block: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := blocks.BeaconBlockIsNil(blk); err != nil {
+		http2.HandleError(w, fmt.Sprintf("Block %#x not found", root), http.StatusNotFound)
+		return
+	}
+	st, err := s.StateGen.StateByRoot(ctx, root)
+	if err != nil {
+		http2.HandleError(w, "Could not get state: "+err.Error(), http.StatusNotFound)
+		return
+	}
+	bootstrap, err := blockchain.NewLightClientBootstrapFromBeaconState(ctx, st)
+	if err != nil {
+		http2.HandleError(w, "Could not create light client bootstrap: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if http2.RespondWithSsz(r) {
+		sszResp, err := bootstrap.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "light_client_bootstrap.ssz")
+		return
+	}
+	http2.WriteJson(w, &LightClientBootstrapResponse{
+		Version: version.String(version.Zond),
+		Data: &LightClientBootstrap{
+			Header:                     headerFromConsensus(bootstrap.Header),
+			CurrentSyncCommittee:       syncCommitteeFromConsensus(bootstrap.CurrentSyncCommittee),
+			CurrentSyncCommitteeBranch: encodeHexSlice(bootstrap.CurrentSyncCommitteeBranch),
+		},
+	})
+}
+
+// GetLightClientUpdatesByRange returns the best light client updates of the requested sync committee periods.
+// The response stops at the first period for which no update is known, as the updates must be consecutive.
+func (s *Server) GetLightClientUpdatesByRange(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "lightclient.GetLightClientUpdatesByRange")
+	defer span.End()
+
+	rawStartPeriod := r.URL.Query().Get("start_period")
+	if rawStartPeriod == "" {
+		http2.HandleError(w, "start_period is required", http.StatusBadRequest)
+		return
+	}
+	startPeriod, valid := shared.ValidateUint(w, "start_period", rawStartPeriod)
+	if !valid {
+		return
+	}
+	rawCount := r.URL.Query().Get("count")
+	if rawCount == "" {
+		http2.HandleError(w, "count is required", http.StatusBadRequest)
+		return
+	}
+	count, valid := shared.ValidateUint(w, "count", rawCount)
+	if !valid {
+		return
+	}
+	count = min(count, params.BeaconNetworkConfig().MaxRequestLightClientUpdates)
+	if count == 0 {
+		http2.HandleError(w, "count must be greater than 0", http.StatusBadRequest)
+		return
+	}
+	endPeriod := startPeriod + count - 1
+	if endPeriod < startPeriod {
+		http2.HandleError(w, "start_period and count overflow", http.StatusBadRequest)
+		return
+	}
+
+	updatesByPeriod, err := s.BeaconDB.LightClientUpdates(ctx, startPeriod, endPeriod)
+	if err != nil {
+		http2.HandleError(w, "Could not get light client updates: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	updates := make([]*qrlpb.LightClientUpdate, 0, len(updatesByPeriod))
+	for period := startPeriod; period <= endPeriod; period++ {
+		update, ok := updatesByPeriod[period]
+		if !ok {
+			break
+		}
+		updates = append(updates, update)
+	}
+
+	if http2.RespondWithSsz(r) {
+		sszResp, err := s.updatesToSsz(updates)
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "light_client_updates.ssz")
+		return
+	}
+	resp := make([]*LightClientUpdateWithVersion, len(updates))
+	for i, update := range updates {
+		resp[i] = &LightClientUpdateWithVersion{
+			Version: version.String(version.Zond),
+			Data:    updateFromConsensus(update),
+		}
+	}
+	http2.WriteJson(w, resp)
+}
+
+// GetLightClientFinalityUpdate returns the latest light client finality update.
+func (s *Server) GetLightClientFinalityUpdate(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "lightclient.GetLightClientFinalityUpdate")
+	defer span.End()
+
+	update := s.LightClientUpdateFetcher.LightClientFinalityUpdate()
+	if update == nil {
+		http2.HandleError(w, "No light client finality update available", http.StatusNotFound)
+		return
+	}
+
+	if http2.RespondWithSsz(r) {
+		sszResp, err := update.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "light_client_finality_update.ssz")
+		return
+	}
+	http2.WriteJson(w, &LightClientFinalityUpdateResponse{
+		Version: version.String(version.Zond),
+		Data: &LightClientFinalityUpdate{
+			AttestedHeader:  headerFromConsensus(update.AttestedHeader),
+			FinalizedHeader: headerFromConsensus(update.FinalizedHeader),
+			FinalityBranch:  encodeHexSlice(update.FinalityBranch),
+			SyncAggregate:   syncAggregateFromConsensus(update.SyncAggregate),
+			SignatureSlot:   strconv.FormatUint(uint64(update.SignatureSlot), 10),
+		},
+	})
+}
+
+// GetLightClientOptimisticUpdate returns the latest light client optimistic update.
+func (s *Server) GetLightClientOptimisticUpdate(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "lightclient.GetLightClientOptimisticUpdate")
+	defer span.End()
+
+	update := s.LightClientUpdateFetcher.LightClientOptimisticUpdate()
+	if update == nil {
+		http2.HandleError(w, "No light client optimistic update available", http.StatusNotFound)
+		return
+	}
+
+	if http2.RespondWithSsz(r) {
+		sszResp, err := update.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "light_client_optimistic_update.ssz")
+		return
+	}
+	http2.WriteJson(w, &LightClientOptimisticUpdateResponse{
+		Version: version.String(version.Zond),
+		Data: &LightClientOptimisticUpdate{
+			AttestedHeader: headerFromConsensus(update.AttestedHeader),
+			SyncAggregate:  syncAggregateFromConsensus(update.SyncAggregate),
+			SignatureSlot:  strconv.FormatUint(uint64(update.SignatureSlot), 10),
+		},
+	})
+}
+
+// updatesToSsz encodes each update as an 8-byte little-endian length, followed by the fork digest of
+// the attested header and the SSZ encoded update. The length covers both the fork digest and the update.
+func (s *Server) updatesToSsz(updates []*qrlpb.LightClientUpdate) ([]byte, error) {
+	valRoot := s.GenesisFetcher.GenesisValidatorsRoot()
+	var buf []byte
+	for _, update := range updates {
+		sszUpdate, err := update.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(update.AttestedHeader.Slot), valRoot[:])
+		if err != nil {
+			return nil, err
+		}
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(len(digest)+len(sszUpdate)))
+		buf = append(buf, digest[:]...)
+		buf = append(buf, sszUpdate...)
+	}
+	return buf, nil
+}
+
+func updateFromConsensus(update *qrlpb.LightClientUpdate) *LightClientUpdate {
+	return &LightClientUpdate{
+		AttestedHeader:          headerFromConsensus(update.AttestedHeader),
+		NextSyncCommittee:       syncCommitteeFromConsensus(update.NextSyncCommittee),
+		NextSyncCommitteeBranch: encodeHexSlice(update.NextSyncCommitteeBranch),
+		FinalizedHeader:         headerFromConsensus(update.FinalizedHeader),
+		FinalityBranch:          encodeHexSlice(update.FinalityBranch),
+		SyncAggregate:           syncAggregateFromConsensus(update.SyncAggregate),
+		SignatureSlot:           strconv.FormatUint(uint64(update.SignatureSlot), 10),
+	}
+}
+
+func headerFromConsensus(h *qrlpb.BeaconBlockHeader) *shared.BeaconBlockHeader {
+	if h == nil {
+		return nil
+	}
+	return &shared.BeaconBlockHeader{
+		Slot:          strconv.FormatUint(uint64(h.Slot), 10),
+		ProposerIndex: strconv.FormatUint(uint64(h.ProposerIndex), 10),
+		ParentRoot:    hexutil.Encode(h.ParentRoot),
+		StateRoot:     hexutil.Encode(h.StateRoot),
+		BodyRoot:      hexutil.Encode(h.BodyRoot),
+	}
+}
+
+func syncCommitteeFromConsensus(sc *qrlpb.SyncCommittee) *SyncCommittee {
+	if sc == nil {
+		return nil
+	}
+	return &SyncCommittee{Pubkeys: encodeHexSlice(sc.Pubkeys)}
+}
+
+func syncAggregateFromConsensus(agg *qrlpb.SyncAggregate) *shared.SyncAggregate {
+	if agg == nil {
+		return nil
+	}
+	return &shared.SyncAggregate{
+		SyncCommitteeBits:       hexutil.Encode(agg.SyncCommitteeBits),
+		SyncCommitteeSignatures: encodeHexSlice(agg.SyncCommitteeSignatures),
+	}
+}
+
+func encodeHexSlice(branch [][]byte) []string {
+	b := make([]string, len(branch))
+	for i, node := range branch {
+		b[i] = hexutil.Encode(node)
+	}
+	return b
+}
@@ -0,0 +1,174 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	mock "github.com/theQRL/qrysm/beacon-chain/blockchain/testing"
+	dbtest "github.com/theQRL/qrysm/beacon-chain/db/testing"
+	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	http2 "github.com/theQRL/qrysm/network/http"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func testHeader(slot primitives.Slot) *qrlpb.BeaconBlockHeader {
+	return &qrlpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   make([]byte, 32),
+	}
+}
+
+func testBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, 32)
+	}
+	return branch
+}
+
+func testUpdate(slot primitives.Slot) *qrlpb.LightClientUpdate {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.MLDSA87PubkeyLength)
+	}
+	return &qrlpb.LightClientUpdate{
+		AttestedHeader:          testHeader(slot),
+		NextSyncCommittee:       &qrlpb.SyncCommittee{Pubkeys: pubkeys},
+		NextSyncCommitteeBranch: testBranch(5),
+		FinalizedHeader:         testHeader(slot - 1),
+		FinalityBranch:          testBranch(6),
+		SyncAggregate: &qrlpb.SyncAggregate{
+			SyncCommitteeBits: make([]byte, params.BeaconConfig().SyncCommitteeSize/8),
+		},
+		SignatureSlot: slot + 1,
+	}
+}
+
+func TestGetLightClientUpdatesByRange(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.SetupDB(t)
+	slotsPerPeriod := primitives.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	for _, period := range []uint64{1, 2, 4} {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, testUpdate(primitives.Slot(period)*slotsPerPeriod)))
+	}
+	s := &Server{BeaconDB: db, GenesisFetcher: &mock.ChainService{}}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrl/v1/beacon/light_client/updates?start_period=1&count=4", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var resp []*LightClientUpdateWithVersion
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+		// The updates stop at the first missing period.
+		require.Equal(t, 2, len(resp))
+		assert.Equal(t, "zond", resp[0].Version)
+		assert.Equal(t, "1024", resp[0].Data.AttestedHeader.Slot)
+		assert.Equal(t, 5, len(resp[0].Data.NextSyncCommitteeBranch))
+		assert.Equal(t, 6, len(resp[0].Data.FinalityBranch))
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrl/v1/beacon/light_client/updates?start_period=1&count=1", nil)
+		request.Header.Set("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		body := writer.Body.Bytes()
+		require.Equal(t, true, len(body) > 12)
+		length := binary.LittleEndian.Uint64(body[:8])
+		require.Equal(t, uint64(len(body)-8), length)
+		update := &qrlpb.LightClientUpdate{}
+		require.NoError(t, update.UnmarshalSSZ(body[12:]))
+		assert.Equal(t, slotsPerPeriod, update.AttestedHeader.Slot)
+	})
+	t.Run("missing count", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrl/v1/beacon/light_client/updates?start_period=1", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "count is required", e.Message)
+	})
+}
+
+func TestGetLightClientFinalityUpdate(t *testing.T) {
+	t.Run("not available", func(t *testing.T) {
+		s := &Server{LightClientUpdateFetcher: &mock.ChainService{}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrl/v1/beacon/light_client/finality_update", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientFinalityUpdate(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("ok", func(t *testing.T) {
+		update := testUpdate(100)
+		s := &Server{LightClientUpdateFetcher: &mock.ChainService{LCFinalityUpdate: &qrlpb.LightClientFinalityUpdate{
+			AttestedHeader:  update.AttestedHeader,
+			FinalizedHeader: update.FinalizedHeader,
+			FinalityBranch:  update.FinalityBranch,
+			SyncAggregate:   update.SyncAggregate,
+			SignatureSlot:   update.SignatureSlot,
+		}}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrl/v1/beacon/light_client/finality_update", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientFinalityUpdate(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &LightClientFinalityUpdateResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "100", resp.Data.AttestedHeader.Slot)
+		assert.Equal(t, "99", resp.Data.FinalizedHeader.Slot)
+		assert.Equal(t, "101", resp.Data.SignatureSlot)
+	})
+}
+
+func TestGetLightClientOptimisticUpdate(t *testing.T) {
+	update := testUpdate(100)
+	s := &Server{LightClientUpdateFetcher: &mock.ChainService{LCOptimisticUpdate: &qrlpb.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}}}
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/qrl/v1/beacon/light_client/optimistic_update", nil)
+	request.Header.Set("Accept", "application/octet-stream")
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetLightClientOptimisticUpdate(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &qrlpb.LightClientOptimisticUpdate{}
+	require.NoError(t, resp.UnmarshalSSZ(writer.Body.Bytes()))
+	assert.Equal(t, primitives.Slot(101), resp.SignatureSlot)
+}
+
+func TestGetLightClientBootstrap_BlockNotFound(t *testing.T) {
+	s := &Server{BeaconDB: dbtest.SetupDB(t)}
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/qrl/v1/beacon/light_client/bootstrap/{block_root}", nil)
+	request = mux.SetURLVars(request, map[string]string{"block_root": "0x" + string(bytes.Repeat([]byte("ab"), 32))})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetLightClientBootstrap(writer, request)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+}
@@ -0,0 +1,14 @@
+package lightclient
+
+import (
+	"github.com/theQRL/qrysm/beacon-chain/blockchain"
+	"github.com/theQRL/qrysm/beacon-chain/db"
+	"github.com/theQRL/qrysm/beacon-chain/state/stategen"
+)
+
+type Server struct {
+	BeaconDB                 db.ReadOnlyDatabase
+	StateGen                 stategen.StateManager
+	GenesisFetcher           blockchain.GenesisFetcher
+	LightClientUpdateFetcher blockchain.LightClientUpdateFetcher
+}
@@ -0,0 +1,59 @@
+package lightclient
+
+import (
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/shared"
+)
+
+type LightClientBootstrapResponse struct {
+	Version string                `json:"version"`
+	Data    *LightClientBootstrap `json:"data"`
+}
+
+type LightClientBootstrap struct {
+	Header                     *shared.BeaconBlockHeader `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee            `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string                  `json:"current_sync_committee_branch"`
+}
+
+type SyncCommittee struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+
+type LightClientUpdateWithVersion struct {
+	Version string             `json:"version"`
+	Data    *LightClientUpdate `json:"data"`
+}
+
+type LightClientUpdate struct {
+	AttestedHeader          *shared.BeaconBlockHeader `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee            `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []string                  `json:"next_sync_committee_branch"`
+	FinalizedHeader         *shared.BeaconBlockHeader `json:"finalized_header"`
+	FinalityBranch          []string                  `json:"finality_branch"`
+	SyncAggregate           *shared.SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           string                    `json:"signature_slot"`
+}
+
+type LightClientFinalityUpdateResponse struct {
+	Version string                     `json:"version"`
+	Data    *LightClientFinalityUpdate `json:"data"`
+}
+
+type LightClientFinalityUpdate struct {
+	AttestedHeader  *shared.BeaconBlockHeader `json:"attested_header"`
+	FinalizedHeader *shared.BeaconBlockHeader `json:"finalized_header"`
+	FinalityBranch  []string                  `json:"finality_branch"`
+	SyncAggregate   *shared.SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot   string                    `json:"signature_slot"`
+}
+
+type LightClientOptimisticUpdateResponse struct {
+	Version string                       `json:"version"`
+	Data    *LightClientOptimisticUpdate `json:"data"`
+}
+
+type LightClientOptimisticUpdate struct {
+	AttestedHeader *shared.BeaconBlockHeader `json:"attested_header"`
+	SyncAggregate  *shared.SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot  string                    `json:"signature_slot"`
+}
@@ -30,5 +30,12 @@ func (s *Server) GetSyncStatus(w http.ResponseWriter, r *http.Request) {
 			ElOffline:    !s.ExecutionChainInfoFetcher.ExecutionClientConnected(),
 		},
 	}
+	if s.BackfillStatus != nil {
+		response.Data.Backfill = &BackfillStatus{
+			LowestSlot:     strconv.FormatUint(uint64(s.BackfillStatus.EndGap()), 10),
+			RemainingSlots: strconv.FormatUint(uint64(s.BackfillStatus.EndGap()-s.BackfillStatus.StartGap()), 10),
+			IsComplete:     s.BackfillStatus.Complete(),
+		}
+	}
 	http2.WriteJson(w, response)
 }
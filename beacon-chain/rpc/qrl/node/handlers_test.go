@@ -49,4 +49,48 @@ func TestSyncStatus(t *testing.T) {
 	assert.Equal(t, true, resp.Data.IsSyncing)
 	assert.Equal(t, true, resp.Data.IsOptimistic)
 	assert.Equal(t, false, resp.Data.ElOffline)
+	assert.Equal(t, (*BackfillStatus)(nil), resp.Data.Backfill)
+}
+
+type mockBackfillStatus struct {
+	start, end primitives.Slot
+	complete   bool
+}
+
+func (m *mockBackfillStatus) StartGap() primitives.Slot { return m.start }
+func (m *mockBackfillStatus) EndGap() primitives.Slot   { return m.end }
+func (m *mockBackfillStatus) Complete() bool            { return m.complete }
+func (m *mockBackfillStatus) SlotCovered(primitives.Slot) bool {
+	return m.complete
+}
+
+func TestSyncStatus_Backfill(t *testing.T) {
+	currentSlot := new(primitives.Slot)
+	*currentSlot = 110
+	state, err := util.NewBeaconStateZond()
+	require.NoError(t, err)
+	require.NoError(t, state.SetSlot(100))
+	chainService := &mock.ChainService{Slot: currentSlot, State: state}
+
+	s := &Server{
+		HeadFetcher:               chainService,
+		GenesisTimeFetcher:        chainService,
+		OptimisticModeFetcher:     chainService,
+		SyncChecker:               &syncmock.Sync{},
+		ExecutionChainInfoFetcher: &testutil.MockExecutionChainInfoFetcher{},
+		BackfillStatus:            &mockBackfillStatus{start: 0, end: 64},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetSyncStatus(writer, request)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	resp := &SyncStatusResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.NotNil(t, resp.Data.Backfill)
+	assert.Equal(t, "64", resp.Data.Backfill.LowestSlot)
+	assert.Equal(t, "64", resp.Data.Backfill.RemainingSlots)
+	assert.Equal(t, false, resp.Data.Backfill.IsComplete)
 }
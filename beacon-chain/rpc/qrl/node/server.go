@@ -9,6 +9,7 @@ import (
 	"github.com/theQRL/qrysm/beacon-chain/execution"
 	"github.com/theQRL/qrysm/beacon-chain/p2p"
 	"github.com/theQRL/qrysm/beacon-chain/sync"
+	"github.com/theQRL/qrysm/beacon-chain/sync/backfill"
 	"google.golang.org/grpc"
 )
 
@@ -26,4 +27,5 @@ type Server struct {
 	GenesisTimeFetcher        blockchain.TimeFetcher
 	HeadFetcher               blockchain.HeadFetcher
 	ExecutionChainInfoFetcher execution.ChainInfoFetcher
+	BackfillStatus            backfill.StatusFetcher
 }
@@ -10,4 +10,12 @@ type SyncStatusResponseData struct {
 	IsSyncing    bool   `json:"is_syncing"`
 	IsOptimistic bool   `json:"is_optimistic"`
 	ElOffline    bool   `json:"el_offline"`
+	// Backfill is only set when the node was started from a checkpoint and has to backfill the history below it.
+	Backfill *BackfillStatus `json:"backfill,omitempty"`
+}
+
+type BackfillStatus struct {
+	LowestSlot     string `json:"lowest_slot"`
+	RemainingSlots string `json:"remaining_slots"`
+	IsComplete     bool   `json:"is_complete"`
 }
@@ -1,6 +1,7 @@
 package rewards
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/beacon-chain/core/altair"
 	coreblocks "github.com/theQRL/qrysm/beacon-chain/core/blocks"
 	"github.com/theQRL/qrysm/beacon-chain/core/epoch/precompute"
@@ -16,6 +18,7 @@ import (
 	"github.com/theQRL/qrysm/beacon-chain/state"
 	field_params "github.com/theQRL/qrysm/config/fieldparams"
 	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
 	"github.com/theQRL/qrysm/consensus-types/primitives"
 	bytesutil2 "github.com/theQRL/qrysm/encoding/bytesutil"
 	http2 "github.com/theQRL/qrysm/network/http"
@@ -33,87 +36,88 @@ func (s *Server) BlockRewards(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	rewards, err := s.blockRewards(r.Context(), blk)
+	if err != nil {
+		http2.HandleError(w, "Could not get block rewards: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	blkRoot, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		http2.HandleError(w, "Could not get block root: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	optimistic, err := s.OptimisticModeFetcher.IsOptimisticForRoot(r.Context(), blkRoot)
+	if err != nil {
+		http2.HandleError(w, "Could not get optimistic mode info: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := &BlockRewardsResponse{
+		Data:                *rewards,
+		ExecutionOptimistic: optimistic,
+		Finalized:           s.FinalizationFetcher.IsFinalized(r.Context(), blkRoot),
+	}
+	http2.WriteJson(w, response)
+}
+
+// blockRewards computes the rewards of the proposer of the block for each operation in it.
+func (s *Server) blockRewards(ctx context.Context, blk interfaces.ReadOnlySignedBeaconBlock) (*BlockRewards, error) {
 	// We want to run several block processing functions that update the proposer's balance.
 	// This will allow us to calculate proposer rewards for each operation (atts, slashings etc).
 	// To do this, we replay the state up to the block's slot, but before processing the block.
-	st, err := s.ReplayerBuilder.ReplayerForSlot(blk.Block().Slot()-1).ReplayToSlot(r.Context(), blk.Block().Slot())
+	st, err := s.ReplayerBuilder.ReplayerForSlot(blk.Block().Slot()-1).ReplayToSlot(ctx, blk.Block().Slot())
 	if err != nil {
-		http2.HandleError(w, "Could not get state: "+err.Error(), http.StatusInternalServerError)
-		return
+		return nil, errors.Wrap(err, "could not get state")
 	}
 
 	proposerIndex := blk.Block().ProposerIndex()
 	initBalance, err := st.BalanceAtIndex(proposerIndex)
 	if err != nil {
-		http2.HandleError(w, "Could not get proposer's balance: "+err.Error(), http.StatusInternalServerError)
-		return
+		return nil, errors.Wrap(err, "could not get proposer's balance")
 	}
-	st, err = altair.ProcessAttestationsNoVerifySignature(r.Context(), st, blk)
+	st, err = altair.ProcessAttestationsNoVerifySignature(ctx, st, blk)
 	if err != nil {
-		http2.HandleError(w, "Could not get attestation rewards"+err.Error(), http.StatusInternalServerError)
-		return
+		return nil, errors.Wrap(err, "could not get attestation rewards")
 	}
 	attBalance, err := st.BalanceAtIndex(proposerIndex)
 	if err != nil {
-		http2.HandleError(w, "Could not get proposer's balance: "+err.Error(), http.StatusInternalServerError)
-		return
+		return nil, errors.Wrap(err, "could not get proposer's balance")
 	}
-	st, err = coreblocks.ProcessAttesterSlashingsNoVerify(r.Context(), st, blk.Block().Body().AttesterSlashings(), validators.SlashValidator)
+	st, err = coreblocks.ProcessAttesterSlashingsNoVerify(ctx, st, blk.Block().Body().AttesterSlashings(), validators.SlashValidator)
 	if err != nil {
-		http2.HandleError(w, "Could not get attester slashing rewards: "+err.Error(), http.StatusInternalServerError)
-		return
+		return nil, errors.Wrap(err, "could not get attester slashing rewards")
 	}
 	attSlashingsBalance, err := st.BalanceAtIndex(proposerIndex)
 	if err != nil {
-		http2.HandleError(w, "Could not get proposer's balance: "+err.Error(), http.StatusInternalServerError)
-		return
+		return nil, errors.Wrap(err, "could not get proposer's balance")
 	}
-	st, err = coreblocks.ProcessProposerSlashingsNoVerify(r.Context(), st, blk.Block().Body().ProposerSlashings(), validators.SlashValidator)
+	st, err = coreblocks.ProcessProposerSlashingsNoVerify(ctx, st, blk.Block().Body().ProposerSlashings(), validators.SlashValidator)
 	if err != nil {
-		http2.HandleError(w, "Could not get proposer slashing rewards"+err.Error(), http.StatusInternalServerError)
-		return
+		return nil, errors.Wrap(err, "could not get proposer slashing rewards")
 	}
 	proposerSlashingsBalance, err := st.BalanceAtIndex(proposerIndex)
 	if err != nil {
-		http2.HandleError(w, "Could not get proposer's balance: "+err.Error(), http.StatusInternalServerError)
-		return
+		return nil, errors.Wrap(err, "could not get proposer's balance")
 	}
 	sa, err := blk.Block().Body().SyncAggregate()
 	if err != nil {
-		http2.HandleError(w, "Could not get sync aggregate: "+err.Error(), http.StatusInternalServerError)
-		return
+		return nil, errors.Wrap(err, "could not get sync aggregate")
 	}
 	var syncCommitteeReward uint64
-	_, syncCommitteeReward, err = altair.ProcessSyncAggregateNoVerifySig(r.Context(), st, sa)
-	if err != nil {
-		http2.HandleError(w, "Could not get sync aggregate rewards: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	blkRoot, err := blk.Block().HashTreeRoot()
-	if err != nil {
-		http2.HandleError(w, "Could not get block root: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	optimistic, err := s.OptimisticModeFetcher.IsOptimisticForRoot(r.Context(), blkRoot)
+	_, syncCommitteeReward, err = altair.ProcessSyncAggregateNoVerifySig(ctx, st, sa)
 	if err != nil {
-		http2.HandleError(w, "Could not get optimistic mode info: "+err.Error(), http.StatusInternalServerError)
-		return
+		return nil, errors.Wrap(err, "could not get sync aggregate rewards")
 	}
 
-	response := &BlockRewardsResponse{
-		Data: BlockRewards{
-			ProposerIndex:     strconv.FormatUint(uint64(proposerIndex), 10),
-			Total:             strconv.FormatUint(proposerSlashingsBalance-initBalance+syncCommitteeReward, 10),
-			Attestations:      strconv.FormatUint(attBalance-initBalance, 10),
-			SyncAggregate:     strconv.FormatUint(syncCommitteeReward, 10),
-			ProposerSlashings: strconv.FormatUint(proposerSlashingsBalance-attSlashingsBalance, 10),
-			AttesterSlashings: strconv.FormatUint(attSlashingsBalance-attBalance, 10),
-		},
-		ExecutionOptimistic: optimistic,
-		Finalized:           s.FinalizationFetcher.IsFinalized(r.Context(), blkRoot),
-	}
-	http2.WriteJson(w, response)
+	return &BlockRewards{
+		ProposerIndex:     strconv.FormatUint(uint64(proposerIndex), 10),
+		Total:             strconv.FormatUint(proposerSlashingsBalance-initBalance+syncCommitteeReward, 10),
+		Attestations:      strconv.FormatUint(attBalance-initBalance, 10),
+		SyncAggregate:     strconv.FormatUint(syncCommitteeReward, 10),
+		ProposerSlashings: strconv.FormatUint(proposerSlashingsBalance-attSlashingsBalance, 10),
+		AttesterSlashings: strconv.FormatUint(attSlashingsBalance-attBalance, 10),
+	}, nil
 }
 
 // AttestationRewards retrieves attestation reward info for validators specified by array of public keys or validator index.
@@ -444,35 +448,42 @@ func requestedValIndices(w http.ResponseWriter, r *http.Request, st state.Beacon
 			return nil, false
 		}
 	}
+	valIndices, err := valIndicesFromIds(st, rawValIds)
+	if err != nil {
+		http2.HandleError(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+	if len(valIndices) == 0 {
+		valIndices = make([]primitives.ValidatorIndex, len(allVals))
+		for i := range allVals {
+			valIndices[i] = primitives.ValidatorIndex(i)
+		}
+	}
+
+	return valIndices, true
+}
+
+// valIndicesFromIds converts validator indices and public keys to validator indices.
+func valIndicesFromIds(st state.ReadOnlyBeaconState, rawValIds []string) ([]primitives.ValidatorIndex, error) {
 	valIndices := make([]primitives.ValidatorIndex, len(rawValIds))
 	for i, v := range rawValIds {
 		index, err := strconv.ParseUint(v, 10, 64)
 		if err != nil {
 			pubkey, err := bytesutil.FromHexString(v)
 			if err != nil || len(pubkey) != field_params.MLDSA87PubkeyLength {
-				http2.HandleError(w, fmt.Sprintf("%s is not a validator index or pubkey", v), http.StatusBadRequest)
-				return nil, false
+				return nil, fmt.Errorf("%s is not a validator index or pubkey", v)
 			}
 			var ok bool
 			valIndices[i], ok = st.ValidatorIndexByPubkey(bytesutil2.ToBytes2592(pubkey))
 			if !ok {
-				http2.HandleError(w, fmt.Sprintf("No validator index found for pubkey %#x", pubkey), http.StatusBadRequest)
-				return nil, false
+				return nil, fmt.Errorf("No validator index found for pubkey %#x", pubkey)
 			}
 		} else {
 			if index >= uint64(st.NumValidators()) {
-				http2.HandleError(w, fmt.Sprintf("Validator index %d is too large. Maximum allowed index is %d", index, st.NumValidators()-1), http.StatusBadRequest)
-				return nil, false
+				return nil, fmt.Errorf("Validator index %d is too large. Maximum allowed index is %d", index, st.NumValidators()-1)
 			}
 			valIndices[i] = primitives.ValidatorIndex(index)
 		}
 	}
-	if len(valIndices) == 0 {
-		valIndices = make([]primitives.ValidatorIndex, len(allVals))
-		for i := range allVals {
-			valIndices[i] = primitives.ValidatorIndex(i)
-		}
-	}
-
-	return valIndices, true
+	return valIndices, nil
 }
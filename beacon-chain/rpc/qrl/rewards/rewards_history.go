@@ -0,0 +1,336 @@
+package rewards
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/beacon-chain/core/altair"
+	"github.com/theQRL/qrysm/beacon-chain/core/epoch/precompute"
+	"github.com/theQRL/qrysm/beacon-chain/core/helpers"
+	"github.com/theQRL/qrysm/beacon-chain/core/transition"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/beacon-chain/state/stategen"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	http2 "github.com/theQRL/qrysm/network/http"
+	"github.com/theQRL/qrysm/time/slots"
+)
+
+// RewardsHistory is an HTTP handler that streams the rewards and penalties of the requested validators for each
+// epoch of a range as newline delimited JSON, one ValidatorEpochRewards object per validator and epoch, ordered by
+// epoch. The states of the range are replayed one epoch at a time, so that long ranges do not need to be held in
+// memory. An error that occurs after the response has started is written as a final RewardsHistoryError line.
+func (s *Server) RewardsHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req RewardsHistoryRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(req.Validators) == 0 {
+		http2.HandleError(w, "No validators requested", http.StatusBadRequest)
+		return
+	}
+	startEpoch, err := strconv.ParseUint(req.StartEpoch, 10, 64)
+	if err != nil {
+		http2.HandleError(w, "Could not decode start epoch: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	endEpoch, err := strconv.ParseUint(req.EndEpoch, 10, 64)
+	if err != nil {
+		http2.HandleError(w, "Could not decode end epoch: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if startEpoch > endEpoch {
+		http2.HandleError(w, "Start epoch is after end epoch", http.StatusBadRequest)
+		return
+	}
+	currentEpoch := uint64(slots.ToEpoch(s.TimeFetcher.CurrentSlot()))
+	if currentEpoch < 2 || endEpoch > currentEpoch-2 {
+		http2.HandleError(w,
+			"Attestation rewards are available after two epoch transitions to ensure all attestations have a chance of inclusion",
+			http.StatusNotFound)
+		return
+	}
+	headSt, err := s.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	valIndices, err := valIndicesFromIds(headSt, req.Validators)
+	if err != nil {
+		http2.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Each epoch is computed from the states at its end and at the end of the next epoch. Only the first state is
+	// regenerated, each following state is advanced from the previous one, so every slot of the range is processed
+	// once.
+	st, err := s.epochEndState(ctx, primitives.Epoch(startEpoch))
+	if err != nil {
+		http2.HandleError(w, "Could not get state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	enc := json.NewEncoder(w)
+	flusher, _ := w.(http.Flusher)
+	for epoch := primitives.Epoch(startEpoch); epoch <= primitives.Epoch(endEpoch); epoch++ {
+		if ctx.Err() != nil {
+			return
+		}
+		nextSt, err := s.advanceToEpochEnd(ctx, st, epoch+1)
+		if err != nil {
+			writeRewardsHistoryError(enc, errors.Wrapf(err, "could not get state at the end of epoch %d", epoch+1))
+			return
+		}
+		rewards, err := s.epochRewards(ctx, epoch, st, nextSt, valIndices)
+		if err != nil {
+			writeRewardsHistoryError(enc, errors.Wrapf(err, "could not compute rewards of epoch %d", epoch))
+			return
+		}
+		for _, rw := range rewards {
+			if err := enc.Encode(rw.toJson()); err != nil {
+				return
+			}
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		st = nextSt
+	}
+}
+
+// validatorRewards accumulates the rewards of a validator in an epoch.
+type validatorRewards struct {
+	epoch                  primitives.Epoch
+	validatorIndex         primitives.ValidatorIndex
+	sourceReward           uint64
+	sourcePenalty          uint64
+	targetReward           uint64
+	targetPenalty          uint64
+	headReward             uint64
+	syncReward             int64
+	proposerReward         uint64
+	effectiveBalanceChange int64
+}
+
+func (rw *validatorRewards) toJson() *ValidatorEpochRewards {
+	return &ValidatorEpochRewards{
+		Epoch:                  strconv.FormatUint(uint64(rw.epoch), 10),
+		ValidatorIndex:         strconv.FormatUint(uint64(rw.validatorIndex), 10),
+		SourceReward:           strconv.FormatUint(rw.sourceReward, 10),
+		SourcePenalty:          strconv.FormatUint(rw.sourcePenalty, 10),
+		TargetReward:           strconv.FormatUint(rw.targetReward, 10),
+		TargetPenalty:          strconv.FormatUint(rw.targetPenalty, 10),
+		HeadReward:             strconv.FormatUint(rw.headReward, 10),
+		SyncReward:             strconv.FormatInt(rw.syncReward, 10),
+		ProposerReward:         strconv.FormatUint(rw.proposerReward, 10),
+		EffectiveBalanceChange: strconv.FormatInt(rw.effectiveBalanceChange, 10),
+	}
+}
+
+func writeRewardsHistoryError(enc *json.Encoder, err error) {
+	// The status code is already sent, so the error can only be reported in the body.
+	_ = enc.Encode(&RewardsHistoryError{Error: err.Error()})
+}
+
+// epochEndState replays the state at the last slot of the epoch.
+func (s *Server) epochEndState(ctx context.Context, epoch primitives.Epoch) (state.BeaconState, error) {
+	end, err := slots.EpochEnd(epoch)
+	if err != nil {
+		return nil, err
+	}
+	return s.ReplayerBuilder.ReplayerForSlot(end).ReplayBlocks(ctx)
+}
+
+// advanceToEpochEnd applies the canonical blocks of the epoch to a copy of st, which must be the state at the end of
+// the previous epoch, and returns the state at the last slot of the epoch.
+func (s *Server) advanceToEpochEnd(ctx context.Context, st state.BeaconState, epoch primitives.Epoch) (state.BeaconState, error) {
+	start, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, err
+	}
+	end, err := slots.EpochEnd(epoch)
+	if err != nil {
+		return nil, err
+	}
+	st = st.Copy()
+	for slot := start; slot <= end; slot++ {
+		blk, err := s.Blocker.Block(ctx, []byte(strconv.FormatUint(uint64(slot), 10)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get block at slot %d", slot)
+		}
+		if blk == nil || blk.IsNil() {
+			continue
+		}
+		st, err = stategen.ReplayProcessSlots(ctx, st, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+		}
+		// The blocks are read from the database, so their signatures and state roots are not verified again.
+		st, err = transition.ProcessBlockForStateRoot(ctx, st, blk)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process block at slot %d", slot)
+		}
+	}
+	return stategen.ReplayProcessSlots(ctx, st, end)
+}
+
+// epochRewards computes the rewards of the validators in the epoch. st is the state at the end of the epoch and
+// nextSt the state at the end of the next epoch, whose previous epoch participation holds the attestations of
+// the epoch.
+func (s *Server) epochRewards(
+	ctx context.Context,
+	epoch primitives.Epoch,
+	st, nextSt state.BeaconState,
+	valIndices []primitives.ValidatorIndex,
+) ([]*validatorRewards, error) {
+	// Validators that are not in the registry yet are skipped.
+	requested := make(map[primitives.ValidatorIndex]*validatorRewards, len(valIndices))
+	rewards := make([]*validatorRewards, 0, len(valIndices))
+	for _, idx := range valIndices {
+		if uint64(idx) >= uint64(st.NumValidators()) || requested[idx] != nil {
+			continue
+		}
+		rw := &validatorRewards{epoch: epoch, validatorIndex: idx}
+		requested[idx] = rw
+		rewards = append(rewards, rw)
+	}
+	if len(rewards) == 0 {
+		return nil, nil
+	}
+
+	if err := attestationEpochRewards(ctx, nextSt, rewards); err != nil {
+		return nil, err
+	}
+	if err := s.blockEpochRewards(ctx, epoch, st, requested); err != nil {
+		return nil, err
+	}
+	for _, rw := range rewards {
+		before, err := st.ValidatorAtIndexReadOnly(rw.validatorIndex)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get validator")
+		}
+		after, err := nextSt.ValidatorAtIndexReadOnly(rw.validatorIndex)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get validator")
+		}
+		rw.effectiveBalanceChange = int64(after.EffectiveBalance()) - int64(before.EffectiveBalance()) // lint:ignore uintcast -- Effective balances fit in an int64.
+	}
+	return rewards, nil
+}
+
+// attestationEpochRewards sets the attestation rewards and penalties of the previous epoch of the state.
+func attestationEpochRewards(ctx context.Context, st state.BeaconState, rewards []*validatorRewards) error {
+	allVals, bal, err := altair.InitializePrecomputeValidators(ctx, st)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize precompute validators")
+	}
+	allVals, bal, err = altair.ProcessEpochParticipation(ctx, st, bal, allVals)
+	if err != nil {
+		return errors.Wrap(err, "could not process epoch participation")
+	}
+	vals := make([]*precompute.Validator, len(rewards))
+	for i, rw := range rewards {
+		vals[i] = allVals[rw.validatorIndex]
+	}
+	deltas, err := altair.AttestationsDelta(st, bal, vals)
+	if err != nil {
+		return errors.Wrap(err, "could not get attestations delta")
+	}
+	for i, d := range deltas {
+		rewards[i].sourceReward = d.SourceReward
+		rewards[i].sourcePenalty = d.SourcePenalty
+		rewards[i].targetReward = d.TargetReward
+		rewards[i].targetPenalty = d.TargetPenalty
+		rewards[i].headReward = d.HeadReward
+	}
+	return nil
+}
+
+// blockEpochRewards sets the sync committee rewards and penalties, and the proposer rewards of the blocks of the
+// epoch. st must be a state of the epoch, so that its current sync committee and total active balance are the ones
+// used to reward the sync aggregates of the epoch.
+func (s *Server) blockEpochRewards(
+	ctx context.Context,
+	epoch primitives.Epoch,
+	st state.BeaconState,
+	requested map[primitives.ValidatorIndex]*validatorRewards,
+) error {
+	sc, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return errors.Wrap(err, "could not get current sync committee")
+	}
+	// The positions of the requested validators in the sync committee. A validator can have several positions.
+	scMembers := make(map[int]*validatorRewards)
+	for i, pk := range sc.Pubkeys {
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes2592(pk))
+		if !ok {
+			return errors.Errorf("no validator index found for sync committee pubkey %#x", pk)
+		}
+		if rw, ok := requested[idx]; ok {
+			scMembers[i] = rw
+		}
+	}
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return errors.Wrap(err, "could not get total active balance")
+	}
+	_, participantReward, err := altair.SyncRewards(activeBalance)
+	if err != nil {
+		return errors.Wrap(err, "could not get sync committee rewards")
+	}
+
+	start, err := slots.EpochStart(epoch)
+	if err != nil {
+		return err
+	}
+	for slot := start; slot < start+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		if slot == 0 {
+			continue
+		}
+		blk, err := s.Blocker.Block(ctx, []byte(strconv.FormatUint(uint64(slot), 10)))
+		if err != nil {
+			return errors.Wrapf(err, "could not get block at slot %d", slot)
+		}
+		if blk == nil || blk.IsNil() {
+			continue
+		}
+		if len(scMembers) > 0 {
+			sa, err := blk.Block().Body().SyncAggregate()
+			if err != nil {
+				return errors.Wrap(err, "could not get sync aggregate")
+			}
+			for i, rw := range scMembers {
+				if sa.SyncCommitteeBits.BitAt(uint64(i)) {
+					rw.syncReward += int64(participantReward) // lint:ignore uintcast -- Sync rewards fit in an int64.
+				} else {
+					rw.syncReward -= int64(participantReward) // lint:ignore uintcast -- Sync rewards fit in an int64.
+				}
+			}
+		}
+		if rw, ok := requested[blk.Block().ProposerIndex()]; ok {
+			br, err := s.blockRewards(ctx, blk)
+			if err != nil {
+				return errors.Wrapf(err, "could not get rewards of block at slot %d", slot)
+			}
+			total, err := strconv.ParseUint(br.Total, 10, 64)
+			if err != nil {
+				return err
+			}
+			rw.proposerReward += total
+		}
+	}
+	return nil
+}
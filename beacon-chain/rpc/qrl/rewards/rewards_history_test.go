@@ -0,0 +1,185 @@
+package rewards
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/theQRL/go-bitfield"
+	mock "github.com/theQRL/qrysm/beacon-chain/blockchain/testing"
+	"github.com/theQRL/qrysm/beacon-chain/core/altair"
+	"github.com/theQRL/qrysm/beacon-chain/core/helpers"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/testutil"
+	mockstategen "github.com/theQRL/qrysm/beacon-chain/state/stategen/mock"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/blocks"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/crypto/ml_dsa_87"
+	http2 "github.com/theQRL/qrysm/network/http"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+func TestRewardsHistory(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	helpers.ClearCache()
+	ctx := context.Background()
+	spe := params.BeaconConfig().SlotsPerEpoch
+
+	valCount := 64
+	st, err := util.NewBeaconStateZond()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(spe*2-1))
+	validators := make([]*qrysmpb.Validator, 0, valCount)
+	balances := make([]uint64, 0, valCount)
+	secretKeys := make([]ml_dsa_87.MLDSA87Key, 0, valCount)
+	for range valCount {
+		mlDSA87Key, err := ml_dsa_87.RandKey()
+		require.NoError(t, err)
+		secretKeys = append(secretKeys, mlDSA87Key)
+		validators = append(validators, &qrysmpb.Validator{
+			PublicKey:         mlDSA87Key.PublicKey().Marshal(),
+			ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
+			EffectiveBalance:  params.BeaconConfig().MaxEffectiveBalance,
+		})
+		balances = append(balances, params.BeaconConfig().MaxEffectiveBalance)
+	}
+	require.NoError(t, st.SetValidators(validators))
+	require.NoError(t, st.SetBalances(balances))
+	require.NoError(t, st.SetInactivityScores(make([]uint64, valCount)))
+	participation := make([]byte, valCount)
+	for i := range participation {
+		participation[i] = 0b111
+	}
+	require.NoError(t, st.SetCurrentParticipationBits(participation))
+	require.NoError(t, st.SetPreviousParticipationBits(participation))
+	sc, err := altair.NextSyncCommittee(ctx, st)
+	require.NoError(t, err)
+	require.NoError(t, st.SetCurrentSyncCommittee(sc))
+
+	// The effective balance of validator 0 drops by an increment at the end of epoch 1.
+	require.NoError(t, st.UpdateBalancesAtIndex(0, params.BeaconConfig().MaxEffectiveBalance-params.BeaconConfig().EffectiveBalanceIncrement))
+
+	// Validator 0 proposes a block in epoch 1 with a full sync aggregate.
+	blkSlot := spe + 1
+	blkSt := st.Copy()
+	require.NoError(t, blkSt.SetSlot(blkSlot))
+	b := util.HydrateSignedBeaconBlockZond(util.NewBeaconBlockZond())
+	b.Block.Slot = blkSlot
+	b.Block.ProposerIndex = 0
+	scBits := bitfield.NewBitvector128()
+	for i := range scBits.Len() {
+		scBits.SetBitAt(i, true)
+	}
+	b.Block.Body.SyncAggregate = &qrysmpb.SyncAggregate{SyncCommitteeBits: scBits}
+	sbb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	replayer := mockstategen.NewMockReplayerBuilder()
+	// Only the state at the end of the start epoch is replayed, the state at the end of epoch 2 is advanced from it.
+	replayer.SetMockStateForSlot(st, spe*2-1)
+	replayer.SetMockStateForSlot(blkSt, blkSlot-1)
+	currentSlot := spe * 3
+	chainService := &mock.ChainService{Slot: &currentSlot, State: st}
+	blocker := &testutil.MockBlocker{SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{blkSlot: sbb}}
+	s := &Server{
+		Blocker:         blocker,
+		ReplayerBuilder: replayer,
+		TimeFetcher:     chainService,
+		HeadFetcher:     chainService,
+	}
+
+	request := func(t *testing.T, req *RewardsHistoryRequest) *httptest.ResponseRecorder {
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+		r := httptest.NewRequest(http.MethodPost, "http://example.com/qrysm/validators/rewards_history", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.RewardsHistory(writer, r)
+		return writer
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		pubkey := fmt.Sprintf("%#x", secretKeys[1].PublicKey().Marshal())
+		writer := request(t, &RewardsHistoryRequest{Validators: []string{"0", pubkey}, StartEpoch: "1", EndEpoch: "1"})
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "application/x-ndjson", writer.Header().Get("Content-Type"))
+
+		var lines []*ValidatorEpochRewards
+		scanner := bufio.NewScanner(writer.Body)
+		for scanner.Scan() {
+			rw := &ValidatorEpochRewards{}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), rw))
+			lines = append(lines, rw)
+		}
+		require.NoError(t, scanner.Err())
+		require.Equal(t, 2, len(lines))
+
+		activeBalance, err := helpers.TotalActiveBalance(st)
+		require.NoError(t, err)
+		_, participantReward, err := altair.SyncRewards(activeBalance)
+		require.NoError(t, err)
+		for i, rw := range lines {
+			assert.Equal(t, "1", rw.Epoch)
+			assert.Equal(t, strconv.Itoa(i), rw.ValidatorIndex)
+			assert.Equal(t, "0", rw.SourcePenalty)
+			assert.Equal(t, "0", rw.TargetPenalty)
+			assert.NotEqual(t, "0", rw.HeadReward)
+			positions := 0
+			for _, pk := range sc.Pubkeys {
+				if bytes.Equal(pk, validators[i].PublicKey) {
+					positions++
+				}
+			}
+			assert.Equal(t, strconv.FormatUint(uint64(positions)*participantReward, 10), rw.SyncReward)
+		}
+		assert.NotEqual(t, "0", lines[0].ProposerReward)
+		assert.Equal(t, "0", lines[1].ProposerReward)
+		assert.Equal(t, fmt.Sprintf("-%d", params.BeaconConfig().EffectiveBalanceIncrement), lines[0].EffectiveBalanceChange)
+		assert.Equal(t, "0", lines[1].EffectiveBalanceChange)
+	})
+	t.Run("start after end", func(t *testing.T) {
+		writer := request(t, &RewardsHistoryRequest{Validators: []string{"0"}, StartEpoch: "1", EndEpoch: "0"})
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, "Start epoch is after end epoch", e.Message)
+	})
+	t.Run("end epoch too recent", func(t *testing.T) {
+		writer := request(t, &RewardsHistoryRequest{Validators: []string{"0"}, StartEpoch: "1", EndEpoch: "2"})
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("no validators", func(t *testing.T) {
+		writer := request(t, &RewardsHistoryRequest{StartEpoch: "1", EndEpoch: "1"})
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("unknown validator", func(t *testing.T) {
+		writer := request(t, &RewardsHistoryRequest{Validators: []string{"64"}, StartEpoch: "1", EndEpoch: "1"})
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, "Validator index 64 is too large. Maximum allowed index is 63", e.Message)
+	})
+	t.Run("state replay fails", func(t *testing.T) {
+		blocker.ErrorToReturn = fmt.Errorf("missing blocks")
+		defer func() {
+			blocker.ErrorToReturn = nil
+		}()
+		writer := request(t, &RewardsHistoryRequest{Validators: []string{"0"}, StartEpoch: "1", EndEpoch: "1"})
+		require.Equal(t, http.StatusOK, writer.Code)
+		e := &RewardsHistoryError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "could not get state at the end of epoch 2", e.Error)
+		assert.StringContains(t, "missing blocks", e.Error)
+	})
+}
@@ -51,3 +51,26 @@ type SyncCommitteeReward struct {
 	ValidatorIndex string `json:"validator_index"`
 	Reward         string `json:"reward"`
 }
+
+type RewardsHistoryRequest struct {
+	Validators []string `json:"validators"`
+	StartEpoch string   `json:"start_epoch"`
+	EndEpoch   string   `json:"end_epoch"`
+}
+
+type ValidatorEpochRewards struct {
+	Epoch                  string `json:"epoch"`
+	ValidatorIndex         string `json:"validator_index"`
+	SourceReward           string `json:"source_reward"`
+	SourcePenalty          string `json:"source_penalty"`
+	TargetReward           string `json:"target_reward"`
+	TargetPenalty          string `json:"target_penalty"`
+	HeadReward             string `json:"head_reward"`
+	SyncReward             string `json:"sync_reward"`
+	ProposerReward         string `json:"proposer_reward"`
+	EffectiveBalanceChange string `json:"effective_balance_change"`
+}
+
+type RewardsHistoryError struct {
+	Error string `json:"error"`
+}
@@ -63,6 +63,10 @@ func WriteStateFetchError(w http.ResponseWriter, err error) {
 		http2.HandleError(w, "Could not get state: lacking historical data needed to fulfill request", http.StatusNotFound)
 		return
 	}
+	if errors.Is(err, stategen.ErrReplayBudgetExceeded) {
+		http2.HandleError(w, "Could not get state: "+err.Error(), http.StatusServiceUnavailable)
+		return
+	}
 	// Use errors.As so a typed error wrapped in lookup.FetchStateError (e.g.
 	// the wrapping that the optimistic-status check applies before calling
 	// HandleIsOptimisticError) is still recognized.
@@ -86,6 +90,11 @@ func WriteBlockFetchError(w http.ResponseWriter, blk interfaces.ReadOnlySignedBe
 		http2.HandleError(w, "Invalid block ID: "+invalidBlockIdErr.Error(), http.StatusBadRequest)
 		return false
 	}
+	var notAvailableErr *lookup.BlockNotAvailableError
+	if errors.As(err, &notAvailableErr) {
+		http2.HandleError(w, "Could not get block: lacking historical data needed to fulfill request: "+notAvailableErr.Error(), http.StatusNotFound)
+		return false
+	}
 	if err != nil {
 		http2.HandleError(w, "Could not get block from block ID: "+err.Error(), http.StatusInternalServerError)
 		return false
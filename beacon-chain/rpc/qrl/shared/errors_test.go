@@ -35,6 +35,12 @@ func TestWriteStateFetchError(t *testing.T) {
 			code:        http.StatusNotFound,
 			wantMessage: "Could not get state: lacking historical data needed to fulfill request",
 		},
+		{
+			name:        "replay budget exceeded returns service unavailable",
+			err:         pkgerrors.Wrap(stategen.ErrReplayBudgetExceeded, "could not regenerate state at slot=123"),
+			code:        http.StatusServiceUnavailable,
+			wantMessage: "Could not get state: could not regenerate state at slot=123: " + stategen.ErrReplayBudgetExceeded.Error(),
+		},
 		{
 			name: "state not found returns not found",
 			err: func() error {
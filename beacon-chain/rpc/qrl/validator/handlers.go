@@ -76,6 +76,15 @@ func (s *Server) GetAggregateAttestation(w http.ResponseWriter, r *http.Request)
 		http2.HandleError(w, "No matching attestation found", http.StatusNotFound)
 		return
 	}
+	if http2.RespondWithSsz(r) {
+		sszResp, err := match.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, "Could not marshal attestation: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "aggregate_attestation.ssz")
+		return
+	}
 
 	sigs := make([]string, len(match.Signatures))
 	for i, sig := range match.Signatures {
@@ -797,6 +806,15 @@ func (s *Server) GetAttesterDuties(w http.ResponseWriter, r *http.Request) {
 		Data:                duties,
 		ExecutionOptimistic: isOptimistic,
 	}
+	if http2.RespondWithSsz(r) {
+		sszResp, err := response.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, "Could not marshal attester duties: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "attester_duties.ssz")
+		return
+	}
 	http2.WriteJson(w, response)
 }
 
@@ -908,6 +926,15 @@ func (s *Server) GetProposerDuties(w http.ResponseWriter, r *http.Request) {
 		Data:                duties,
 		ExecutionOptimistic: isOptimistic,
 	}
+	if http2.RespondWithSsz(r) {
+		sszResp, err := resp.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, "Could not marshal proposer duties: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "proposer_duties.ssz")
+		return
+	}
 	http2.WriteJson(w, resp)
 }
 
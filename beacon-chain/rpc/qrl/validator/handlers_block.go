@@ -85,6 +85,7 @@ func (s *Server) produceBlockV3(ctx context.Context, w http.ResponseWriter, r *h
 		http2.HandleError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	w.Header().Set(api.VersionHeader, version.String(version.Zond))
 	w.Header().Set(api.ExecutionPayloadBlindedHeader, fmt.Sprintf("%v", v1alpha1resp.IsBlinded))
 	w.Header().Set(api.ExecutionPayloadValueHeader, fmt.Sprintf("%d", v1alpha1resp.PayloadValue))
 	optimistic, err := s.OptimisticModeFetcher.IsOptimistic(ctx)
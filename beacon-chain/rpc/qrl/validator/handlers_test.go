@@ -18,6 +18,7 @@ import (
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/theQRL/go-qrl/common"
 	"github.com/theQRL/go-qrl/common/hexutil"
+	"github.com/theQRL/qrysm/api"
 	mockChain "github.com/theQRL/qrysm/beacon-chain/blockchain/testing"
 	builderTest "github.com/theQRL/qrysm/beacon-chain/builder/testing"
 	"github.com/theQRL/qrysm/beacon-chain/cache"
@@ -189,6 +190,23 @@ func TestGetAggregateAttestation(t *testing.T) {
 		assert.Equal(t, "1", resp.Data.Data.Target.Epoch)
 		assert.DeepEqual(t, hexutil.Encode(root33), resp.Data.Data.Target.Root)
 	})
+	t.Run("ssz", func(t *testing.T) {
+		reqRoot, err := attslot22.Data.HashTreeRoot()
+		require.NoError(t, err)
+		attDataRoot := hexutil.Encode(reqRoot[:])
+		url := "http://example.com?attestation_data_root=" + attDataRoot + "&slot=2"
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAggregateAttestation(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		att := &qrysmpb.Attestation{}
+		require.NoError(t, att.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.DeepEqual(t, attslot22, att)
+	})
 	t.Run("no matching attestation", func(t *testing.T) {
 		attDataRoot := hexutil.Encode(bytesutil.PadTo([]byte("foo"), 32))
 		url := "http://example.com?attestation_data_root=" + attDataRoot + "&slot=2"
@@ -1638,6 +1656,27 @@ func TestGetAttesterDuties(t *testing.T) {
 		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 		require.Equal(t, 2, len(resp.Data))
 	})
+	t.Run("ssz", func(t *testing.T) {
+		var body bytes.Buffer
+		_, err = body.WriteString("[\"0\",\"1\"]")
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodGet, "http://www.example.com/qrl/v1/validator/duties/attester/{epoch}", &body)
+		request = mux.SetURLVars(request, map[string]string{"epoch": "0"})
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAttesterDuties(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		resp := &GetAttesterDutiesResponse{}
+		require.NoError(t, resp.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.Equal(t, hexutil.Encode(genesisRoot[:]), resp.DependentRoot)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, hexutil.Encode(pubKeys[0]), resp.Data[0].Pubkey)
+		assert.Equal(t, "0", resp.Data[0].ValidatorIndex)
+		assert.Equal(t, "1", resp.Data[1].ValidatorIndex)
+	})
 	t.Run("no body", func(t *testing.T) {
 		request := httptest.NewRequest(http.MethodPost, "http://www.example.com/qrl/v1/validator/duties/attester/{epoch}", nil)
 		request = mux.SetURLVars(request, map[string]string{"epoch": "0"})
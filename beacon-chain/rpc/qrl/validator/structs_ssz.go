@@ -0,0 +1,167 @@
+package validator
+
+import (
+	"strconv"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/theQRL/go-qrl/common/hexutil"
+	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
+)
+
+// The duty responses are served as SSZ containers when the client prefers application/octet-stream:
+//
+//	class AttesterDuty(Container):
+//	    pubkey: Bytes2592
+//	    validator_index: uint64
+//	    committee_index: uint64
+//	    committee_length: uint64
+//	    committees_at_slot: uint64
+//	    validator_committee_index: uint64
+//	    slot: uint64
+//
+//	class ProposerDuty(Container):
+//	    pubkey: Bytes2592
+//	    validator_index: uint64
+//	    slot: uint64
+//
+//	class GetDutiesResponse(Container):
+//	    dependent_root: Bytes32
+//	    execution_optimistic: boolean
+//	    data: List[Duty, VALIDATOR_REGISTRY_LIMIT]
+const (
+	attesterDutySize    = fieldparams.MLDSA87PubkeyLength + 6*8
+	proposerDutySize    = fieldparams.MLDSA87PubkeyLength + 2*8
+	dutiesResponseFixed = fieldparams.RootLength + 1 + 4
+)
+
+// MarshalSSZ encodes the response as an SSZ container.
+func (r *GetAttesterDutiesResponse) MarshalSSZ() ([]byte, error) {
+	dst, err := marshalDutiesHeader(r.DependentRoot, r.ExecutionOptimistic, len(r.Data)*attesterDutySize)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range r.Data {
+		if dst, err = marshalPubkey(dst, d.Pubkey); err != nil {
+			return nil, err
+		}
+		for _, v := range []string{d.ValidatorIndex, d.CommitteeIndex, d.CommitteeLength, d.CommitteesAtSlot, d.ValidatorCommitteeIndex, d.Slot} {
+			if dst, err = marshalUint64String(dst, v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return dst, nil
+}
+
+// UnmarshalSSZ decodes the response from an SSZ container.
+func (r *GetAttesterDutiesResponse) UnmarshalSSZ(buf []byte) error {
+	data, err := unmarshalDutiesHeader(buf, attesterDutySize, &r.DependentRoot, &r.ExecutionOptimistic)
+	if err != nil {
+		return err
+	}
+	r.Data = make([]*AttesterDuty, len(data)/attesterDutySize)
+	for i := range r.Data {
+		b := data[i*attesterDutySize : (i+1)*attesterDutySize]
+		u := func(n int) string {
+			off := fieldparams.MLDSA87PubkeyLength + 8*n
+			return strconv.FormatUint(ssz.UnmarshallUint64(b[off:off+8]), 10)
+		}
+		r.Data[i] = &AttesterDuty{
+			Pubkey:                  hexutil.Encode(b[:fieldparams.MLDSA87PubkeyLength]),
+			ValidatorIndex:          u(0),
+			CommitteeIndex:          u(1),
+			CommitteeLength:         u(2),
+			CommitteesAtSlot:        u(3),
+			ValidatorCommitteeIndex: u(4),
+			Slot:                    u(5),
+		}
+	}
+	return nil
+}
+
+// MarshalSSZ encodes the response as an SSZ container.
+func (r *GetProposerDutiesResponse) MarshalSSZ() ([]byte, error) {
+	dst, err := marshalDutiesHeader(r.DependentRoot, r.ExecutionOptimistic, len(r.Data)*proposerDutySize)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range r.Data {
+		if dst, err = marshalPubkey(dst, d.Pubkey); err != nil {
+			return nil, err
+		}
+		if dst, err = marshalUint64String(dst, d.ValidatorIndex); err != nil {
+			return nil, err
+		}
+		if dst, err = marshalUint64String(dst, d.Slot); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+// UnmarshalSSZ decodes the response from an SSZ container.
+func (r *GetProposerDutiesResponse) UnmarshalSSZ(buf []byte) error {
+	data, err := unmarshalDutiesHeader(buf, proposerDutySize, &r.DependentRoot, &r.ExecutionOptimistic)
+	if err != nil {
+		return err
+	}
+	r.Data = make([]*ProposerDuty, len(data)/proposerDutySize)
+	for i := range r.Data {
+		b := data[i*proposerDutySize : (i+1)*proposerDutySize]
+		r.Data[i] = &ProposerDuty{
+			Pubkey:         hexutil.Encode(b[:fieldparams.MLDSA87PubkeyLength]),
+			ValidatorIndex: strconv.FormatUint(ssz.UnmarshallUint64(b[fieldparams.MLDSA87PubkeyLength:]), 10),
+			Slot:           strconv.FormatUint(ssz.UnmarshallUint64(b[fieldparams.MLDSA87PubkeyLength+8:]), 10),
+		}
+	}
+	return nil
+}
+
+func marshalDutiesHeader(dependentRoot string, optimistic bool, dataSize int) ([]byte, error) {
+	root, err := hexutil.Decode(dependentRoot)
+	if err != nil {
+		return nil, err
+	}
+	if len(root) != fieldparams.RootLength {
+		return nil, ssz.ErrBytesLengthFn("dependent_root", len(root), fieldparams.RootLength)
+	}
+	dst := make([]byte, 0, dutiesResponseFixed+dataSize)
+	dst = append(dst, root...)
+	dst = ssz.MarshalBool(dst, optimistic)
+	return ssz.WriteOffset(dst, dutiesResponseFixed), nil
+}
+
+func unmarshalDutiesHeader(buf []byte, dutySize int, dependentRoot *string, optimistic *bool) ([]byte, error) {
+	if len(buf) < dutiesResponseFixed {
+		return nil, ssz.ErrSize
+	}
+	if ssz.ReadOffset(buf[fieldparams.RootLength+1:dutiesResponseFixed]) != dutiesResponseFixed {
+		return nil, ssz.ErrOffset
+	}
+	data := buf[dutiesResponseFixed:]
+	if len(data)%dutySize != 0 {
+		return nil, ssz.ErrSize
+	}
+	*dependentRoot = hexutil.Encode(buf[:fieldparams.RootLength])
+	*optimistic = ssz.UnmarshalBool(buf[fieldparams.RootLength : fieldparams.RootLength+1])
+	return data, nil
+}
+
+func marshalPubkey(dst []byte, pubkey string) ([]byte, error) {
+	b, err := hexutil.Decode(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != fieldparams.MLDSA87PubkeyLength {
+		return nil, ssz.ErrBytesLengthFn("pubkey", len(b), fieldparams.MLDSA87PubkeyLength)
+	}
+	return append(dst, b...), nil
+}
+
+func marshalUint64String(dst []byte, v string) ([]byte, error) {
+	n, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.MarshalUint64(dst, n), nil
+}
@@ -0,0 +1,62 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/theQRL/go-qrl/common/hexutil"
+	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func TestDutiesResponse_SSZ(t *testing.T) {
+	root := hexutil.Encode(bytesutil.PadTo([]byte("root"), fieldparams.RootLength))
+	pubkey := hexutil.Encode(bytesutil.PadTo([]byte("pubkey"), fieldparams.MLDSA87PubkeyLength))
+
+	t.Run("attester", func(t *testing.T) {
+		resp := &GetAttesterDutiesResponse{
+			DependentRoot:       root,
+			ExecutionOptimistic: true,
+			Data: []*AttesterDuty{
+				{Pubkey: pubkey, ValidatorIndex: "1", CommitteeIndex: "2", CommitteeLength: "3", CommitteesAtSlot: "4", ValidatorCommitteeIndex: "5", Slot: "6"},
+				{Pubkey: pubkey, ValidatorIndex: "7", CommitteeIndex: "8", CommitteeLength: "9", CommitteesAtSlot: "10", ValidatorCommitteeIndex: "11", Slot: "12"},
+			},
+		}
+		enc, err := resp.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, dutiesResponseFixed+2*attesterDutySize, len(enc))
+		got := &GetAttesterDutiesResponse{}
+		require.NoError(t, got.UnmarshalSSZ(enc))
+		require.DeepEqual(t, resp, got)
+
+		require.NotNil(t, got.UnmarshalSSZ(enc[:len(enc)-1]))
+	})
+	t.Run("proposer", func(t *testing.T) {
+		resp := &GetProposerDutiesResponse{
+			DependentRoot: root,
+			Data: []*ProposerDuty{
+				{Pubkey: pubkey, ValidatorIndex: "1", Slot: "128"},
+			},
+		}
+		enc, err := resp.MarshalSSZ()
+		require.NoError(t, err)
+		got := &GetProposerDutiesResponse{}
+		require.NoError(t, got.UnmarshalSSZ(enc))
+		require.DeepEqual(t, resp, got)
+	})
+	t.Run("empty", func(t *testing.T) {
+		enc, err := (&GetProposerDutiesResponse{DependentRoot: root}).MarshalSSZ()
+		require.NoError(t, err)
+		got := &GetProposerDutiesResponse{}
+		require.NoError(t, got.UnmarshalSSZ(enc))
+		require.Equal(t, 0, len(got.Data))
+	})
+	t.Run("invalid pubkey", func(t *testing.T) {
+		resp := &GetProposerDutiesResponse{
+			DependentRoot: root,
+			Data:          []*ProposerDuty{{Pubkey: "0x01", ValidatorIndex: "1", Slot: "1"}},
+		}
+		_, err := resp.MarshalSSZ()
+		require.ErrorContains(t, "pubkey", err)
+	})
+}
@@ -0,0 +1,66 @@
+package debug
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/shared"
+	"github.com/theQRL/qrysm/beacon-chain/state/stategen"
+	http2 "github.com/theQRL/qrysm/network/http"
+	"go.opencensus.io/trace"
+)
+
+// GetRegenCost is an HTTP handler that serves the GET /qrysm/debug/states/{state_id}/regen_cost endpoint.
+// It estimates how many slots and blocks the node has to replay to regenerate the state, without regenerating it,
+// and whether that fits in the replay budget of the node. Requests for states over the budget are rejected by the
+// /qrl/v1/beacon/states endpoints and should be sent to an archive node instead.
+//
+// Example usage:
+//
+//	GET /qrysm/debug/states/123456/regen_cost
+func (s *Server) GetRegenCost(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetRegenCost")
+	defer span.End()
+
+	if s.HistoricalStates == nil {
+		http2.HandleError(w, "Historical state regeneration is not available", http.StatusNotFound)
+		return
+	}
+	stateId := mux.Vars(r)["state_id"]
+	if stateId == "" {
+		http2.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	slot, err := s.Stater.StateSlot(ctx, []byte(stateId))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return
+	}
+	if slot > s.GenesisTimeFetcher.CurrentSlot() {
+		http2.HandleError(w, fmt.Sprintf("Slot %d is in the future", slot), http.StatusBadRequest)
+		return
+	}
+	cost, err := s.HistoricalStates.RegenCost(ctx, slot)
+	if err != nil {
+		if errors.Is(err, stategen.ErrNoDataForSlot) {
+			http2.HandleError(w, "Lacking historical data needed to regenerate the state", http.StatusNotFound)
+			return
+		}
+		http2.HandleError(w, "Could not estimate regeneration cost: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &RegenCostResponse{
+		Data: &RegenCost{
+			Slot:         strconv.FormatUint(uint64(cost.Slot), 10),
+			BaseSlot:     strconv.FormatUint(uint64(cost.BaseSlot), 10),
+			BaseCached:   cost.Cached,
+			ReplaySlots:  strconv.FormatUint(uint64(cost.ReplaySlots()), 10),
+			ReplayBlocks: strconv.Itoa(cost.Blocks),
+			ReplayBudget: strconv.FormatUint(uint64(cost.Budget), 10),
+			WithinBudget: cost.WithinBudget(),
+		},
+	})
+}
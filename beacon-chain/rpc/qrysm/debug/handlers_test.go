@@ -0,0 +1,148 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-qrl/common/hexutil"
+	mock "github.com/theQRL/qrysm/beacon-chain/blockchain/testing"
+	testDB "github.com/theQRL/qrysm/beacon-chain/db/testing"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/lookup"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/beacon-chain/state/stategen"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	http2 "github.com/theQRL/qrysm/network/http"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+type mockHistoricalStates struct {
+	baseSlot primitives.Slot
+	err      error
+}
+
+func (m *mockHistoricalStates) StateBySlot(context.Context, primitives.Slot) (state.BeaconState, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockHistoricalStates) StateByBlockRoot(context.Context, [32]byte) (state.BeaconState, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockHistoricalStates) RegenCost(_ context.Context, slot primitives.Slot) (*stategen.RegenCost, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &stategen.RegenCost{Slot: slot, BaseSlot: m.baseSlot, Blocks: 3, Budget: 64}, nil
+}
+
+func TestGetRegenCost(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateZond(t, 16)
+	require.NoError(t, st.SetSlot(200))
+	stateRoot := bytesutil.PadTo([]byte("state root"), 32)
+	require.NoError(t, st.UpdateStateRootAtIndex(150, bytesutil.ToBytes32(stateRoot)))
+	db := testDB.SetupDB(t)
+	blk := util.NewBeaconBlockZond()
+	blk.Block.Slot = 130
+	util.SaveBlock(t, context.Background(), db, blk)
+	finalizedRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	blk = util.NewBeaconBlockZond()
+	blk.Block.Slot = 150
+	util.SaveBlock(t, context.Background(), db, blk)
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.UpdateBlockRootAtIndex(150, blockRoot))
+	currentSlot := primitives.Slot(200)
+	chain := &mock.ChainService{
+		State:               st,
+		Slot:                &currentSlot,
+		FinalizedCheckPoint: &qrysmpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]},
+	}
+	hs := &mockHistoricalStates{baseSlot: 100}
+	s := &Server{
+		GenesisTimeFetcher: chain,
+		HistoricalStates:   hs,
+		Stater: &lookup.BeaconDbStater{
+			BeaconDB:         db,
+			ChainInfoFetcher: chain,
+		},
+	}
+
+	request := func(stateId string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/debug/states/"+stateId+"/regen_cost", nil)
+		r = mux.SetURLVars(r, map[string]string{"state_id": stateId})
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.GetRegenCost(w, r)
+		return w
+	}
+
+	t.Run("slot", func(t *testing.T) {
+		w := request("120")
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &RegenCostResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.DeepEqual(t, &RegenCost{
+			Slot:         "120",
+			BaseSlot:     "100",
+			ReplaySlots:  "20",
+			ReplayBlocks: "3",
+			ReplayBudget: "64",
+			WithinBudget: true,
+		}, resp.Data)
+	})
+	t.Run("over budget", func(t *testing.T) {
+		w := request("190")
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &RegenCostResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, "90", resp.Data.ReplaySlots)
+		require.Equal(t, false, resp.Data.WithinBudget)
+	})
+	t.Run("finalized", func(t *testing.T) {
+		w := request("finalized")
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &RegenCostResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, "130", resp.Data.Slot)
+	})
+	t.Run("state root", func(t *testing.T) {
+		w := request(hexutil.Encode(stateRoot))
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &RegenCostResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, "150", resp.Data.Slot)
+	})
+	t.Run("unknown state root", func(t *testing.T) {
+		w := request(hexutil.Encode(bytesutil.PadTo([]byte("unknown"), 32)))
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("future slot", func(t *testing.T) {
+		w := request("201")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), e))
+		require.Equal(t, "Slot 201 is in the future", e.Message)
+	})
+	t.Run("invalid state id", func(t *testing.T) {
+		w := request("foo")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("no data for slot", func(t *testing.T) {
+		hs.err = errors.Wrap(stategen.ErrNoDataForSlot, "slot not backfilled")
+		defer func() {
+			hs.err = nil
+		}()
+		w := request("10")
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
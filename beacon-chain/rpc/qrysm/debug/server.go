@@ -0,0 +1,13 @@
+package debug
+
+import (
+	"github.com/theQRL/qrysm/beacon-chain/blockchain"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/lookup"
+	"github.com/theQRL/qrysm/beacon-chain/state/stategen"
+)
+
+type Server struct {
+	GenesisTimeFetcher blockchain.TimeFetcher
+	HistoricalStates   stategen.HistoricalStateFetcher
+	Stater             lookup.Stater
+}
@@ -0,0 +1,15 @@
+package debug
+
+type RegenCostResponse struct {
+	Data *RegenCost `json:"data"`
+}
+
+type RegenCost struct {
+	Slot         string `json:"slot"`
+	BaseSlot     string `json:"base_slot"`
+	BaseCached   bool   `json:"base_cached"`
+	ReplaySlots  string `json:"replay_slots"`
+	ReplayBlocks string `json:"replay_blocks"`
+	ReplayBudget string `json:"replay_budget"`
+	WithinBudget bool   `json:"within_budget"`
+}
@@ -0,0 +1,192 @@
+package node
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/theQRL/qrysm/beacon-chain/p2p/peers/scorers"
+	http2 "github.com/theQRL/qrysm/network/http"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+)
+
+// ListPeerScores retrieves the score of every known peer, the score given to it by every scorer and its ban history.
+func (s *Server) ListPeerScores(w http.ResponseWriter, _ *http.Request) {
+	peerStatus := s.PeersFetcher.Peers()
+	ids := peerStatus.All()
+	sortPeerIDs(ids)
+	now := time.Now()
+	scores := make([]*PeerScore, 0, len(ids))
+	for _, id := range ids {
+		state, err := peerStatus.ConnectionState(id)
+		if err != nil {
+			if errors.Is(err, peerdata.ErrPeerUnknown) {
+				continue
+			}
+			http2.HandleError(w, "Could not obtain connection state: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		breakdown := peerStatus.Scorers().ScoreBreakdown(id)
+		history := peerStatus.BanHistory(id)
+		bans := make([]*PeerBan, len(history))
+		for i, b := range history {
+			bans[i] = httpPeerBan(id, b, now)
+		}
+		scores = append(scores, &PeerScore{
+			PeerID:  id.String(),
+			State:   qrysmpb.ConnectionState(state).String(),
+			Score:   breakdown.Score,
+			IsBad:   peerStatus.IsBad(id),
+			Trusted: peerStatus.IsTrustedPeers(id),
+			Allowed: peerStatus.IsAllowedPeer(id),
+			Components: &PeerScoreComponents{
+				BadResponses:  httpComponentScore(breakdown.BadResponses),
+				BlockProvider: httpComponentScore(breakdown.BlockProvider),
+				PeerStatus:    httpComponentScore(breakdown.PeerStatus),
+				Gossip:        httpComponentScore(breakdown.Gossip),
+			},
+			Bans: bans,
+		})
+	}
+	http2.WriteJson(w, &PeerScoresResponse{Data: scores})
+}
+
+// ListBannedPeers retrieves the bans in force, whether they were set by the operator or by the peer scorers.
+func (s *Server) ListBannedPeers(w http.ResponseWriter, _ *http.Request) {
+	active := s.PeersFetcher.Peers().ActiveBans()
+	ids := make([]peer.ID, 0, len(active))
+	for id := range active {
+		ids = append(ids, id)
+	}
+	sortPeerIDs(ids)
+	now := time.Now()
+	bans := make([]*PeerBan, len(ids))
+	for i, id := range ids {
+		bans[i] = httpPeerBan(id, active[id], now)
+	}
+	http2.WriteJson(w, &PeerBansResponse{Data: bans})
+}
+
+// BanPeer bans a peer, for the given duration or until it is unbanned, and disconnects from it. A banned peer is
+// neither dialed nor accepted, even when it is trusted.
+func (s *Server) BanPeer(w http.ResponseWriter, r *http.Request) {
+	var req BanPeerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	peerId, err := peer.Decode(req.PeerID)
+	if err != nil {
+		http2.HandleError(w, "Could not decode peer id: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	var duration time.Duration
+	if req.Duration != "" {
+		duration, err = time.ParseDuration(req.Duration)
+		if err != nil || duration < 0 {
+			http2.HandleError(w, "Invalid ban duration: "+req.Duration, http.StatusBadRequest)
+			return
+		}
+	}
+	reason := req.Reason
+	if reason == "" {
+		reason = "banned by the operator"
+	}
+
+	peerStatus := s.PeersFetcher.Peers()
+	peerStatus.Ban(peerId, reason, duration)
+	if peerStatus.IsActive(peerId) {
+		if err := s.PeerManager.Disconnect(peerId); err != nil {
+			http2.HandleError(w, "Could not disconnect from peer: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// UnbanPeer lifts the ban set on a peer by the operator. Bans set by the peer scorers end once the scores of the peer
+// recover.
+func (s *Server) UnbanPeer(w http.ResponseWriter, r *http.Request) {
+	peerId, err := peer.Decode(mux.Vars(r)["peer_id"])
+	if err != nil {
+		http2.HandleError(w, "Could not decode peer id: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !s.PeersFetcher.Peers().Unban(peerId) {
+		http2.HandleError(w, "Peer is not banned by the operator", http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// ListAllowedPeers retrieves the ids of the allow-listed peers.
+func (s *Server) ListAllowedPeers(w http.ResponseWriter, _ *http.Request) {
+	ids := s.PeersFetcher.Peers().GetAllowedPeers()
+	sortPeerIDs(ids)
+	data := make([]string, len(ids))
+	for i, id := range ids {
+		data[i] = id.String()
+	}
+	http2.WriteJson(w, &AllowedPeersResponse{Data: data})
+}
+
+// AddAllowedPeer adds a peer to the allow-list. Allowed peers are never considered bad by the peer scorers, but bans
+// set by the operator still apply to them.
+func (s *Server) AddAllowedPeer(w http.ResponseWriter, r *http.Request) {
+	var req PeerIdRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	peerId, err := peer.Decode(req.PeerID)
+	if err != nil {
+		http2.HandleError(w, "Could not decode peer id: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.PeersFetcher.Peers().AllowPeers([]peer.ID{peerId})
+	w.WriteHeader(http.StatusOK)
+}
+
+// RemoveAllowedPeer removes a peer from the allow-list but does not close the connection.
+func (s *Server) RemoveAllowedPeer(w http.ResponseWriter, r *http.Request) {
+	peerId, err := peer.Decode(mux.Vars(r)["peer_id"])
+	if err != nil {
+		http2.HandleError(w, "Could not decode peer id: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.PeersFetcher.Peers().DisallowPeers([]peer.ID{peerId})
+	w.WriteHeader(http.StatusOK)
+}
+
+func httpComponentScore(c scorers.ComponentScore) *ComponentScore {
+	return &ComponentScore{
+		Score:  c.Score,
+		Weight: c.Weight,
+		IsBad:  c.IsBad,
+	}
+}
+
+func httpPeerBan(id peer.ID, b *peerdata.Ban, now time.Time) *PeerBan {
+	ban := &PeerBan{
+		PeerID: id.String(),
+		Reason: b.Reason,
+		Manual: b.Manual,
+		Start:  b.Start.UTC().Format(time.RFC3339),
+		Active: b.Active(now),
+	}
+	if !b.End.IsZero() {
+		ban.End = b.End.UTC().Format(time.RFC3339)
+	}
+	return ban
+}
+
+func sortPeerIDs(ids []peer.ID) {
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+}
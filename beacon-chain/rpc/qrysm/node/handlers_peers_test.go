@@ -0,0 +1,163 @@
+package node
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/libp2p/go-libp2p/core/peer"
+	mockp2p "github.com/theQRL/qrysm/beacon-chain/p2p/testing"
+	http2 "github.com/theQRL/qrysm/network/http"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func TestListPeerScores(t *testing.T) {
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerStatus := peerFetcher.Peers()
+	ids := peerStatus.All()
+	require.Equal(t, 2, len(ids))
+	sortPeerIDs(ids)
+	peerStatus.Scorers().BadResponsesScorer().Increment(ids[0])
+	peerStatus.Ban(ids[1], "spam", 0)
+	s := Server{PeersFetcher: peerFetcher}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/node/peers/scores", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.ListPeerScores(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &PeerScoresResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Data))
+
+	first := resp.Data[0]
+	assert.Equal(t, ids[0].String(), first.PeerID)
+	assert.Equal(t, "CONNECTED", first.State)
+	assert.Equal(t, false, first.IsBad)
+	assert.Equal(t, true, first.Components.BadResponses.Score < 0)
+	assert.Equal(t, 0, len(first.Bans))
+
+	second := resp.Data[1]
+	assert.Equal(t, ids[1].String(), second.PeerID)
+	assert.Equal(t, true, second.IsBad)
+	require.Equal(t, 1, len(second.Bans))
+	assert.Equal(t, "spam", second.Bans[0].Reason)
+	assert.Equal(t, true, second.Bans[0].Manual)
+	assert.Equal(t, true, second.Bans[0].Active)
+	assert.Equal(t, "", second.Bans[0].End)
+}
+
+func TestBanPeer(t *testing.T) {
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerStatus := peerFetcher.Peers()
+	ids := peerStatus.All()
+	require.Equal(t, 2, len(ids))
+	sortPeerIDs(ids)
+	s := Server{PeersFetcher: peerFetcher, PeerManager: &mockp2p.MockPeerManager{}}
+
+	ban := func(body string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/qrysm/node/peers/bans", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.BanPeer(writer, request)
+		return writer
+	}
+	listBans := func() *PeerBansResponse {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/node/peers/bans", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ListBannedPeers(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &PeerBansResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp
+	}
+	unban := func(id string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/qrysm/node/peers/bans/"+id, nil)
+		request = mux.SetURLVars(request, map[string]string{"peer_id": id})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.UnbanPeer(writer, request)
+		return writer
+	}
+
+	t.Run("ban", func(t *testing.T) {
+		w := ban(`{"peer_id":"` + ids[0].String() + `","reason":"spam","duration":"1h"}`)
+		require.Equal(t, http.StatusOK, w.Code)
+		w = ban(`{"peer_id":"` + ids[1].String() + `"}`)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, true, peerStatus.IsBad(ids[0]))
+		assert.Equal(t, true, peerStatus.IsBad(ids[1]))
+
+		resp := listBans()
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, ids[0].String(), resp.Data[0].PeerID)
+		assert.Equal(t, "spam", resp.Data[0].Reason)
+		assert.NotEqual(t, "", resp.Data[0].End)
+		assert.Equal(t, "banned by the operator", resp.Data[1].Reason)
+		assert.Equal(t, "", resp.Data[1].End)
+	})
+	t.Run("unban", func(t *testing.T) {
+		w := unban(ids[0].String())
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, false, peerStatus.IsBad(ids[0]))
+		resp := listBans()
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, ids[1].String(), resp.Data[0].PeerID)
+
+		w = unban(ids[0].String())
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("invalid peer id", func(t *testing.T) {
+		w := ban(`{"peer_id":"foo"}`)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		w = unban("foo")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("invalid duration", func(t *testing.T) {
+		w := ban(`{"peer_id":"` + ids[0].String() + `","duration":"-1h"}`)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), e))
+		assert.Equal(t, "Invalid ban duration: -1h", e.Message)
+	})
+}
+
+func TestAllowedPeers(t *testing.T) {
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerFetcher.ClearPeers()
+	s := Server{PeersFetcher: peerFetcher}
+	id, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+
+	listAllowed := func() []string {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/node/peers/allowed", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ListAllowedPeers(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &AllowedPeersResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp.Data
+	}
+
+	request := httptest.NewRequest(http.MethodPost, "http://example.com/qrysm/node/peers/allowed", bytes.NewBufferString(`{"peer_id":"`+id.String()+`"}`))
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.AddAllowedPeer(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	assert.DeepEqual(t, []string{id.String()}, listAllowed())
+
+	request = httptest.NewRequest(http.MethodDelete, "http://example.com/qrysm/node/peers/allowed/"+id.String(), nil)
+	request = mux.SetURLVars(request, map[string]string{"peer_id": id.String()})
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.RemoveAllowedPeer(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	assert.Equal(t, 0, len(listAllowed()))
+	assert.Equal(t, false, peerFetcher.Peers().IsAllowedPeer(id))
+}
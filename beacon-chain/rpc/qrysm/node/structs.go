@@ -15,3 +15,58 @@ type Peer struct {
 	State              string `json:"state"`
 	Direction          string `json:"direction"`
 }
+
+type PeerIdRequest struct {
+	PeerID string `json:"peer_id"`
+}
+
+type BanPeerRequest struct {
+	PeerID   string `json:"peer_id"`
+	Reason   string `json:"reason"`
+	Duration string `json:"duration"`
+}
+
+type PeerScoresResponse struct {
+	Data []*PeerScore `json:"data"`
+}
+
+type PeerScore struct {
+	PeerID     string               `json:"peer_id"`
+	State      string               `json:"state"`
+	Score      float64              `json:"score"`
+	IsBad      bool                 `json:"is_bad"`
+	Trusted    bool                 `json:"trusted"`
+	Allowed    bool                 `json:"allowed"`
+	Components *PeerScoreComponents `json:"components"`
+	Bans       []*PeerBan           `json:"bans"`
+}
+
+type PeerScoreComponents struct {
+	BadResponses  *ComponentScore `json:"bad_responses"`
+	BlockProvider *ComponentScore `json:"block_provider"`
+	PeerStatus    *ComponentScore `json:"peer_status"`
+	Gossip        *ComponentScore `json:"gossip"`
+}
+
+type ComponentScore struct {
+	Score  float64 `json:"score"`
+	Weight float64 `json:"weight"`
+	IsBad  bool    `json:"is_bad"`
+}
+
+type PeerBansResponse struct {
+	Data []*PeerBan `json:"data"`
+}
+
+type PeerBan struct {
+	PeerID string `json:"peer_id"`
+	Reason string `json:"reason"`
+	Manual bool   `json:"manual"`
+	Start  string `json:"start"`
+	End    string `json:"end,omitempty"`
+	Active bool   `json:"active"`
+}
+
+type AllowedPeersResponse struct {
+	Data []string `json:"data"`
+}
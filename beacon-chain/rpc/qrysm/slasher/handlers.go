@@ -0,0 +1,198 @@
+package slasher
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-qrl/common/hexutil"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/shared"
+	slashertypes "github.com/theQRL/qrysm/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/cmd"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	http2 "github.com/theQRL/qrysm/network/http"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// ListSlashings is an HTTP handler that serves the GET /qrysm/slasher/slashings endpoint.
+// It returns the slashings detected by the slasher for offenses committed from start_epoch to end_epoch
+// included, ordered by epoch. Both bounds are optional. Each slashing holds the conflicting attestations
+// or block headers as evidence of the offense, and the outcome of its submission to the slashing pool.
+// The slashings are paginated with the optional page_size and page_token parameters, and the response
+// holds the token of the next page, empty on the last page.
+//
+// Example usage:
+//
+//	GET /qrysm/slasher/slashings?start_epoch=100&end_epoch=200&page_size=50&page_token=1
+func (s *Server) ListSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.ListSlashings")
+	defer span.End()
+
+	if s.SlashingRecordsFetcher == nil {
+		http2.HandleError(w, "Slasher is not enabled, use --slasher to detect slashable offenses", http.StatusNotFound)
+		return
+	}
+	ok, _, startEpoch := shared.UintFromQuery(w, r, "start_epoch")
+	if !ok {
+		return
+	}
+	ok, rawEndEpoch, endEpoch := shared.UintFromQuery(w, r, "end_epoch")
+	if !ok {
+		return
+	}
+	if rawEndEpoch == "" {
+		endEpoch = math.MaxUint64
+	}
+	if startEpoch > endEpoch {
+		http2.HandleError(w, "start_epoch is after end_epoch", http.StatusBadRequest)
+		return
+	}
+	page, ok := pageFromQuery(w, r)
+	if !ok {
+		return
+	}
+	// One more record than the page size tells whether there is a next page.
+	records, err := s.SlashingRecordsFetcher.SlashingRecords(
+		ctx, primitives.Epoch(startEpoch), primitives.Epoch(endEpoch), page.offset(), page.size+1,
+	)
+	if err != nil {
+		http2.HandleError(w, "Could not get slashings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeSlashings(w, records, page)
+}
+
+// ListValidatorSlashings is an HTTP handler that serves the GET /qrysm/slasher/validators/{validator_index}/slashings
+// endpoint. It returns the slashings detected by the slasher for offenses committed by the validator, in the same
+// format and with the same pagination as the GET /qrysm/slasher/slashings endpoint.
+//
+// Example usage:
+//
+//	GET /qrysm/slasher/validators/42/slashings?page_size=50
+func (s *Server) ListValidatorSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.ListValidatorSlashings")
+	defer span.End()
+
+	if s.SlashingRecordsFetcher == nil {
+		http2.HandleError(w, "Slasher is not enabled, use --slasher to detect slashable offenses", http.StatusNotFound)
+		return
+	}
+	idx, ok := shared.ValidateUint(w, "validator_index", mux.Vars(r)["validator_index"])
+	if !ok {
+		return
+	}
+	page, ok := pageFromQuery(w, r)
+	if !ok {
+		return
+	}
+	records, err := s.SlashingRecordsFetcher.ValidatorSlashingRecords(
+		ctx, primitives.ValidatorIndex(idx), page.offset(), page.size+1,
+	)
+	if err != nil {
+		http2.HandleError(w, "Could not get slashings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeSlashings(w, records, page)
+}
+
+// slashingsPage is a page of slashings, numbered by its token.
+type slashingsPage struct {
+	token int
+	size  int
+}
+
+func (p slashingsPage) offset() int {
+	return p.token * p.size
+}
+
+// pageFromQuery reads the page_size and page_token query parameters. The page size defaults to the default page
+// size of the RPC servers and is capped by their maximum page size.
+func pageFromQuery(w http.ResponseWriter, r *http.Request) (slashingsPage, bool) {
+	ok, _, pageSize := shared.UintFromQuery(w, r, "page_size")
+	if !ok {
+		return slashingsPage{}, false
+	}
+	if pageSize == 0 {
+		pageSize = uint64(params.BeaconConfig().DefaultPageSize)
+	}
+	if pageSize > uint64(cmd.Get().MaxRPCPageSize) {
+		http2.HandleError(
+			w,
+			fmt.Sprintf("Requested page size %d can not be greater than max size %d", pageSize, cmd.Get().MaxRPCPageSize),
+			http.StatusBadRequest,
+		)
+		return slashingsPage{}, false
+	}
+	ok, _, token := shared.UintFromQuery(w, r, "page_token")
+	if !ok {
+		return slashingsPage{}, false
+	}
+	if token > uint64(math.MaxInt32) {
+		http2.HandleError(w, "page_token is too large", http.StatusBadRequest)
+		return slashingsPage{}, false
+	}
+	return slashingsPage{token: int(token), size: int(pageSize)}, true
+}
+
+// writeSlashings writes the records of a page, which were read with one more record than the page size to tell
+// whether there is a next page.
+func writeSlashings(w http.ResponseWriter, records []*slashertypes.SlashingRecord, page slashingsPage) {
+	resp := &SlashingsResponse{}
+	if len(records) > page.size {
+		records = records[:page.size]
+		resp.NextPageToken = strconv.Itoa(page.token + 1)
+	}
+	resp.Data = make([]*Slashing, len(records))
+	for i, r := range records {
+		sl, err := slashingToJson(r)
+		if err != nil {
+			http2.HandleError(w, "Could not convert slashing: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp.Data[i] = sl
+	}
+	http2.WriteJson(w, resp)
+}
+
+func slashingToJson(r *slashertypes.SlashingRecord) (*Slashing, error) {
+	root, err := r.Root()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute slashing root")
+	}
+	sl := &Slashing{
+		Root:            hexutil.Encode(root[:]),
+		Epoch:           strconv.FormatUint(uint64(r.Epoch()), 10),
+		DetectedAt:      r.DetectedAt.UTC().Format(time.RFC3339),
+		Status:          string(r.Status),
+		RejectionReason: r.RejectionReason,
+	}
+	for _, idx := range r.ValidatorIndices() {
+		sl.ValidatorIndices = append(sl.ValidatorIndices, strconv.FormatUint(uint64(idx), 10))
+	}
+	if r.Status == slashertypes.SlashingIncluded {
+		sl.IncludedSlot = strconv.FormatUint(uint64(r.IncludedSlot), 10)
+		sl.IncludedBlockRoot = hexutil.Encode(r.IncludedBlockRoot[:])
+	}
+	if r.AttesterSlashing != nil {
+		sl.Kind = "attester"
+		slashings, err := shared.AttesterSlashingsFromConsensus([]*qrysmpb.AttesterSlashing{r.AttesterSlashing})
+		if err != nil {
+			return nil, err
+		}
+		sl.AttesterSlashing = slashings[0]
+		return sl, nil
+	}
+	sl.Kind = "proposer"
+	slashings, err := shared.ProposerSlashingsFromConsensus([]*qrysmpb.ProposerSlashing{r.ProposerSlashing})
+	if err != nil {
+		return nil, err
+	}
+	sl.ProposerSlashing = slashings[0]
+	return sl, nil
+}
@@ -0,0 +1,200 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	slashertypes "github.com/theQRL/qrysm/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/cmd"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+type mockSlashingRecordsFetcher struct {
+	records    []*slashertypes.SlashingRecord
+	startEpoch primitives.Epoch
+	endEpoch   primitives.Epoch
+}
+
+func (m *mockSlashingRecordsFetcher) SlashingRecords(
+	_ context.Context, startEpoch, endEpoch primitives.Epoch, offset, limit int,
+) ([]*slashertypes.SlashingRecord, error) {
+	m.startEpoch, m.endEpoch = startEpoch, endEpoch
+	return pageOf(m.records, offset, limit), nil
+}
+
+func (m *mockSlashingRecordsFetcher) ValidatorSlashingRecords(
+	_ context.Context, idx primitives.ValidatorIndex, offset, limit int,
+) ([]*slashertypes.SlashingRecord, error) {
+	out := make([]*slashertypes.SlashingRecord, 0)
+	for _, r := range m.records {
+		if slices.Contains(r.ValidatorIndices(), idx) {
+			out = append(out, r)
+		}
+	}
+	return pageOf(out, offset, limit), nil
+}
+
+func pageOf(records []*slashertypes.SlashingRecord, offset, limit int) []*slashertypes.SlashingRecord {
+	records = records[min(offset, len(records)):]
+	return records[:min(limit, len(records))]
+}
+
+func TestListSlashings(t *testing.T) {
+	detectedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	attesterSlashing := &qrysmpb.AttesterSlashing{
+		Attestation_1: util.HydrateIndexedAttestation(&qrysmpb.IndexedAttestation{AttestingIndices: []uint64{1, 2}}),
+		Attestation_2: util.HydrateIndexedAttestation(&qrysmpb.IndexedAttestation{AttestingIndices: []uint64{2}}),
+	}
+	attesterSlashing.Attestation_2.Data.Target.Epoch = 4
+	proposerSlashing := &qrysmpb.ProposerSlashing{
+		Header_1: util.HydrateSignedBeaconHeader(&qrysmpb.SignedBeaconBlockHeader{}),
+		Header_2: util.HydrateSignedBeaconHeader(&qrysmpb.SignedBeaconBlockHeader{}),
+	}
+	proposerSlashing.Header_1.Header.ProposerIndex = 3
+	proposerSlashing.Header_2.Header.ProposerIndex = 3
+	proposerSlashing.Header_2.Header.StateRoot = bytes.Repeat([]byte{1}, 32)
+	fetcher := &mockSlashingRecordsFetcher{records: []*slashertypes.SlashingRecord{
+		{
+			ProposerSlashing:  proposerSlashing,
+			DetectedAt:        detectedAt,
+			Status:            slashertypes.SlashingIncluded,
+			IncludedSlot:      7,
+			IncludedBlockRoot: [32]byte{'a'},
+		},
+		{
+			AttesterSlashing: attesterSlashing,
+			DetectedAt:       detectedAt,
+			Status:           slashertypes.SlashingRejected,
+			RejectionReason:  "validator already slashed",
+		},
+	}}
+	s := &Server{SlashingRecordsFetcher: fetcher}
+
+	list := func(url string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ListSlashings(writer, request)
+		return writer
+	}
+
+	t.Run("epoch range", func(t *testing.T) {
+		writer := list("http://example.com/qrysm/slasher/slashings?start_epoch=2&end_epoch=5")
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, primitives.Epoch(2), fetcher.startEpoch)
+		assert.Equal(t, primitives.Epoch(5), fetcher.endEpoch)
+		resp := &SlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "", resp.NextPageToken)
+
+		proposer := resp.Data[0]
+		assert.Equal(t, "proposer", proposer.Kind)
+		assert.Equal(t, "0", proposer.Epoch)
+		assert.DeepEqual(t, []string{"3"}, proposer.ValidatorIndices)
+		assert.Equal(t, "2024-01-02T03:04:05Z", proposer.DetectedAt)
+		assert.Equal(t, "included", proposer.Status)
+		assert.Equal(t, "7", proposer.IncludedSlot)
+		require.NotNil(t, proposer.ProposerSlashing)
+		assert.Equal(t, "3", proposer.ProposerSlashing.SignedHeader1.Message.ProposerIndex)
+		assert.Equal(t, true, proposer.AttesterSlashing == nil)
+
+		attester := resp.Data[1]
+		assert.Equal(t, "attester", attester.Kind)
+		assert.Equal(t, "4", attester.Epoch)
+		assert.DeepEqual(t, []string{"2"}, attester.ValidatorIndices)
+		assert.Equal(t, "rejected", attester.Status)
+		assert.Equal(t, "validator already slashed", attester.RejectionReason)
+		assert.Equal(t, "", attester.IncludedSlot)
+		require.NotNil(t, attester.AttesterSlashing)
+		assert.DeepEqual(t, []string{"1", "2"}, attester.AttesterSlashing.Attestation1.AttestingIndices)
+	})
+	t.Run("no end epoch", func(t *testing.T) {
+		writer := list("http://example.com/qrysm/slasher/slashings?start_epoch=2")
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, primitives.Epoch(math.MaxUint64), fetcher.endEpoch)
+	})
+	t.Run("pagination", func(t *testing.T) {
+		writer := list("http://example.com/qrysm/slasher/slashings?page_size=1")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &SlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "proposer", resp.Data[0].Kind)
+		assert.Equal(t, "1", resp.NextPageToken)
+
+		writer = list("http://example.com/qrysm/slasher/slashings?page_size=1&page_token=1")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp = &SlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "attester", resp.Data[0].Kind)
+		assert.Equal(t, "", resp.NextPageToken)
+
+		writer = list(fmt.Sprintf("http://example.com/qrysm/slasher/slashings?page_size=%d", cmd.Get().MaxRPCPageSize+1))
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("invalid range", func(t *testing.T) {
+		writer := list("http://example.com/qrysm/slasher/slashings?start_epoch=5&end_epoch=2")
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		writer = list("http://example.com/qrysm/slasher/slashings?start_epoch=foo")
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("slasher disabled", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/slasher/slashings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		(&Server{}).ListSlashings(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
+
+func TestListValidatorSlashings(t *testing.T) {
+	proposerSlashing := &qrysmpb.ProposerSlashing{
+		Header_1: util.HydrateSignedBeaconHeader(&qrysmpb.SignedBeaconBlockHeader{}),
+		Header_2: util.HydrateSignedBeaconHeader(&qrysmpb.SignedBeaconBlockHeader{}),
+	}
+	proposerSlashing.Header_1.Header.ProposerIndex = 3
+	proposerSlashing.Header_2.Header.ProposerIndex = 3
+	s := &Server{SlashingRecordsFetcher: &mockSlashingRecordsFetcher{records: []*slashertypes.SlashingRecord{
+		{ProposerSlashing: proposerSlashing, Status: slashertypes.SlashingPooled},
+	}}}
+
+	list := func(idx string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/slasher/validators/"+idx+"/slashings", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": idx})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ListValidatorSlashings(writer, request)
+		return writer
+	}
+
+	writer := list("3")
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &SlashingsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, "pooled", resp.Data[0].Status)
+
+	writer = list("4")
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp = &SlashingsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, 0, len(resp.Data))
+
+	writer = list("foo")
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+}
@@ -0,0 +1,9 @@
+package slasher
+
+import (
+	slasherservice "github.com/theQRL/qrysm/beacon-chain/slasher"
+)
+
+type Server struct {
+	SlashingRecordsFetcher slasherservice.SlashingRecordsFetcher
+}
@@ -0,0 +1,24 @@
+package slasher
+
+import (
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/shared"
+)
+
+type SlashingsResponse struct {
+	Data          []*Slashing `json:"data"`
+	NextPageToken string      `json:"next_page_token"`
+}
+
+type Slashing struct {
+	Root              string                   `json:"root"`
+	Kind              string                   `json:"kind"`
+	Epoch             string                   `json:"epoch"`
+	ValidatorIndices  []string                 `json:"validator_indices"`
+	DetectedAt        string                   `json:"detected_at"`
+	Status            string                   `json:"status"`
+	RejectionReason   string                   `json:"rejection_reason,omitempty"`
+	IncludedSlot      string                   `json:"included_slot,omitempty"`
+	IncludedBlockRoot string                   `json:"included_block_root,omitempty"`
+	AttesterSlashing  *shared.AttesterSlashing `json:"attester_slashing,omitempty"`
+	ProposerSlashing  *shared.ProposerSlashing `json:"proposer_slashing,omitempty"`
+}
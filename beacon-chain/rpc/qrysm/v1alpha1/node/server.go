@@ -226,9 +226,17 @@ func (ns *Server) GetExecutionConnectionStatus(_ context.Context, _ *emptypb.Emp
 	if err != nil {
 		currErr = err.Error()
 	}
+	errs := ns.ExecutionChainInfoFetcher.ExecutionConnectionErrors()
+	connErrs := make([]string, len(errs))
+	for i, err := range errs {
+		if err != nil {
+			connErrs[i] = err.Error()
+		}
+	}
 	return &qrysmpb.ExecutionConnectionStatus{
 		CurrentAddress:         ns.ExecutionChainInfoFetcher.ExecutionClientEndpoint(),
 		CurrentConnectionError: currErr,
-		Addresses:              []string{ns.ExecutionChainInfoFetcher.ExecutionClientEndpoint()},
+		Addresses:              ns.ExecutionChainInfoFetcher.ExecutionEndpoints(),
+		ConnectionErrors:       connErrs,
 	}, nil
 }
@@ -170,4 +170,13 @@ func TestNodeServer_GetExecutionConnectionStatus(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, ep, res.CurrentAddress)
 	assert.Equal(t, errStr, res.CurrentConnectionError)
+	assert.DeepEqual(t, []string{ep}, res.Addresses)
+	assert.DeepEqual(t, []string{errStr}, res.ConnectionErrors)
+
+	mockFetcher.Endpoints = []string{ep, "bar"}
+	mockFetcher.Errors = []error{err, nil}
+	res, err = ns.GetExecutionConnectionStatus(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{ep, "bar"}, res.Addresses)
+	assert.DeepEqual(t, []string{errStr, ""}, res.ConnectionErrors)
 }
@@ -2,10 +2,14 @@ package validator
 
 import (
 	"bytes"
+	"context"
 	"sort"
 	"testing"
 
 	"github.com/theQRL/go-bitfield"
+	"github.com/theQRL/qrysm/beacon-chain/core/helpers"
+	field_params "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/config/params"
 	"github.com/theQRL/qrysm/consensus-types/primitives"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 	"github.com/theQRL/qrysm/testing/assert"
@@ -423,3 +427,108 @@ func TestProposer_ProposerAtts_dedup(t *testing.T) {
 		})
 	}
 }
+
+func TestProposer_ProposerAtts_packBySize(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	// Two committees of 8 validators per slot.
+	cfg.TargetCommitteeSize = 8
+	params.OverrideBeaconConfig(cfg)
+	helpers.ClearCache()
+	validatorCount := 2 * cfg.TargetCommitteeSize * uint64(cfg.SlotsPerEpoch)
+	validators := make([]*qrysmpb.Validator, validatorCount)
+	for i := range validators {
+		validators[i] = &qrysmpb.Validator{
+			PublicKey:         make([]byte, field_params.MLDSA87PubkeyLength),
+			EffectiveBalance:  cfg.MaxEffectiveBalance,
+			ExitEpoch:         cfg.FarFutureEpoch,
+			WithdrawableEpoch: cfg.FarFutureEpoch,
+		}
+	}
+	st, err := util.NewBeaconStateZond(func(state *qrysmpb.BeaconStateZond) error {
+		state.Slot = 3
+		state.Validators = validators
+		state.Balances = make([]uint64, validatorCount)
+		state.InactivityScores = make([]uint64, validatorCount)
+		state.PreviousEpochParticipation = make([]byte, validatorCount)
+		state.CurrentEpochParticipation = make([]byte, validatorCount)
+		return nil
+	})
+	require.NoError(t, err)
+
+	att := func(slot primitives.Slot, committee primitives.CommitteeIndex, bits bitfield.Bitlist) *qrysmpb.Attestation {
+		a := util.HydrateAttestation(&qrysmpb.Attestation{
+			Data:            &qrysmpb.AttestationData{Slot: slot, CommitteeIndex: committee},
+			AggregationBits: bits,
+		})
+		a.Signatures = make([][]byte, bits.Count())
+		for i := range a.Signatures {
+			a.Signatures[i] = make([]byte, 4627)
+		}
+		return a
+	}
+	size := func(atts ...*qrysmpb.Attestation) uint64 {
+		var s uint64
+		for _, a := range atts {
+			s += uint64(a.SizeSSZ()) + attestationOffsetSize
+		}
+		return s
+	}
+
+	t.Run("within byte budget", func(t *testing.T) {
+		a := att(2, 0, bitfield.Bitlist{0b00001111, 0b1})
+		overlapping := att(2, 0, bitfield.Bitlist{0b00111100, 0b1})
+		b := att(2, 1, bitfield.Bitlist{0b00000011, 0b1})
+
+		// The overlapping attestation adds only two validators for four signatures, so it comes last.
+		packed, err := proposerAtts{a, overlapping, b}.packBySize(ctx, st, 0)
+		require.NoError(t, err)
+		require.DeepEqual(t, proposerAtts{a, b, overlapping}, packed)
+
+		packed, err = proposerAtts{a, overlapping, b}.packBySize(ctx, st, size(a, b))
+		require.NoError(t, err)
+		require.DeepEqual(t, proposerAtts{a, b}, packed)
+
+		packed, err = proposerAtts{a, overlapping, b}.packBySize(ctx, st, size(a))
+		require.NoError(t, err)
+		require.DeepEqual(t, proposerAtts{a}, packed)
+	})
+
+	t.Run("weighted by inclusion delay", func(t *testing.T) {
+		// Only the attestation included after one slot earns the head reward.
+		late := att(1, 0, bitfield.Bitlist{0b00000011, 0b1})
+		timely := att(2, 0, bitfield.Bitlist{0b00000011, 0b1})
+		packed, err := proposerAtts{late, timely}.packBySize(ctx, st, 0)
+		require.NoError(t, err)
+		require.DeepEqual(t, proposerAtts{timely, late}, packed)
+	})
+
+	t.Run("participation already credited", func(t *testing.T) {
+		a := att(2, 0, bitfield.Bitlist{0b00001111, 0b1})
+		overlapping := att(2, 0, bitfield.Bitlist{0b00111100, 0b1})
+		b := att(2, 1, bitfield.Bitlist{0b00000011, 0b1})
+
+		// The validators of a already have every flag, so a earns nothing and overlapping only earns for two
+		// validators.
+		committee, err := helpers.BeaconCommitteeFromState(ctx, st, 2, 0)
+		require.NoError(t, err)
+		participation := make([]byte, validatorCount)
+		for _, idx := range committee[:4] {
+			participation[idx] = 0b111
+		}
+		credited := st.Copy()
+		require.NoError(t, credited.SetCurrentParticipationBits(participation))
+		packed, err := proposerAtts{a, overlapping, b}.packBySize(ctx, credited, 0)
+		require.NoError(t, err)
+		require.DeepEqual(t, proposerAtts{b, overlapping}, packed)
+	})
+
+	t.Run("no reward", func(t *testing.T) {
+		wrongSource := att(2, 0, bitfield.Bitlist{0b00000011, 0b1})
+		wrongSource.Data.Source.Root = bytes.Repeat([]byte{1}, 32)
+		packed, err := proposerAtts{wrongSource}.packBySize(ctx, st, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(packed))
+	})
+}
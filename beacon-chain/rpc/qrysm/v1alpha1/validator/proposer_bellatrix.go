@@ -13,7 +13,6 @@ import (
 	"github.com/sirupsen/logrus"
 	qrlparams "github.com/theQRL/go-qrl/params"
 	"github.com/theQRL/qrysm/api/client/builder"
-	"github.com/theQRL/qrysm/beacon-chain/core/signing"
 	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
 	"github.com/theQRL/qrysm/config/params"
 	"github.com/theQRL/qrysm/consensus-types/interfaces"
@@ -133,13 +132,6 @@ func (vs *Server) getPayloadHeaderFromBuilder(
 	ctx, cancel := context.WithTimeout(ctx, blockBuilderTimeout)
 	defer cancel()
 
-	signedBid, err := vs.BlockBuilder.GetHeader(ctx, slot, bytesutil.ToBytes32(h.BlockHash()), pk)
-	if err != nil {
-		return nil, err
-	}
-	if signedBid == nil || signedBid.IsNil() {
-		return nil, errors.New("builder returned nil bid")
-	}
 	fork, err := forks.Fork(slots.ToEpoch(slot))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get fork information")
@@ -148,60 +140,79 @@ func (vs *Server) getPayloadHeaderFromBuilder(
 	if !ok {
 		return nil, errors.New("unable to find current fork in schedule")
 	}
-	if !strings.EqualFold(version.String(signedBid.Version()), forkName) {
-		return nil, fmt.Errorf("builder bid response version: %d is different from head block version: %d for epoch %d", signedBid.Version(), b.Version(), slots.ToEpoch(slot))
-	}
-
-	bid, err := signedBid.Message()
+	var gasLimit uint64
+	reg, err := vs.BlockBuilder.RegistrationByValidatorID(ctx, idx)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get bid")
+		log.WithError(err).Warn("Proposer: failed to get registration by validator ID, could not check gas limit")
+	} else {
+		gasLimit = expectedGasLimit(parentGasLimit, reg.GasLimit)
 	}
-	if bid == nil || bid.IsNil() {
-		return nil, errors.New("builder returned nil bid")
+	t, err := slots.ToTime(uint64(vs.TimeFetcher.GenesisTime().Unix()), slot)
+	if err != nil {
+		return nil, err
 	}
 
-	v := bytesutil.LittleEndianBytesToBigInt(bid.Value())
-	if v.String() == "0" {
-		return nil, errors.New("builder returned header with 0 bid amount")
-	}
+	// The bid of every relay is validated, so that an invalid bid does not prevent choosing a valid one.
+	validate := func(signedBid builder.SignedBid) error {
+		if signedBid == nil || signedBid.IsNil() {
+			return errors.New("builder returned nil bid")
+		}
+		if !strings.EqualFold(version.String(signedBid.Version()), forkName) {
+			return fmt.Errorf("builder bid response version: %d is different from head block version: %d for epoch %d", signedBid.Version(), b.Version(), slots.ToEpoch(slot))
+		}
 
-	header, err := bid.Header()
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get bid header")
-	}
-	txRoot, err := header.TransactionsRoot()
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get transaction root")
-	}
-	if bytesutil.ToBytes32(txRoot) == emptyTransactionsRoot {
-		return nil, errors.New("builder returned header with an empty tx root")
-	}
+		bid, err := signedBid.Message()
+		if err != nil {
+			return errors.Wrap(err, "could not get bid")
+		}
+		if bid == nil || bid.IsNil() {
+			return errors.New("builder returned nil bid")
+		}
 
-	if !bytes.Equal(header.ParentHash(), h.BlockHash()) {
-		return nil, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), h.BlockHash())
-	}
+		v := bytesutil.LittleEndianBytesToBigInt(bid.Value())
+		if v.String() == "0" {
+			return errors.New("builder returned header with 0 bid amount")
+		}
 
-	reg, err := vs.BlockBuilder.RegistrationByValidatorID(ctx, idx)
-	if err != nil {
-		log.WithError(err).Warn("Proposer: failed to get registration by validator ID, could not check gas limit")
-	} else {
-		gasLimit := expectedGasLimit(parentGasLimit, reg.GasLimit)
-		if gasLimit != header.GasLimit() {
-			return nil, fmt.Errorf("incorrect header gas limit %d != %d", gasLimit, header.GasLimit())
+		header, err := bid.Header()
+		if err != nil {
+			return errors.Wrap(err, "could not get bid header")
+		}
+		txRoot, err := header.TransactionsRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not get transaction root")
 		}
+		if bytesutil.ToBytes32(txRoot) == emptyTransactionsRoot {
+			return errors.New("builder returned header with an empty tx root")
+		}
+
+		if !bytes.Equal(header.ParentHash(), h.BlockHash()) {
+			return fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), h.BlockHash())
+		}
+
+		if reg != nil && gasLimit != header.GasLimit() {
+			return fmt.Errorf("incorrect header gas limit %d != %d", gasLimit, header.GasLimit())
+		}
+
+		if header.Timestamp() != uint64(t.Unix()) {
+			return fmt.Errorf("incorrect timestamp %d != %d", header.Timestamp(), uint64(t.Unix()))
+		}
+		return nil
 	}
 
-	t, err := slots.ToTime(uint64(vs.TimeFetcher.GenesisTime().Unix()), slot)
+	signedBid, err := vs.BlockBuilder.GetHeader(ctx, slot, bytesutil.ToBytes32(h.BlockHash()), pk, validate)
 	if err != nil {
 		return nil, err
 	}
-	if header.Timestamp() != uint64(t.Unix()) {
-		return nil, fmt.Errorf("incorrect timestamp %d != %d", header.Timestamp(), uint64(t.Unix()))
+	bid, err := signedBid.Message()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid")
 	}
-
-	if err := validateBuilderSignature(signedBid); err != nil {
-		return nil, errors.Wrap(err, "could not validate builder signature")
+	header, err := bid.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid header")
 	}
+	v := bytesutil.LittleEndianBytesToBigInt(bid.Value())
 
 	log.WithFields(logrus.Fields{
 		"value":              v.String(),
@@ -221,27 +232,6 @@ func (vs *Server) getPayloadHeaderFromBuilder(
 	return header, nil
 }
 
-// Validates builder signature and returns an error if the signature is invalid.
-func validateBuilderSignature(signedBid builder.SignedBid) error {
-	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
-		nil, /* fork version */
-		nil /* genesis val root */)
-	if err != nil {
-		return err
-	}
-	if signedBid == nil || signedBid.IsNil() {
-		return errors.New("nil builder bid")
-	}
-	bid, err := signedBid.Message()
-	if err != nil {
-		return errors.Wrap(err, "could not get bid")
-	}
-	if bid == nil || bid.IsNil() {
-		return errors.New("builder returned nil bid")
-	}
-	return signing.VerifySigningRoot(bid, bid.Pubkey(), signedBid.Signature(), d)
-}
-
 func matchingWithdrawalsRoot(local, builder interfaces.ExecutionData) (bool, error) {
 	wds, err := local.Withdrawals()
 	if err != nil {
@@ -498,45 +498,6 @@ func TestExpectedGasLimit(t *testing.T) {
 	}
 }
 
-func TestServer_validateBuilderSignature(t *testing.T) {
-	sk, err := ml_dsa_87.RandKey()
-	require.NoError(t, err)
-	bid := &qrysmpb.BuilderBidZond{
-		Header: &v1.ExecutionPayloadHeaderZond{
-			ParentHash:       make([]byte, fieldparams.RootLength),
-			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
-			StateRoot:        make([]byte, fieldparams.RootLength),
-			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
-			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
-			PrevRandao:       make([]byte, fieldparams.RootLength),
-			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
-			BlockHash:        make([]byte, fieldparams.RootLength),
-			TransactionsRoot: make([]byte, fieldparams.RootLength),
-			BlockNumber:      1,
-			WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
-		},
-		Pubkey: sk.PublicKey().Marshal(),
-		Value:  bytesutil.PadTo([]byte{1, 2, 3}, 32),
-	}
-	d := params.BeaconConfig().DomainApplicationBuilder
-	domain, err := signing.ComputeDomain(d, nil, nil)
-	require.NoError(t, err)
-	sr, err := signing.ComputeSigningRoot(bid, domain)
-	require.NoError(t, err)
-	pbBid := &qrysmpb.SignedBuilderBidZond{
-		Message:   bid,
-		Signature: sk.Sign(sr[:]).Marshal(),
-	}
-	sBid, err := builder.WrappedSignedBuilderBidZond(pbBid)
-	require.NoError(t, err)
-	require.NoError(t, validateBuilderSignature(sBid))
-
-	pbBid.Message.Value = make([]byte, 32)
-	sBid, err = builder.WrappedSignedBuilderBidZond(pbBid)
-	require.NoError(t, err)
-	require.ErrorIs(t, validateBuilderSignature(sBid), signing.ErrSigFailedToVerify)
-}
-
 func Test_matchingWithdrawalsRoot(t *testing.T) {
 	t.Run("could not get local withdrawals", func(t *testing.T) {
 		local := &v1.ExecutionPayloadZond{}
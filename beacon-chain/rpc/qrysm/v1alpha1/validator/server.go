@@ -73,6 +73,7 @@ type Server struct {
 	BlockBuilder           builder.BlockBuilder
 	ClockWaiter            startup.ClockWaiter
 	CoreService            *core.Service
+	MaxAttestationBytes    uint64
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
@@ -0,0 +1,111 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/theQRL/qrysm/beacon-chain/monitor"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	http2 "github.com/theQRL/qrysm/network/http"
+)
+
+type DutyHistoryResponse struct {
+	Data []*ValidatorDutyHistory `json:"data"`
+}
+
+type ValidatorDutyHistory struct {
+	ValidatorIndex string         `json:"validator_index"`
+	Duties         []*DutyOutcome `json:"duties"`
+}
+
+type DutyOutcome struct {
+	Type          string                `json:"type"`
+	Epoch         string                `json:"epoch"`
+	Slot          string                `json:"slot,omitempty"`
+	Missed        bool                  `json:"missed"`
+	Balance       string                `json:"balance"`
+	Attestation   *AttestationOutcome   `json:"attestation,omitempty"`
+	SyncCommittee *SyncCommitteeOutcome `json:"sync_committee,omitempty"`
+}
+
+type AttestationOutcome struct {
+	TimelySource bool `json:"timely_source"`
+	TimelyTarget bool `json:"timely_target"`
+	TimelyHead   bool `json:"timely_head"`
+}
+
+type SyncCommitteeOutcome struct {
+	Contributions         string `json:"contributions"`
+	ExpectedContributions string `json:"expected_contributions"`
+}
+
+// GetDutyHistory is an HTTP handler that serves the GET /qrysm/validators/duty_history endpoint.
+// It returns the recent duty outcomes that the validator monitor recorded for the validators given
+// by the index query parameter, or for all tracked validators when no index is given. Outcomes are
+// ordered from oldest to newest.
+//
+// Example usage:
+//
+//	GET /qrysm/validators/duty_history?index=1&index=2
+func (vs *Server) GetDutyHistory(w http.ResponseWriter, r *http.Request) {
+	if vs.DutyHistoryFetcher == nil {
+		http2.HandleError(w, "Validator monitor is not enabled, use --monitor-indices to track validators", http.StatusNotFound)
+		return
+	}
+	var indices []primitives.ValidatorIndex
+	for _, rawIdx := range r.URL.Query()["index"] {
+		idx, err := strconv.ParseUint(rawIdx, 10, 64)
+		if err != nil {
+			http2.HandleError(w, "Invalid validator index: "+rawIdx, http.StatusBadRequest)
+			return
+		}
+		indices = append(indices, primitives.ValidatorIndex(idx))
+	}
+	if len(indices) == 0 {
+		indices = vs.DutyHistoryFetcher.TrackedValidatorIndices()
+	}
+
+	resp := &DutyHistoryResponse{Data: make([]*ValidatorDutyHistory, 0, len(indices))}
+	for _, idx := range indices {
+		outcomes, ok := vs.DutyHistoryFetcher.DutyHistory(idx)
+		if !ok {
+			http2.HandleError(w, fmt.Sprintf("Validator %d is not tracked by the validator monitor", idx), http.StatusNotFound)
+			return
+		}
+		h := &ValidatorDutyHistory{
+			ValidatorIndex: strconv.FormatUint(uint64(idx), 10),
+			Duties:         make([]*DutyOutcome, len(outcomes)),
+		}
+		for i, o := range outcomes {
+			h.Duties[i] = dutyOutcomeToJson(o)
+		}
+		resp.Data = append(resp.Data, h)
+	}
+	http2.WriteJson(w, resp)
+}
+
+func dutyOutcomeToJson(o monitor.DutyOutcome) *DutyOutcome {
+	d := &DutyOutcome{
+		Type:    string(o.Type),
+		Epoch:   strconv.FormatUint(uint64(o.Epoch), 10),
+		Missed:  o.Missed,
+		Balance: strconv.FormatUint(o.Balance, 10),
+	}
+	switch o.Type {
+	case monitor.ProposalDuty, monitor.Slashing:
+		d.Slot = strconv.FormatUint(uint64(o.Slot), 10)
+	case monitor.AttestationDuty:
+		d.Attestation = &AttestationOutcome{
+			TimelySource: o.TimelySource,
+			TimelyTarget: o.TimelyTarget,
+			TimelyHead:   o.TimelyHead,
+		}
+	case monitor.SyncCommitteeDuty:
+		d.SyncCommittee = &SyncCommitteeOutcome{
+			Contributions:         strconv.FormatUint(o.Contributions, 10),
+			ExpectedContributions: strconv.FormatUint(o.ExpectedContributions, 10),
+		}
+	}
+	return d
+}
@@ -0,0 +1,93 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/theQRL/qrysm/beacon-chain/monitor"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	http2 "github.com/theQRL/qrysm/network/http"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+type mockDutyHistoryFetcher struct {
+	history map[primitives.ValidatorIndex][]monitor.DutyOutcome
+}
+
+func (m *mockDutyHistoryFetcher) TrackedValidatorIndices() []primitives.ValidatorIndex {
+	return []primitives.ValidatorIndex{1, 2}
+}
+
+func (m *mockDutyHistoryFetcher) DutyHistory(idx primitives.ValidatorIndex) ([]monitor.DutyOutcome, bool) {
+	h, ok := m.history[idx]
+	return h, ok
+}
+
+func TestGetDutyHistory(t *testing.T) {
+	fetcher := &mockDutyHistoryFetcher{history: map[primitives.ValidatorIndex][]monitor.DutyOutcome{
+		1: {
+			{Type: monitor.AttestationDuty, Epoch: 3, TimelySource: true, TimelyTarget: true, Balance: 40},
+			{Type: monitor.ProposalDuty, Epoch: 4, Slot: 130, Missed: true, Balance: 41},
+		},
+		2: {
+			{Type: monitor.SyncCommitteeDuty, Epoch: 4, Contributions: 3, ExpectedContributions: 4, Balance: 40},
+		},
+	}}
+	s := &Server{DutyHistoryFetcher: fetcher}
+
+	request := func(url string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.GetDutyHistory(w, r)
+		return w
+	}
+
+	t.Run("all tracked validators", func(t *testing.T) {
+		w := request("http://example.com/qrysm/validators/duty_history")
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &DutyHistoryResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		require.Equal(t, "1", resp.Data[0].ValidatorIndex)
+		require.Equal(t, 2, len(resp.Data[0].Duties))
+		att := resp.Data[0].Duties[0]
+		require.Equal(t, "attestation", att.Type)
+		require.Equal(t, "", att.Slot)
+		require.DeepEqual(t, &AttestationOutcome{TimelySource: true, TimelyTarget: true}, att.Attestation)
+		proposal := resp.Data[0].Duties[1]
+		require.Equal(t, "130", proposal.Slot)
+		require.Equal(t, true, proposal.Missed)
+		require.DeepEqual(t, &SyncCommitteeOutcome{Contributions: "3", ExpectedContributions: "4"}, resp.Data[1].Duties[0].SyncCommittee)
+	})
+	t.Run("requested validator", func(t *testing.T) {
+		w := request("http://example.com/qrysm/validators/duty_history?index=2")
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &DutyHistoryResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		require.Equal(t, "2", resp.Data[0].ValidatorIndex)
+	})
+	t.Run("untracked validator", func(t *testing.T) {
+		w := request("http://example.com/qrysm/validators/duty_history?index=3")
+		require.Equal(t, http.StatusNotFound, w.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), e))
+		require.Equal(t, "Validator 3 is not tracked by the validator monitor", e.Message)
+	})
+	t.Run("invalid index", func(t *testing.T) {
+		w := request("http://example.com/qrysm/validators/duty_history?index=foo")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("monitor disabled", func(t *testing.T) {
+		s := &Server{}
+		r := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/validators/duty_history", nil)
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.GetDutyHistory(w, r)
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -3,6 +3,7 @@ package validator
 import (
 	"github.com/theQRL/qrysm/beacon-chain/blockchain"
 	"github.com/theQRL/qrysm/beacon-chain/db"
+	"github.com/theQRL/qrysm/beacon-chain/monitor"
 	"github.com/theQRL/qrysm/beacon-chain/rpc/core"
 	"github.com/theQRL/qrysm/beacon-chain/rpc/lookup"
 	"github.com/theQRL/qrysm/beacon-chain/sync"
@@ -20,4 +21,5 @@ type Server struct {
 	ChainInfoFetcher      blockchain.ChainInfoFetcher
 	BeaconDB              db.ReadOnlyDatabase
 	FinalizationFetcher   blockchain.FinalizationFetcher
+	DutyHistoryFetcher    monitor.DutyHistoryFetcher
 }
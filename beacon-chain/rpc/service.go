@@ -25,6 +25,7 @@ import (
 	statefeed "github.com/theQRL/qrysm/beacon-chain/core/feed/state"
 	"github.com/theQRL/qrysm/beacon-chain/db"
 	"github.com/theQRL/qrysm/beacon-chain/execution"
+	"github.com/theQRL/qrysm/beacon-chain/monitor"
 	"github.com/theQRL/qrysm/beacon-chain/operations/attestations"
 	"github.com/theQRL/qrysm/beacon-chain/operations/slashings"
 	"github.com/theQRL/qrysm/beacon-chain/operations/synccommittee"
@@ -36,10 +37,13 @@ import (
 	rpcBuilder "github.com/theQRL/qrysm/beacon-chain/rpc/qrl/builder"
 	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/debug"
 	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/events"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/lightclient"
 	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/node"
 	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/rewards"
 	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/validator"
+	debugqrysm "github.com/theQRL/qrysm/beacon-chain/rpc/qrysm/debug"
 	nodeqrysm "github.com/theQRL/qrysm/beacon-chain/rpc/qrysm/node"
+	slasherqrysm "github.com/theQRL/qrysm/beacon-chain/rpc/qrysm/slasher"
 	beaconv1alpha1 "github.com/theQRL/qrysm/beacon-chain/rpc/qrysm/v1alpha1/beacon"
 	debugv1alpha1 "github.com/theQRL/qrysm/beacon-chain/rpc/qrysm/v1alpha1/debug"
 	nodev1alpha1 "github.com/theQRL/qrysm/beacon-chain/rpc/qrysm/v1alpha1/node"
@@ -49,8 +53,10 @@ import (
 	"github.com/theQRL/qrysm/beacon-chain/startup"
 	"github.com/theQRL/qrysm/beacon-chain/state/stategen"
 	chainSync "github.com/theQRL/qrysm/beacon-chain/sync"
+	"github.com/theQRL/qrysm/beacon-chain/sync/backfill"
 	"github.com/theQRL/qrysm/config/features"
 	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
 	"github.com/theQRL/qrysm/io/logs"
 	"github.com/theQRL/qrysm/monitoring/tracing"
 	qrlpbservice "github.com/theQRL/qrysm/proto/qrl/service"
@@ -106,6 +112,7 @@ type Config struct {
 	ExitPool                      voluntaryexits.PoolManager
 	SlashingsPool                 slashings.PoolManager
 	SlashingChecker               slasherservice.SlashingChecker
+	SlashingRecordsFetcher        slasherservice.SlashingRecordsFetcher
 	SyncCommitteeObjectPool       synccommittee.Pool
 	SyncService                   chainSync.Checker
 	Broadcaster                   p2p.Broadcaster
@@ -119,12 +126,19 @@ type Config struct {
 	OperationNotifier             opfeed.Notifier
 	StateGen                      *stategen.State
 	MaxMsgSize                    int
+	MaxAttestationBytes           uint64
 	ExecutionEngineCaller         execution.EngineCaller
 	ProposerIdsCache              *cache.ProposerPayloadIDsCache
 	OptimisticModeFetcher         blockchain.OptimisticModeFetcher
 	BlockBuilder                  builder.BlockBuilder
 	Router                        *mux.Router
 	ClockWaiter                   startup.ClockWaiter
+	BackfillStatus                backfill.StatusFetcher
+	LightClientUpdateFetcher      blockchain.LightClientUpdateFetcher
+	DutyHistoryFetcher            monitor.DutyHistoryFetcher
+	HistoricalReplayBudget        primitives.Slot
+	HistoricalReplayWorkers       int
+	HistoricalStateCacheSize      int
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -193,16 +207,27 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 	}
 	withCache := stategen.WithCache(stateCache)
 	ch := stategen.NewCanonicalHistory(s.cfg.BeaconDB, s.cfg.ChainInfoFetcher, s.cfg.ChainInfoFetcher, withCache)
+	historicalStates := stategen.NewHistoricalStates(
+		s.cfg.BeaconDB,
+		s.cfg.ChainInfoFetcher,
+		s.cfg.ChainInfoFetcher,
+		stategen.WithStateCache(stateCache),
+		stategen.WithReplayBudget(s.cfg.HistoricalReplayBudget),
+		stategen.WithReplayWorkers(s.cfg.HistoricalReplayWorkers),
+		stategen.WithBoundaryCacheSize(s.cfg.HistoricalStateCacheSize),
+	)
 	stater := &lookup.BeaconDbStater{
 		BeaconDB:           s.cfg.BeaconDB,
 		ChainInfoFetcher:   s.cfg.ChainInfoFetcher,
 		GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
 		StateGenService:    s.cfg.StateGen,
 		ReplayerBuilder:    ch,
+		HistoricalStates:   historicalStates,
 	}
 	blocker := &lookup.BeaconDbBlocker{
 		BeaconDB:         s.cfg.BeaconDB,
 		ChainInfoFetcher: s.cfg.ChainInfoFetcher,
+		AvailableBlocker: s.cfg.BackfillStatus,
 	}
 
 	rewardsServer := &rewards.Server{
@@ -218,6 +243,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 	s.cfg.Router.HandleFunc("/qrl/v1/beacon/rewards/blocks/{block_id}", rewardsServer.BlockRewards).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/qrl/v1/beacon/rewards/attestations/{epoch}", rewardsServer.AttestationRewards).Methods(http.MethodPost)
 	s.cfg.Router.HandleFunc("/qrl/v1/beacon/rewards/sync_committee/{block_id}", rewardsServer.SyncCommitteeRewards).Methods(http.MethodPost)
+	s.cfg.Router.HandleFunc("/qrysm/validators/rewards_history", rewardsServer.RewardsHistory).Methods(http.MethodPost)
 
 	builderServer := &rpcBuilder.Server{
 		FinalizationFetcher:   s.cfg.FinalizationFetcher,
@@ -226,6 +252,19 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 	}
 	s.cfg.Router.HandleFunc("/qrl/v1/builder/states/{state_id}/expected_withdrawals", builderServer.ExpectedWithdrawals).Methods(http.MethodGet)
 
+	if features.Get().EnableLightClient {
+		lightClientServer := &lightclient.Server{
+			BeaconDB:                 s.cfg.BeaconDB,
+			StateGen:                 s.cfg.StateGen,
+			GenesisFetcher:           s.cfg.GenesisFetcher,
+			LightClientUpdateFetcher: s.cfg.LightClientUpdateFetcher,
+		}
+		s.cfg.Router.HandleFunc("/qrl/v1/beacon/light_client/bootstrap/{block_root}", lightClientServer.GetLightClientBootstrap).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/qrl/v1/beacon/light_client/updates", lightClientServer.GetLightClientUpdatesByRange).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/qrl/v1/beacon/light_client/finality_update", lightClientServer.GetLightClientFinalityUpdate).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/qrl/v1/beacon/light_client/optimistic_update", lightClientServer.GetLightClientOptimisticUpdate).Methods(http.MethodGet)
+	}
+
 	coreService := &core.Service{
 		HeadFetcher:           s.cfg.HeadFetcher,
 		GenesisTimeFetcher:    s.cfg.GenesisTimeFetcher,
@@ -273,6 +312,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		BlockBuilder:           s.cfg.BlockBuilder,
 		ClockWaiter:            s.cfg.ClockWaiter,
 		CoreService:            coreService,
+		MaxAttestationBytes:    s.cfg.MaxAttestationBytes,
 	}
 	validatorServerV1 := &validator.Server{
 		HeadFetcher:            s.cfg.HeadFetcher,
@@ -333,6 +373,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		MetadataProvider:          s.cfg.MetadataProvider,
 		HeadFetcher:               s.cfg.HeadFetcher,
 		ExecutionChainInfoFetcher: s.cfg.ExecutionChainInfoFetcher,
+		BackfillStatus:            s.cfg.BackfillStatus,
 	}
 
 	s.cfg.Router.HandleFunc("/qrl/v1/node/syncing", nodeServerQRL.GetSyncStatus).Methods(http.MethodGet)
@@ -352,6 +393,26 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 	s.cfg.Router.HandleFunc("/qrysm/node/trusted_peers", nodeServerQrysm.ListTrustedPeer).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/qrysm/node/trusted_peers", nodeServerQrysm.AddTrustedPeer).Methods(http.MethodPost)
 	s.cfg.Router.HandleFunc("/qrysm/node/trusted_peers/{peer_id}", nodeServerQrysm.RemoveTrustedPeer).Methods(http.MethodDelete)
+	s.cfg.Router.HandleFunc("/qrysm/node/peers/scores", nodeServerQrysm.ListPeerScores).Methods(http.MethodGet)
+	s.cfg.Router.HandleFunc("/qrysm/node/peers/bans", nodeServerQrysm.ListBannedPeers).Methods(http.MethodGet)
+	s.cfg.Router.HandleFunc("/qrysm/node/peers/bans", nodeServerQrysm.BanPeer).Methods(http.MethodPost)
+	s.cfg.Router.HandleFunc("/qrysm/node/peers/bans/{peer_id}", nodeServerQrysm.UnbanPeer).Methods(http.MethodDelete)
+	s.cfg.Router.HandleFunc("/qrysm/node/peers/allowed", nodeServerQrysm.ListAllowedPeers).Methods(http.MethodGet)
+	s.cfg.Router.HandleFunc("/qrysm/node/peers/allowed", nodeServerQrysm.AddAllowedPeer).Methods(http.MethodPost)
+	s.cfg.Router.HandleFunc("/qrysm/node/peers/allowed/{peer_id}", nodeServerQrysm.RemoveAllowedPeer).Methods(http.MethodDelete)
+
+	debugServerQrysm := &debugqrysm.Server{
+		GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
+		HistoricalStates:   historicalStates,
+		Stater:             stater,
+	}
+	s.cfg.Router.HandleFunc("/qrysm/debug/states/{state_id}/regen_cost", debugServerQrysm.GetRegenCost).Methods(http.MethodGet)
+
+	slasherServerQrysm := &slasherqrysm.Server{
+		SlashingRecordsFetcher: s.cfg.SlashingRecordsFetcher,
+	}
+	s.cfg.Router.HandleFunc("/qrysm/slasher/slashings", slasherServerQrysm.ListSlashings).Methods(http.MethodGet)
+	s.cfg.Router.HandleFunc("/qrysm/slasher/validators/{validator_index}/slashings", slasherServerQrysm.ListValidatorSlashings).Methods(http.MethodGet)
 
 	beaconChainServer := &beaconv1alpha1.Server{
 		Ctx:                         s.ctx,
@@ -412,8 +473,10 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		ChainInfoFetcher:      s.cfg.ChainInfoFetcher,
 		BeaconDB:              s.cfg.BeaconDB,
 		FinalizationFetcher:   s.cfg.FinalizationFetcher,
+		DutyHistoryFetcher:    s.cfg.DutyHistoryFetcher,
 	}
 	s.cfg.Router.HandleFunc("/qrysm/validators/performance", httpServer.GetValidatorPerformance).Methods(http.MethodPost)
+	s.cfg.Router.HandleFunc("/qrysm/validators/duty_history", httpServer.GetDutyHistory).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/qrl/v1/beacon/states/{state_id}/validator_count", httpServer.GetValidatorCount).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/qrl/v1/beacon/states/{state_id}/committees", beaconChainServerV1.GetCommittees).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/qrl/v1/beacon/states/{state_id}/fork", beaconChainServerV1.GetStateFork).Methods(http.MethodGet)
@@ -429,6 +492,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 	s.cfg.Router.HandleFunc("/qrl/v1/beacon/headers/{block_id}", beaconChainServerV1.GetBlockHeader).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/qrl/v1/config/deposit_contract", beaconChainServerV1.GetDepositContract).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/qrl/v1/beacon/genesis", beaconChainServerV1.GetGenesis).Methods(http.MethodGet)
+	s.cfg.Router.HandleFunc("/qrl/v1/beacon/deposit_snapshot", beaconChainServerV1.GetDepositSnapshot).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/qrl/v1/beacon/states/{state_id}/finality_checkpoints", beaconChainServerV1.GetFinalityCheckpoints).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/qrl/v1/beacon/states/{state_id}/validators", beaconChainServerV1.GetValidators).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/qrl/v1/beacon/states/{state_id}/validators/{validator_id}", beaconChainServerV1.GetValidator).Methods(http.MethodGet)
@@ -467,6 +531,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 			FinalizationFetcher:   s.cfg.FinalizationFetcher,
 			ChainInfoFetcher:      s.cfg.ChainInfoFetcher,
 		}
+		s.cfg.Router.HandleFunc("/qrl/v1/debug/fork_choice", debugServerV1.GetForkChoiceDump).Methods(http.MethodGet)
 		qrysmpb.RegisterDebugServer(s.grpcServer, debugServer)
 		qrlpbservice.RegisterBeaconDebugServer(s.grpcServer, debugServerV1)
 	}
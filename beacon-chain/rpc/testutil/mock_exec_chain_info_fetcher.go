@@ -8,6 +8,8 @@ import (
 type MockExecutionChainInfoFetcher struct {
 	CurrEndpoint string
 	CurrError    error
+	Endpoints    []string
+	Errors       []error
 }
 
 func (*MockExecutionChainInfoFetcher) GenesisExecutionChainInfo() (uint64, *big.Int) {
@@ -25,3 +27,17 @@ func (m *MockExecutionChainInfoFetcher) ExecutionClientEndpoint() string {
 func (m *MockExecutionChainInfoFetcher) ExecutionClientConnectionErr() error {
 	return m.CurrError
 }
+
+func (m *MockExecutionChainInfoFetcher) ExecutionEndpoints() []string {
+	if m.Endpoints == nil {
+		return []string{m.CurrEndpoint}
+	}
+	return m.Endpoints
+}
+
+func (m *MockExecutionChainInfoFetcher) ExecutionConnectionErrors() []error {
+	if m.Errors == nil {
+		return []error{m.CurrError}
+	}
+	return m.Errors
+}
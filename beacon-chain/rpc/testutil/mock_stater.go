@@ -18,6 +18,7 @@ type MockStater struct {
 	StatesBySlot      map[primitives.Slot]state.BeaconState
 	StatesByEpoch     map[primitives.Epoch]state.BeaconState
 	StatesByRoot      map[[32]byte]state.BeaconState
+	StateSlots        map[string]primitives.Slot
 }
 
 // State --
@@ -58,3 +59,14 @@ func (m *MockStater) StateByEpoch(_ context.Context, e primitives.Epoch) (state.
 	}
 	return nil, nil
 }
+
+// StateSlot --
+func (m *MockStater) StateSlot(_ context.Context, id []byte) (primitives.Slot, error) {
+	if slot, ok := m.StateSlots[string(id)]; ok {
+		return slot, nil
+	}
+	if m.BeaconState != nil {
+		return m.BeaconState.Slot(), nil
+	}
+	return 0, nil
+}
@@ -0,0 +1,98 @@
+package slasher
+
+import (
+	"encoding/json"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-qrl/common/hexutil"
+	slashertypes "github.com/theQRL/qrysm/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/io/file"
+)
+
+// auditEntry is a line of the audit log, written each time a slashing is detected or the outcome of its
+// submission changes.
+type auditEntry struct {
+	Time              time.Time `json:"time"`
+	Event             string    `json:"event"`
+	Kind              string    `json:"kind"`
+	Root              string    `json:"root"`
+	Epoch             string    `json:"epoch"`
+	ValidatorIndices  []string  `json:"validator_indices"`
+	RejectionReason   string    `json:"rejection_reason,omitempty"`
+	IncludedSlot      string    `json:"included_slot,omitempty"`
+	IncludedBlockRoot string    `json:"included_block_root,omitempty"`
+}
+
+// auditLog is an append-only log of the slashings detected by slasher and of their submission, kept in a file
+// of JSON lines. A nil auditLog discards entries.
+type auditLog struct {
+	lock sync.Mutex
+	f    *os.File
+}
+
+func openAuditLog(path string) (*auditLog, error) {
+	expanded, err := file.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(expanded, os.O_CREATE|os.O_WRONLY|os.O_APPEND, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open slasher audit log")
+	}
+	return &auditLog{f: f}, nil
+}
+
+// write appends an entry recording the current status of the slashing. Entries are synced to disk, as they
+// are rare and must survive a crash of the node.
+func (a *auditLog) write(root [32]byte, record *slashertypes.SlashingRecord) error {
+	if a == nil {
+		return nil
+	}
+	entry := &auditEntry{
+		Time:            time.Now().UTC(),
+		Event:           string(record.Status),
+		Kind:            slashingKind(record),
+		Root:            hexutil.Encode(root[:]),
+		Epoch:           strconv.FormatUint(uint64(record.Epoch()), 10),
+		RejectionReason: record.RejectionReason,
+	}
+	for _, idx := range record.ValidatorIndices() {
+		entry.ValidatorIndices = append(entry.ValidatorIndices, strconv.FormatUint(uint64(idx), 10))
+	}
+	if record.Status == slashertypes.SlashingIncluded {
+		entry.IncludedSlot = strconv.FormatUint(uint64(record.IncludedSlot), 10)
+		entry.IncludedBlockRoot = hexutil.Encode(record.IncludedBlockRoot[:])
+	}
+	enc, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if _, err := a.f.Write(append(enc, '\n')); err != nil {
+		return errors.Wrap(err, "could not write to slasher audit log")
+	}
+	return a.f.Sync()
+}
+
+func (a *auditLog) close() error {
+	if a == nil {
+		return nil
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.f.Close()
+}
+
+func slashingKind(record *slashertypes.SlashingRecord) string {
+	if record.AttesterSlashing != nil {
+		return "attester"
+	}
+	return "proposer"
+}
@@ -0,0 +1,223 @@
+package slasher
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/beacon-chain/blockchain"
+	"github.com/theQRL/qrysm/beacon-chain/core/helpers"
+	"github.com/theQRL/qrysm/beacon-chain/core/transition"
+	"github.com/theQRL/qrysm/beacon-chain/db/filters"
+	slashertypes "github.com/theQRL/qrysm/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/proto/qrysm/v1alpha1/attestation"
+	"github.com/theQRL/qrysm/time/slots"
+)
+
+// Replays the attestations and proposals of the finalized blocks in the beacon node database through slashing
+// detection, so offenses in recent history are detected on a fresh start. The replay covers the last BackfillEpochs
+// epochs up to the finalized epoch and resumes after the last epoch it completed before a restart. Epochs which
+// cannot be replayed, for example because their attestation committees cannot be computed, are skipped and the saved
+// progress does not move past them, so they are replayed again on the next start.
+func (s *Service) backfill(ctx context.Context, finalizedEpoch primitives.Epoch) error {
+	if s.serviceCfg.BackfillEpochs == 0 || s.serviceCfg.BeaconDB == nil {
+		return nil
+	}
+	if s.serviceCfg.AttestationStateFetcher == nil && s.serviceCfg.StateGen == nil {
+		return nil
+	}
+	currentEpoch := slots.ToEpoch(slots.CurrentSlot(uint64(s.genesisTime.Unix())))
+	startEpoch := s.backfillStartEpoch(finalizedEpoch, currentEpoch)
+	lastEpoch, found, err := s.serviceCfg.Database.BackfilledEpoch(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get backfill progress")
+	}
+	if found && lastEpoch >= startEpoch {
+		startEpoch = lastEpoch + 1
+	}
+	if startEpoch > finalizedEpoch {
+		return nil
+	}
+
+	start := time.Now()
+	log.WithFields(logrus.Fields{
+		"startEpoch":     startEpoch,
+		"finalizedEpoch": finalizedEpoch,
+	}).Info("Backfilling slasher with finalized history")
+	skipped := false
+	for epoch := startEpoch; epoch <= finalizedEpoch; epoch++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := s.backfillEpoch(ctx, epoch, currentEpoch); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.WithError(err).WithField("epoch", epoch).Warn("Could not backfill epoch, skipping it")
+			skipped = true
+			continue
+		}
+		if skipped {
+			continue
+		}
+		if err := s.serviceCfg.Database.SaveBackfilledEpoch(ctx, epoch); err != nil {
+			return errors.Wrap(err, "could not save backfill progress")
+		}
+		backfilledEpoch.Set(float64(epoch))
+	}
+	log.WithField("elapsed", time.Since(start)).Info("Finished backfilling slasher with finalized history")
+	return nil
+}
+
+// Backfills the slasher in the background of the queue processing, until done or the service is stopped.
+func (s *Service) runBackfill(ctx context.Context, finalizedEpoch primitives.Epoch) {
+	defer s.wg.Done()
+	if err := s.backfill(ctx, finalizedEpoch); err != nil && !errors.Is(err, context.Canceled) {
+		log.WithError(err).Error("Could not backfill slasher with finalized history")
+	}
+}
+
+// Returns the first epoch to backfill, ignoring epochs whose attestations would be dropped as older than the
+// history kept by slasher.
+func (s *Service) backfillStartEpoch(finalizedEpoch, currentEpoch primitives.Epoch) primitives.Epoch {
+	var startEpoch primitives.Epoch
+	if finalizedEpoch > s.serviceCfg.BackfillEpochs {
+		startEpoch = finalizedEpoch - s.serviceCfg.BackfillEpochs
+	}
+	if currentEpoch >= s.params.historyLength && startEpoch < currentEpoch-s.params.historyLength+1 {
+		startEpoch = currentEpoch - s.params.historyLength + 1
+	}
+	return startEpoch
+}
+
+// Runs slashing detection on the attestations and proposals of the blocks of an epoch.
+func (s *Service) backfillEpoch(ctx context.Context, epoch, currentEpoch primitives.Epoch) error {
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return err
+	}
+	endSlot, err := slots.EpochEnd(epoch)
+	if err != nil {
+		return err
+	}
+	blks, _, err := s.serviceCfg.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+	if err != nil {
+		return errors.Wrap(err, "could not get blocks")
+	}
+
+	targetStates := make(map[[32]byte]state.ReadOnlyBeaconState)
+	atts := make([]*slashertypes.IndexedAttestationWrapper, 0)
+	headers := make([]*slashertypes.SignedBlockHeaderWrapper, 0, len(blks))
+	for _, blk := range blks {
+		if blk.Block().Slot() == 0 {
+			continue
+		}
+		header, err := blk.Header()
+		if err != nil {
+			return errors.Wrap(err, "could not get block header")
+		}
+		if validateBlockHeaderIntegrity(header) {
+			signingRoot, err := header.Header.HashTreeRoot()
+			if err != nil {
+				return errors.Wrap(err, "could not get hash tree root of block header")
+			}
+			headers = append(headers, &slashertypes.SignedBlockHeaderWrapper{
+				SignedBeaconBlockHeader: header,
+				SigningRoot:             signingRoot,
+			})
+		}
+
+		for _, att := range blk.Block().Body().Attestations() {
+			if att.Data == nil || att.Data.Target == nil {
+				continue
+			}
+			targetRoot := bytesutil.ToBytes32(att.Data.Target.Root)
+			targetState, ok := targetStates[targetRoot]
+			if !ok {
+				targetState, err = s.attestationTargetState(ctx, att.Data.Target)
+				if err != nil {
+					return errors.Wrap(err, "could not get attestation target state")
+				}
+				targetStates[targetRoot] = targetState
+			}
+			committee, err := helpers.BeaconCommitteeFromState(ctx, targetState, att.Data.Slot, att.Data.CommitteeIndex)
+			if err != nil {
+				return errors.Wrap(err, "could not get attestation committee")
+			}
+			indexedAtt, err := attestation.ConvertToIndexed(ctx, att, committee)
+			if err != nil {
+				return errors.Wrap(err, "could not convert to indexed attestation")
+			}
+			if !validateAttestationIntegrity(indexedAtt) {
+				continue
+			}
+			signingRoot, err := indexedAtt.Data.HashTreeRoot()
+			if err != nil {
+				return errors.Wrap(err, "could not get hash tree root of attestation")
+			}
+			atts = append(atts, &slashertypes.IndexedAttestationWrapper{
+				IndexedAttestation: indexedAtt,
+				SigningRoot:        signingRoot,
+			})
+		}
+	}
+
+	validAtts, _, _ := s.filterAttestations(atts, currentEpoch)
+	s.attestationDetectionLock.Lock()
+	attSlashings, err := s.checkSlashableAttestations(ctx, currentEpoch, validAtts)
+	s.attestationDetectionLock.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "could not check slashable attestations")
+	}
+	if err := s.processAttesterSlashings(ctx, attSlashings); err != nil {
+		return errors.Wrap(err, "could not process attester slashings")
+	}
+	proposerSlashings, err := s.detectProposerSlashings(ctx, headers)
+	if err != nil {
+		return errors.Wrap(err, "could not detect proposer slashings")
+	}
+	if err := s.processProposerSlashings(ctx, proposerSlashings); err != nil {
+		return errors.Wrap(err, "could not process proposer slashings")
+	}
+
+	log.WithFields(logrus.Fields{
+		"epoch":     epoch,
+		"numBlocks": len(headers),
+		"numAtts":   len(validAtts),
+	}).Debug("Backfilled slasher epoch")
+	processedAttestationsTotal.Add(float64(len(validAtts)))
+	processedBlocksTotal.Add(float64(len(headers)))
+	return nil
+}
+
+// Returns the state used to compute the committees of attestations to a target checkpoint. Targets which are no
+// longer in fork choice, as in finalized history, are regenerated from the beacon node database.
+func (s *Service) attestationTargetState(ctx context.Context, target *qrysmpb.Checkpoint) (state.ReadOnlyBeaconState, error) {
+	if s.serviceCfg.AttestationStateFetcher != nil {
+		st, err := s.serviceCfg.AttestationStateFetcher.AttestationTargetState(ctx, target)
+		if err == nil || s.serviceCfg.StateGen == nil || !errors.Is(err, blockchain.ErrNotCheckpoint) {
+			return st, err
+		}
+	}
+	if s.serviceCfg.StateGen == nil {
+		return nil, errors.New("no state source to compute attestation committees")
+	}
+	st, err := s.serviceCfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(target.Root))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state of target root %#x", target.Root)
+	}
+	epochStart, err := slots.EpochStart(target.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	st, err = transition.ProcessSlotsIfPossible(ctx, st, epochStart)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not process slots up to epoch %d", target.Epoch)
+	}
+	return st, nil
+}
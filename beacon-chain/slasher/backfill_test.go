@@ -0,0 +1,234 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/theQRL/go-bitfield"
+	mock "github.com/theQRL/qrysm/beacon-chain/blockchain/testing"
+	"github.com/theQRL/qrysm/beacon-chain/core/helpers"
+	"github.com/theQRL/qrysm/beacon-chain/core/signing"
+	dbtest "github.com/theQRL/qrysm/beacon-chain/db/testing"
+	doublylinkedtree "github.com/theQRL/qrysm/beacon-chain/forkchoice/doubly-linked-tree"
+	slashingsmock "github.com/theQRL/qrysm/beacon-chain/operations/slashings/mock"
+	"github.com/theQRL/qrysm/beacon-chain/state/stategen"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+func TestService_backfill(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconDB := dbtest.SetupDB(t)
+
+	beaconState, err := util.NewBeaconStateZond()
+	require.NoError(t, err)
+	mockChain := &mock.ChainService{State: beaconState}
+	pool := &slashingsmock.PoolMock{}
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database:                slasherDB,
+			BeaconDB:                beaconDB,
+			BackfillEpochs:          2,
+			AttestationStateFetcher: mockChain,
+			HeadStateFetcher:        mockChain,
+			SlashingPoolInserter:    pool,
+		},
+		params:                         DefaultParams(),
+		latestEpochWrittenForValidator: make(map[primitives.ValidatorIndex]primitives.Epoch),
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	secondsPerEpoch := time.Duration(uint64(slotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+	s.genesisTime = time.Now().Add(-10 * secondsPerEpoch)
+
+	// Two different blocks proposed by the same validator in epoch 3, and one in epoch 1 which is out of range.
+	proposal := func(slot primitives.Slot, stateRoot string) {
+		blk := util.NewBeaconBlockZond()
+		blk.Block.Slot = slot
+		blk.Block.ProposerIndex = 1
+		blk.Block.StateRoot = bytesutil.PadTo([]byte(stateRoot), 32)
+		util.SaveBlock(t, ctx, beaconDB, blk)
+	}
+	proposal(slotsPerEpoch+1, "a")
+	proposal(slotsPerEpoch+1, "b")
+	proposal(3*slotsPerEpoch+1, "a")
+	proposal(3*slotsPerEpoch+1, "b")
+
+	require.NoError(t, s.backfill(ctx, 4))
+	require.Equal(t, 1, len(pool.PendingPropSlashings))
+	assert.Equal(t, 3*slotsPerEpoch+1, pool.PendingPropSlashings[0].Header_1.Header.Slot)
+	epoch, found, err := slasherDB.BackfilledEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.Equal(t, primitives.Epoch(4), epoch)
+
+	// A restart resumes after the last backfilled epoch, so new blocks of epoch 4 are not replayed.
+	proposal(4*slotsPerEpoch+1, "a")
+	proposal(4*slotsPerEpoch+1, "b")
+	proposal(5*slotsPerEpoch+1, "a")
+	proposal(5*slotsPerEpoch+1, "b")
+	require.NoError(t, s.backfill(ctx, 5))
+	require.Equal(t, 2, len(pool.PendingPropSlashings))
+	assert.Equal(t, 5*slotsPerEpoch+1, pool.PendingPropSlashings[1].Header_1.Header.Slot)
+	epoch, _, err = slasherDB.BackfilledEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(5), epoch)
+}
+
+func TestService_backfill_attesterDoubleVote(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconDB := dbtest.SetupDB(t)
+	stateGen := stategen.New(beaconDB, doublylinkedtree.New())
+
+	// The target of the attestations is a finalized checkpoint which is only known to the beacon node database.
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	beaconState, keys := util.DeterministicGenesisStateZond(t, 256)
+	require.NoError(t, beaconState.SetSlot(3*slotsPerEpoch))
+	targetRoot := bytesutil.ToBytes32([]byte("target"))
+	require.NoError(t, stateGen.SaveState(ctx, targetRoot, beaconState))
+
+	pool := &slashingsmock.PoolMock{}
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database:             slasherDB,
+			BeaconDB:             beaconDB,
+			BackfillEpochs:       2,
+			StateGen:             stateGen,
+			HeadStateFetcher:     &mock.ChainService{State: beaconState},
+			SlashingPoolInserter: pool,
+		},
+		params:                         DefaultParams(),
+		latestEpochWrittenForValidator: make(map[primitives.ValidatorIndex]primitives.Epoch),
+	}
+	secondsPerEpoch := time.Duration(uint64(slotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+	s.genesisTime = time.Now().Add(-10 * secondsPerEpoch)
+
+	// The same validator votes for two different heads with the same target, and both votes are included in blocks.
+	attSlot := 3*slotsPerEpoch + 1
+	committee, err := helpers.BeaconCommitteeFromState(ctx, beaconState, attSlot, 0)
+	require.NoError(t, err)
+	domain, err := signing.Domain(beaconState.Fork(), 3, params.BeaconConfig().DomainBeaconAttester, beaconState.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	vote := func(blockSlot primitives.Slot, headRoot string) {
+		data := util.HydrateAttestationData(&qrysmpb.AttestationData{
+			Slot:            attSlot,
+			BeaconBlockRoot: bytesutil.PadTo([]byte(headRoot), 32),
+			Target:          &qrysmpb.Checkpoint{Epoch: 3, Root: targetRoot[:]},
+		})
+		signingRoot, err := signing.ComputeSigningRoot(data, domain)
+		require.NoError(t, err)
+		aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+		aggregationBits.SetBitAt(0, true)
+		blk := util.NewBeaconBlockZond()
+		blk.Block.Slot = blockSlot
+		blk.Block.Body.Attestations = []*qrysmpb.Attestation{{
+			Data:            data,
+			AggregationBits: aggregationBits,
+			Signatures:      [][]byte{keys[committee[0]].Sign(signingRoot[:]).Marshal()},
+		}}
+		util.SaveBlock(t, ctx, beaconDB, blk)
+	}
+	vote(attSlot+1, "a")
+	vote(attSlot+2, "b")
+
+	require.NoError(t, s.backfill(ctx, 4))
+	require.Equal(t, 1, len(pool.PendingAttSlashings))
+	slashing := pool.PendingAttSlashings[0]
+	assert.DeepEqual(t, []uint64{uint64(committee[0])}, slashing.Attestation_1.AttestingIndices)
+	assert.DeepEqual(t, []uint64{uint64(committee[0])}, slashing.Attestation_2.AttestingIndices)
+	epoch, _, err := slasherDB.BackfilledEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(4), epoch)
+}
+
+func TestService_backfill_skipsEpochsWithoutTargetState(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconDB := dbtest.SetupDB(t)
+
+	pool := &slashingsmock.PoolMock{}
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database:             slasherDB,
+			BeaconDB:             beaconDB,
+			BackfillEpochs:       2,
+			StateGen:             stategen.New(beaconDB, doublylinkedtree.New()),
+			SlashingPoolInserter: pool,
+		},
+		params:                         DefaultParams(),
+		latestEpochWrittenForValidator: make(map[primitives.ValidatorIndex]primitives.Epoch),
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	secondsPerEpoch := time.Duration(uint64(slotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+	s.genesisTime = time.Now().Add(-10 * secondsPerEpoch)
+
+	// The target state of the attestation in epoch 3 cannot be regenerated.
+	blk := util.NewBeaconBlockZond()
+	blk.Block.Slot = 3*slotsPerEpoch + 1
+	blk.Block.Body.Attestations = []*qrysmpb.Attestation{util.HydrateAttestation(&qrysmpb.Attestation{
+		Data: &qrysmpb.AttestationData{
+			Slot:   3 * slotsPerEpoch,
+			Target: &qrysmpb.Checkpoint{Epoch: 3, Root: bytesutil.PadTo([]byte("unknown"), 32)},
+		},
+	})}
+	util.SaveBlock(t, ctx, beaconDB, blk)
+
+	hook := logTest.NewGlobal()
+	require.NoError(t, s.backfill(ctx, 4))
+	require.LogsContain(t, hook, "Could not backfill epoch, skipping it")
+	// Progress stops before the skipped epoch so that it is replayed on the next start.
+	epoch, found, err := slasherDB.BackfilledEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.Equal(t, primitives.Epoch(2), epoch)
+}
+
+func TestService_runBackfill_stopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconDB := dbtest.SetupDB(t)
+
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database:             slasherDB,
+			BeaconDB:             beaconDB,
+			BackfillEpochs:       2,
+			StateGen:             stategen.New(beaconDB, doublylinkedtree.New()),
+			SlashingPoolInserter: &slashingsmock.PoolMock{},
+		},
+		params:                         DefaultParams(),
+		latestEpochWrittenForValidator: make(map[primitives.ValidatorIndex]primitives.Epoch),
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	secondsPerEpoch := time.Duration(uint64(slotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+	s.genesisTime = time.Now().Add(-10 * secondsPerEpoch)
+
+	hook := logTest.NewGlobal()
+	cancel()
+	s.wg.Add(1)
+	go s.runBackfill(ctx, 4)
+	s.wg.Wait()
+	require.LogsDoNotContain(t, hook, "Could not backfill slasher")
+	_, found, err := slasherDB.BackfilledEpoch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, false, found)
+}
+
+func TestService_backfillStartEpoch(t *testing.T) {
+	s := &Service{
+		serviceCfg: &ServiceConfig{BackfillEpochs: 10},
+		params:     &Parameters{historyLength: 16},
+	}
+	assert.Equal(t, primitives.Epoch(0), s.backfillStartEpoch(5, 7))
+	assert.Equal(t, primitives.Epoch(10), s.backfillStartEpoch(20, 22))
+	// Epochs older than the history kept by slasher are skipped.
+	assert.Equal(t, primitives.Epoch(15), s.backfillStartEpoch(20, 30))
+}
@@ -72,7 +72,7 @@ func Test_processQueuedBlocks_DetectsDoubleProposals(t *testing.T) {
 	}
 
 	parentRoot := bytesutil.ToBytes32([]byte("parent"))
-	err = s.serviceCfg.StateGen.SaveState(ctx, parentRoot, beaconState)
+	err = s.serviceCfg.StateGen.(stategen.StateManager).SaveState(ctx, parentRoot, beaconState)
 	require.NoError(t, err)
 
 	currentSlotChan := make(chan primitives.Slot)
@@ -165,7 +165,7 @@ func Test_processQueuedBlocks_DetectsDoubleProposals_AcrossBatches(t *testing.T)
 	}
 
 	parentRoot := bytesutil.ToBytes32([]byte("parent"))
-	require.NoError(t, s.serviceCfg.StateGen.SaveState(ctx, parentRoot, beaconState))
+	require.NoError(t, s.serviceCfg.StateGen.(stategen.StateManager).SaveState(ctx, parentRoot, beaconState))
 
 	currentSlotChan := make(chan primitives.Slot)
 	s.wg.Add(1)
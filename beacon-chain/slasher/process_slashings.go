@@ -3,7 +3,10 @@ package slasher
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/beacon-chain/core/blocks"
+	"github.com/theQRL/qrysm/beacon-chain/operations/slashings"
+	slashertypes "github.com/theQRL/qrysm/beacon-chain/slasher/types"
 	"github.com/theQRL/qrysm/beacon-chain/state"
 	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
 	"github.com/theQRL/qrysm/encoding/bytesutil"
@@ -11,77 +14,105 @@ import (
 )
 
 // Verifies attester slashings, logs them, and submits them to the slashing operations pool
-// in the beacon node if they pass validation.
+// in the beacon node if they pass validation. The outcome is recorded as evidence of the offense.
+// Slashings already in the pool or included in a block are not submitted again, and a slashing whose
+// validators are already pending in the pool is recorded as pooled.
 func (s *Service) processAttesterSlashings(
-	ctx context.Context, slashings map[[fieldparams.RootLength]byte]*qrysmpb.AttesterSlashing,
+	ctx context.Context, attSlashings map[[fieldparams.RootLength]byte]*qrysmpb.AttesterSlashing,
 ) error {
 	var beaconState state.BeaconState
 	var err error
-	if len(slashings) > 0 {
+	if len(attSlashings) > 0 {
 		beaconState, err = s.serviceCfg.HeadStateFetcher.HeadState(ctx)
 		if err != nil {
 			return err
 		}
 	}
-	for _, sl := range slashings {
+	for _, sl := range attSlashings {
+		record := &slashertypes.SlashingRecord{AttesterSlashing: sl}
+		if s.slashingSubmitted(ctx, record) {
+			continue
+		}
 		if err := s.verifyAttSignature(ctx, sl.Attestation_1); err != nil {
 			log.WithError(err).WithField("a", sl.Attestation_1).Warn(
 				"Invalid signature for attestation in detected slashing offense",
 			)
+			s.recordSlashing(ctx, record, slashertypes.SlashingRejected, "invalid signature: "+err.Error())
 			continue
 		}
 		if err := s.verifyAttSignature(ctx, sl.Attestation_2); err != nil {
 			log.WithError(err).WithField("b", sl.Attestation_2).Warn(
 				"Invalid signature for attestation in detected slashing offense",
 			)
+			s.recordSlashing(ctx, record, slashertypes.SlashingRejected, "invalid signature: "+err.Error())
 			continue
 		}
 
 		// Log the slashing event and insert into the beacon node's operations pool.
 		logAttesterSlashing(sl)
+		s.recordSlashing(ctx, record, slashertypes.SlashingDetected, "")
 		if err := s.serviceCfg.SlashingPoolInserter.InsertAttesterSlashing(
 			ctx, beaconState, sl,
-		); err != nil {
+		); err != nil && !errors.Is(err, slashings.ErrAttesterSlashingPending) {
 			log.WithError(err).Error("Could not insert attester slashing into operations pool")
+			s.recordSlashing(ctx, record, slashertypes.SlashingRejected, err.Error())
+			continue
 		}
+		s.recordSlashing(ctx, record, slashertypes.SlashingPooled, "")
 	}
 	return nil
 }
 
 // Verifies proposer slashings, logs them, and submits them to the slashing operations pool
-// in the beacon node if they pass validation.
-func (s *Service) processProposerSlashings(ctx context.Context, slashings []*qrysmpb.ProposerSlashing) error {
+// in the beacon node if they pass validation. The outcome is recorded as evidence of the offense,
+// and slashings are submitted at most once, as for attester slashings.
+func (s *Service) processProposerSlashings(ctx context.Context, proposerSlashings []*qrysmpb.ProposerSlashing) error {
 	var beaconState state.BeaconState
 	var err error
-	if len(slashings) > 0 {
+	if len(proposerSlashings) > 0 {
 		beaconState, err = s.serviceCfg.HeadStateFetcher.HeadState(ctx)
 		if err != nil {
 			return err
 		}
 	}
-	for _, sl := range slashings {
+	for _, sl := range proposerSlashings {
+		record := &slashertypes.SlashingRecord{ProposerSlashing: sl}
+		if s.slashingSubmitted(ctx, record) {
+			continue
+		}
 		if err := s.verifyBlockSignature(ctx, sl.Header_1); err != nil {
 			log.WithError(err).WithField("a", sl.Header_1).Warn(
 				"Invalid signature for block header in detected slashing offense",
 			)
+			s.recordSlashing(ctx, record, slashertypes.SlashingRejected, "invalid signature: "+err.Error())
 			continue
 		}
 		if err := s.verifyBlockSignature(ctx, sl.Header_2); err != nil {
 			log.WithError(err).WithField("b", sl.Header_2).Warn(
 				"Invalid signature for block header in detected slashing offense",
 			)
+			s.recordSlashing(ctx, record, slashertypes.SlashingRejected, "invalid signature: "+err.Error())
 			continue
 		}
 		// Log the slashing event and insert into the beacon node's operations pool.
 		logProposerSlashing(sl)
-		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, beaconState, sl); err != nil {
+		s.recordSlashing(ctx, record, slashertypes.SlashingDetected, "")
+		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(
+			ctx, beaconState, sl,
+		); err != nil && !errors.Is(err, slashings.ErrProposerSlashingPending) {
 			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
+			s.recordSlashing(ctx, record, slashertypes.SlashingRejected, err.Error())
+			continue
 		}
+		s.recordSlashing(ctx, record, slashertypes.SlashingPooled, "")
 	}
 	return nil
 }
 
 func (s *Service) verifyBlockSignature(ctx context.Context, header *qrysmpb.SignedBeaconBlockHeader) error {
+	if s.serviceCfg.StateGen == nil {
+		return nil
+	}
 	parentState, err := s.serviceCfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(header.Header.ParentRoot))
 	if err != nil {
 		return err
@@ -90,7 +121,10 @@ func (s *Service) verifyBlockSignature(ctx context.Context, header *qrysmpb.Sign
 }
 
 func (s *Service) verifyAttSignature(ctx context.Context, att *qrysmpb.IndexedAttestation) error {
-	preState, err := s.serviceCfg.AttestationStateFetcher.AttestationTargetState(ctx, att.Data.Target)
+	if s.serviceCfg.AttestationStateFetcher == nil && s.serviceCfg.StateGen == nil {
+		return nil
+	}
+	preState, err := s.attestationTargetState(ctx, att.Data.Target)
 	if err != nil {
 		return err
 	}
@@ -162,7 +162,7 @@ func TestService_processProposerSlashings(t *testing.T) {
 	}
 
 	parentRoot := bytesutil.ToBytes32([]byte("parent"))
-	err = s.serviceCfg.StateGen.SaveState(ctx, parentRoot, beaconState)
+	err = s.serviceCfg.StateGen.(stategen.StateManager).SaveState(ctx, parentRoot, beaconState)
 	require.NoError(t, err)
 
 	firstBlockHeader := util.HydrateSignedBeaconHeader(&qrysmpb.SignedBeaconBlockHeader{
@@ -110,7 +110,9 @@ func (s *Service) processQueuedAttestations(ctx context.Context, slotTicker <-ch
 			// checkSlashableAttestations between the double-vote and surround
 			// checks so that on-disk double-vote detection compares against
 			// previously saved batches rather than the current one.
+			s.attestationDetectionLock.Lock()
 			slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, validAtts)
+			s.attestationDetectionLock.Unlock()
 			if err != nil {
 				log.WithError(err).Error("Could not check slashable attestations")
 				continue
@@ -0,0 +1,123 @@
+package slasher
+
+import (
+	"context"
+	"time"
+
+	"github.com/theQRL/qrysm/beacon-chain/core/feed"
+	statefeed "github.com/theQRL/qrysm/beacon-chain/core/feed/state"
+	slashertypes "github.com/theQRL/qrysm/beacon-chain/slasher/types"
+)
+
+// slashingSubmitted checks whether a detected slashing was already accepted into the slashing operations pool or
+// included in a block, in which case it is not submitted again.
+func (s *Service) slashingSubmitted(ctx context.Context, record *slashertypes.SlashingRecord) bool {
+	root, err := record.Root()
+	if err != nil {
+		log.WithError(err).Error("Could not compute slashing root")
+		return false
+	}
+	existing, err := s.serviceCfg.Database.SlashingRecord(ctx, record.Epoch(), root)
+	if err != nil {
+		log.WithError(err).Error("Could not read slashing record")
+		return false
+	}
+	return existing != nil && (existing.Status == slashertypes.SlashingPooled || existing.Status == slashertypes.SlashingIncluded)
+}
+
+// recordSlashing saves the slashing with the given submission outcome as evidence, and appends the outcome to
+// the audit log. A slashing is only recorded as detected the first time, and a slashing which was already
+// included in a block keeps its status when it is detected again.
+func (s *Service) recordSlashing(
+	ctx context.Context, record *slashertypes.SlashingRecord, status slashertypes.SlashingStatus, reason string,
+) {
+	root, err := record.Root()
+	if err != nil {
+		log.WithError(err).Error("Could not compute slashing root")
+		return
+	}
+	if record.DetectedAt.IsZero() {
+		existing, err := s.serviceCfg.Database.SlashingRecord(ctx, record.Epoch(), root)
+		if err != nil {
+			log.WithError(err).Error("Could not read slashing record")
+			return
+		}
+		if existing != nil && (existing.Status == slashertypes.SlashingIncluded || status == slashertypes.SlashingDetected) {
+			return
+		}
+		record.DetectedAt = time.Now()
+		if existing != nil {
+			record.DetectedAt = existing.DetectedAt
+		}
+	}
+	record.Status = status
+	record.RejectionReason = reason
+	if err := s.serviceCfg.Database.SaveSlashingRecord(ctx, record); err != nil {
+		log.WithError(err).Error("Could not save slashing record")
+	}
+	if err := s.auditLog.write(root, record); err != nil {
+		log.WithError(err).Error("Could not write slashing to audit log")
+	}
+}
+
+// receiveProcessedBlocks marks the slashings detected by slasher as included once they are found in a
+// processed block.
+func (s *Service) receiveProcessedBlocks(ctx context.Context) {
+	defer s.wg.Done()
+
+	stateChannel := make(chan *feed.Event, 1)
+	sub := s.serviceCfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case e := <-stateChannel:
+			if e.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := e.Data.(*statefeed.BlockProcessedData)
+			if !ok {
+				log.Error("Event feed data is not of type *statefeed.BlockProcessedData")
+				continue
+			}
+			s.markIncludedSlashings(ctx, data)
+		case err := <-sub.Err():
+			log.WithError(err).Debug("Subscriber closed with error")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) markIncludedSlashings(ctx context.Context, data *statefeed.BlockProcessedData) {
+	if data.SignedBlock == nil || data.SignedBlock.IsNil() {
+		return
+	}
+	body := data.SignedBlock.Block().Body()
+	included := make([]*slashertypes.SlashingRecord, 0)
+	for _, sl := range body.AttesterSlashings() {
+		included = append(included, &slashertypes.SlashingRecord{AttesterSlashing: sl})
+	}
+	for _, sl := range body.ProposerSlashings() {
+		included = append(included, &slashertypes.SlashingRecord{ProposerSlashing: sl})
+	}
+	for _, r := range included {
+		root, err := r.Root()
+		if err != nil {
+			log.WithError(err).Error("Could not compute slashing root")
+			continue
+		}
+		record, err := s.serviceCfg.Database.SlashingRecord(ctx, r.Epoch(), root)
+		if err != nil {
+			log.WithError(err).Error("Could not read slashing record")
+			continue
+		}
+		// Slashings which were not detected by slasher, or were already seen in a block, are ignored.
+		if record == nil || record.Status == slashertypes.SlashingIncluded {
+			continue
+		}
+		record.IncludedSlot = data.Slot
+		record.IncludedBlockRoot = data.BlockRoot
+		s.recordSlashing(ctx, record, slashertypes.SlashingIncluded, "")
+	}
+}
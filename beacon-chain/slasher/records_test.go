@@ -0,0 +1,169 @@
+package slasher
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mock "github.com/theQRL/qrysm/beacon-chain/blockchain/testing"
+	statefeed "github.com/theQRL/qrysm/beacon-chain/core/feed/state"
+	dbtest "github.com/theQRL/qrysm/beacon-chain/db/testing"
+	"github.com/theQRL/qrysm/beacon-chain/operations/slashings"
+	slashingsmock "github.com/theQRL/qrysm/beacon-chain/operations/slashings/mock"
+	slashertypes "github.com/theQRL/qrysm/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/blocks"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+type rejectingPool struct {
+	slashingsmock.PoolMock
+}
+
+func (*rejectingPool) InsertProposerSlashing(_ context.Context, _ state.ReadOnlyBeaconState, _ *qrysmpb.ProposerSlashing) error {
+	return errors.New("validator already slashed")
+}
+
+func TestService_recordSlashings(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconState, err := util.NewBeaconStateZond()
+	require.NoError(t, err)
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	audit, err := openAuditLog(auditPath)
+	require.NoError(t, err)
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database:             slasherDB,
+			HeadStateFetcher:     &mock.ChainService{State: beaconState},
+			SlashingPoolInserter: &slashingsmock.PoolMock{},
+		},
+		auditLog: audit,
+	}
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	pooled := &qrysmpb.ProposerSlashing{
+		Header_1: createProposalWrapper(t, 1, 1, []byte{1}).SignedBeaconBlockHeader,
+		Header_2: createProposalWrapper(t, 1, 1, []byte{2}).SignedBeaconBlockHeader,
+	}
+	rejected := &qrysmpb.ProposerSlashing{
+		Header_1: createProposalWrapper(t, slotsPerEpoch+1, 1, []byte{1}).SignedBeaconBlockHeader,
+		Header_2: createProposalWrapper(t, slotsPerEpoch+1, 1, []byte{2}).SignedBeaconBlockHeader,
+	}
+	other := &qrysmpb.ProposerSlashing{
+		Header_1: createProposalWrapper(t, 2, 2, []byte{1}).SignedBeaconBlockHeader,
+		Header_2: createProposalWrapper(t, 2, 2, []byte{2}).SignedBeaconBlockHeader,
+	}
+	require.NoError(t, s.processProposerSlashings(ctx, []*qrysmpb.ProposerSlashing{pooled, other}))
+	s.serviceCfg.SlashingPoolInserter = &rejectingPool{}
+	require.NoError(t, s.processProposerSlashings(ctx, []*qrysmpb.ProposerSlashing{rejected}))
+
+	blk := util.NewBeaconBlockZond()
+	blk.Block.Slot = 3
+	blk.Block.Body.ProposerSlashings = []*qrysmpb.ProposerSlashing{pooled}
+	wsb, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	s.markIncludedSlashings(ctx, &statefeed.BlockProcessedData{Slot: 3, BlockRoot: [32]byte{'b'}, SignedBlock: wsb})
+	// Detecting an included slashing again does not change its status.
+	require.NoError(t, s.processProposerSlashings(ctx, []*qrysmpb.ProposerSlashing{pooled}))
+
+	records, err := s.ValidatorSlashingRecords(ctx, 1, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	assert.DeepSSZEqual(t, pooled, records[0].ProposerSlashing)
+	assert.Equal(t, slashertypes.SlashingIncluded, records[0].Status)
+	assert.Equal(t, blk.Block.Slot, records[0].IncludedSlot)
+	assert.Equal(t, [32]byte{'b'}, records[0].IncludedBlockRoot)
+	assert.DeepSSZEqual(t, rejected, records[1].ProposerSlashing)
+	assert.Equal(t, slashertypes.SlashingRejected, records[1].Status)
+	assert.Equal(t, "validator already slashed", records[1].RejectionReason)
+
+	records, err = s.SlashingRecords(ctx, 0, 0, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+
+	require.NoError(t, audit.close())
+	assert.DeepEqual(t, []string{"detected", "pooled", "detected", "pooled", "detected", "rejected", "included"}, auditEvents(t, auditPath))
+}
+
+func TestService_recordSlashings_DetectedTwice(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconState, err := util.NewBeaconStateZond()
+	require.NoError(t, err)
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	audit, err := openAuditLog(auditPath)
+	require.NoError(t, err)
+	pool := &slashingsmock.PoolMock{}
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database:             slasherDB,
+			HeadStateFetcher:     &mock.ChainService{State: beaconState},
+			SlashingPoolInserter: pool,
+		},
+		auditLog: audit,
+	}
+
+	pooled := &qrysmpb.ProposerSlashing{
+		Header_1: createProposalWrapper(t, 1, 1, []byte{1}).SignedBeaconBlockHeader,
+		Header_2: createProposalWrapper(t, 1, 1, []byte{2}).SignedBeaconBlockHeader,
+	}
+	pending := &qrysmpb.ProposerSlashing{
+		Header_1: createProposalWrapper(t, 2, 2, []byte{1}).SignedBeaconBlockHeader,
+		Header_2: createProposalWrapper(t, 2, 2, []byte{2}).SignedBeaconBlockHeader,
+	}
+	// A slashing already in the pool is not submitted again.
+	require.NoError(t, s.processProposerSlashings(ctx, []*qrysmpb.ProposerSlashing{pooled}))
+	require.NoError(t, s.processProposerSlashings(ctx, []*qrysmpb.ProposerSlashing{pooled}))
+	assert.Equal(t, 1, len(pool.PendingPropSlashings))
+	// A slashing refused because its validator is already pending in the pool is pooled, not rejected.
+	s.serviceCfg.SlashingPoolInserter = &pendingPool{}
+	require.NoError(t, s.processProposerSlashings(ctx, []*qrysmpb.ProposerSlashing{pending}))
+	require.NoError(t, s.processProposerSlashings(ctx, []*qrysmpb.ProposerSlashing{pending}))
+
+	records, err := s.SlashingRecords(ctx, 0, 0, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	for _, r := range records {
+		assert.Equal(t, slashertypes.SlashingPooled, r.Status)
+		assert.Equal(t, "", r.RejectionReason)
+	}
+
+	require.NoError(t, audit.close())
+	assert.DeepEqual(t, []string{"detected", "pooled", "detected", "pooled"}, auditEvents(t, auditPath))
+}
+
+type pendingPool struct {
+	slashingsmock.PoolMock
+}
+
+func (*pendingPool) InsertProposerSlashing(_ context.Context, _ state.ReadOnlyBeaconState, _ *qrysmpb.ProposerSlashing) error {
+	return slashings.ErrProposerSlashingPending
+}
+
+// auditEvents reads the events of the proposer slashings in the audit log at the given path.
+func auditEvents(t *testing.T, path string) []string {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	var events []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		entry := &auditEntry{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), entry))
+		assert.Equal(t, "proposer", entry.Kind)
+		events = append(events, entry.Event)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
@@ -61,7 +61,9 @@ func (s *Service) IsSlashableAttestation(
 	}
 
 	currentEpoch := slots.EpochsSinceGenesis(s.genesisTime)
+	s.attestationDetectionLock.Lock()
 	attesterSlashings, err := s.checkSlashableAttestations(ctx, currentEpoch, []*slashertypes.IndexedAttestationWrapper{indexedAttWrapper})
+	s.attestationDetectionLock.Unlock()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not check if attestation is slashable: %v", err)
 	}
@@ -74,3 +76,27 @@ func (s *Service) IsSlashableAttestation(
 	}
 	return out, nil
 }
+
+// SlashingRecords detected for offenses committed from the start epoch to the end epoch included, skipping the
+// first offset records and returning at most limit records.
+func (s *Service) SlashingRecords(
+	ctx context.Context, startEpoch, endEpoch primitives.Epoch, offset, limit int,
+) ([]*slashertypes.SlashingRecord, error) {
+	records, err := s.serviceCfg.Database.SlashingRecords(ctx, startEpoch, endEpoch, offset, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get slashing records from database")
+	}
+	return records, nil
+}
+
+// ValidatorSlashingRecords detected for offenses committed by a validator, skipping the first offset records and
+// returning at most limit records.
+func (s *Service) ValidatorSlashingRecords(
+	ctx context.Context, idx primitives.ValidatorIndex, offset, limit int,
+) ([]*slashertypes.SlashingRecord, error) {
+	records, err := s.serviceCfg.Database.ValidatorSlashingRecords(ctx, idx, offset, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validator slashing records from database")
+	}
+	return records, nil
+}
@@ -14,9 +14,9 @@ import (
 	statefeed "github.com/theQRL/qrysm/beacon-chain/core/feed/state"
 	"github.com/theQRL/qrysm/beacon-chain/db"
 	"github.com/theQRL/qrysm/beacon-chain/operations/slashings"
+	slashertypes "github.com/theQRL/qrysm/beacon-chain/slasher/types"
 	"github.com/theQRL/qrysm/beacon-chain/startup"
-	"github.com/theQRL/qrysm/beacon-chain/state/stategen"
-	beaconChainSync "github.com/theQRL/qrysm/beacon-chain/sync"
+	"github.com/theQRL/qrysm/beacon-chain/state"
 	"github.com/theQRL/qrysm/config/params"
 	"github.com/theQRL/qrysm/consensus-types/primitives"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
@@ -35,12 +35,36 @@ type ServiceConfig struct {
 	BeaconBlockHeadersFeed  *event.Feed
 	Database                db.SlasherDatabase
 	StateNotifier           statefeed.Notifier
+	// AttestationStateFetcher and StateGen are used to verify the signatures of detected slashings. When they are
+	// nil, as in a standalone slasher, signatures are left for the SlashingPoolInserter to verify. StateGen also
+	// regenerates the states of attestation targets which are no longer in fork choice during backfill.
 	AttestationStateFetcher blockchain.AttestationStateFetcher
-	StateGen                stategen.StateManager
+	StateGen                StateByRooter
 	SlashingPoolInserter    slashings.PoolInserter
-	HeadStateFetcher        blockchain.HeadFetcher
-	SyncChecker             beaconChainSync.Checker
+	HeadStateFetcher        HeadFetcher
+	SyncChecker             SyncChecker
 	ClockWaiter             startup.ClockWaiter
+	// BeaconDB is read to replay the attestations and proposals of the last BackfillEpochs finalized epochs on start.
+	BeaconDB       db.ReadOnlyDatabase
+	BackfillEpochs primitives.Epoch
+	// AuditLogPath is the file to which detected slashings and the outcome of their submission are appended.
+	AuditLogPath string
+}
+
+// HeadFetcher defines the information about the head of the chain used by slasher.
+type HeadFetcher interface {
+	HeadSlot() primitives.Slot
+	HeadState(ctx context.Context) (state.BeaconState, error)
+}
+
+// StateByRooter retrieves the state of a block root, to verify the signatures of proposer slashings.
+type StateByRooter interface {
+	StateByRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+}
+
+// SyncChecker reports whether the chain followed by slasher is syncing.
+type SyncChecker interface {
+	Syncing() bool
 }
 
 // SlashingChecker is an interface for defining services that the beacon node may interact with to provide slashing data.
@@ -52,6 +76,16 @@ type SlashingChecker interface {
 	) ([]*qrysmpb.HighestAttestation, error)
 }
 
+// SlashingRecordsFetcher retrieves the slashings detected by slasher, with the outcome of their submission.
+type SlashingRecordsFetcher interface {
+	SlashingRecords(
+		ctx context.Context, startEpoch, endEpoch primitives.Epoch, offset, limit int,
+	) ([]*slashertypes.SlashingRecord, error)
+	ValidatorSlashingRecords(
+		ctx context.Context, idx primitives.ValidatorIndex, offset, limit int,
+	) ([]*slashertypes.SlashingRecord, error)
+}
+
 // Service defining a slasher implementation as part of
 // the beacon node, able to detect consensus slashable offenses.
 type Service struct {
@@ -68,11 +102,23 @@ type Service struct {
 	blocksSlotTicker               *slots.SlotTicker
 	pruningSlotTicker              *slots.SlotTicker
 	latestEpochWrittenForValidator map[primitives.ValidatorIndex]primitives.Epoch
-	wg                             sync.WaitGroup
+	// Serializes attestation slashing detection between the queue, the backfill and RPC callers, which all
+	// update the min-max spans and latestEpochWrittenForValidator.
+	attestationDetectionLock sync.Mutex
+	auditLog                 *auditLog
+	wg                       sync.WaitGroup
 }
 
 // New instantiates a new slasher from configuration values.
 func New(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
+	var audit *auditLog
+	if srvCfg.AuditLogPath != "" {
+		var err error
+		audit, err = openAuditLog(srvCfg.AuditLogPath)
+		if err != nil {
+			return nil, err
+		}
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	return &Service{
 		params:                         DefaultParams(),
@@ -84,6 +130,7 @@ func New(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
 		ctx:                            ctx,
 		cancel:                         cancel,
 		latestEpochWrittenForValidator: make(map[primitives.ValidatorIndex]primitives.Epoch),
+		auditLog:                       audit,
 	}, nil
 }
 
@@ -135,6 +182,11 @@ func (s *Service) run() {
 	s.wg.Add(1)
 	go s.receiveBlocks(s.ctx, beaconBlockHeadersChan)
 
+	if s.serviceCfg.StateNotifier != nil {
+		s.wg.Add(1)
+		go s.receiveProcessedBlocks(s.ctx)
+	}
+
 	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
 	s.attsSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 	s.blocksSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
@@ -148,6 +200,10 @@ func (s *Service) run() {
 
 	s.wg.Add(1)
 	go s.pruneSlasherData(s.ctx, s.pruningSlotTicker.C())
+
+	// Replay the finalized history alongside the detection of newly received data.
+	s.wg.Add(1)
+	go s.runBackfill(s.ctx, headState.FinalizedCheckpointEpoch())
 }
 
 // Stop the slasher service.
@@ -178,6 +234,9 @@ func (s *Service) Stop() error {
 	log.WithField("elapsed", time.Since(start)).Debug(
 		"Finished saving last epoch written per validator",
 	)
+	if err := s.auditLog.close(); err != nil {
+		log.WithError(err).Error("Could not close slasher audit log")
+	}
 	return nil
 }
 
@@ -1,8 +1,12 @@
 package types
 
 import (
+	"time"
+
 	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/container/slice"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/time/slots"
 )
 
 // ChunkKind to differentiate what kind of span we are working
@@ -51,3 +55,67 @@ type AttestedEpochForValidator struct {
 	ValidatorIndex primitives.ValidatorIndex
 	Epoch          primitives.Epoch
 }
+
+// SlashingStatus is the outcome of the submission of a detected slashing to the slashing operations pool.
+type SlashingStatus string
+
+const (
+	// SlashingDetected slashings have not been submitted yet.
+	SlashingDetected SlashingStatus = "detected"
+	// SlashingPooled slashings were accepted into the slashing operations pool.
+	SlashingPooled SlashingStatus = "pooled"
+	// SlashingIncluded slashings were included in a block of the chain.
+	SlashingIncluded SlashingStatus = "included"
+	// SlashingRejected slashings failed signature verification or were refused by the slashing operations pool.
+	SlashingRejected SlashingStatus = "rejected"
+)
+
+// SlashingRecord is a slashing detected by slasher, kept as evidence of the offense along with the
+// outcome of its submission. Exactly one of AttesterSlashing and ProposerSlashing is set.
+type SlashingRecord struct {
+	AttesterSlashing *qrysmpb.AttesterSlashing
+	ProposerSlashing *qrysmpb.ProposerSlashing
+	DetectedAt       time.Time
+	Status           SlashingStatus
+	// RejectionReason is set for rejected slashings.
+	RejectionReason string
+	// IncludedSlot and IncludedBlockRoot identify the block including the slashing.
+	IncludedSlot      primitives.Slot
+	IncludedBlockRoot [32]byte
+}
+
+// Epoch of the offense: the later target epoch of the conflicting attestations for attester slashings,
+// and the epoch of the conflicting blocks for proposer slashings.
+func (r *SlashingRecord) Epoch() primitives.Epoch {
+	if r.AttesterSlashing != nil {
+		return primitives.MaxEpoch(
+			r.AttesterSlashing.Attestation_1.Data.Target.Epoch,
+			r.AttesterSlashing.Attestation_2.Data.Target.Epoch,
+		)
+	}
+	return slots.ToEpoch(r.ProposerSlashing.Header_1.Header.Slot)
+}
+
+// ValidatorIndices returns the indices of the validators slashed by the slashing.
+func (r *SlashingRecord) ValidatorIndices() []primitives.ValidatorIndex {
+	if r.AttesterSlashing != nil {
+		indices := slice.IntersectionUint64(
+			r.AttesterSlashing.Attestation_1.AttestingIndices,
+			r.AttesterSlashing.Attestation_2.AttestingIndices,
+		)
+		out := make([]primitives.ValidatorIndex, len(indices))
+		for i, idx := range indices {
+			out[i] = primitives.ValidatorIndex(idx)
+		}
+		return out
+	}
+	return []primitives.ValidatorIndex{r.ProposerSlashing.Header_1.Header.ProposerIndex}
+}
+
+// Root returns the hash tree root of the slashing.
+func (r *SlashingRecord) Root() ([32]byte, error) {
+	if r.AttesterSlashing != nil {
+		return r.AttesterSlashing.HashTreeRoot()
+	}
+	return r.ProposerSlashing.HashTreeRoot()
+}
@@ -0,0 +1,362 @@
+package stategen
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	lruwrpr "github.com/theQRL/qrysm/cache/lru"
+	"github.com/theQRL/qrysm/consensus-types/blocks"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// ErrReplayBudgetExceeded is returned when regenerating a historical state would replay more slots than the
+// replay budget of the node allows.
+var ErrReplayBudgetExceeded = errors.New("regenerating the state exceeds the replay budget of this node, retry with an archive node")
+
+const (
+	defaultReplayWorkers     = 2
+	defaultBoundaryCacheSize = 8
+)
+
+// HistoricalStateFetcher regenerates historical states at a bounded cost.
+type HistoricalStateFetcher interface {
+	StateBySlot(ctx context.Context, slot primitives.Slot) (state.BeaconState, error)
+	StateByBlockRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+	RegenCost(ctx context.Context, slot primitives.Slot) (*RegenCost, error)
+}
+
+var _ HistoricalStateFetcher = &HistoricalStates{}
+
+// HistoricalAccessor describes the database methods needed by HistoricalStates.
+type HistoricalAccessor interface {
+	HistoryAccessor
+	HasState(ctx context.Context, blockRoot [32]byte) bool
+}
+
+// RegenCost is the estimated cost of regenerating the state at a slot.
+type RegenCost struct {
+	Slot primitives.Slot
+	// BaseSlot is the slot of the saved or cached state that the regeneration starts from.
+	BaseSlot primitives.Slot
+	// Blocks is the number of blocks applied on top of the base state.
+	Blocks int
+	// Cached is true when the base state is held in memory rather than read from the database.
+	Cached bool
+	// Budget is the replay budget of the node, 0 when unlimited.
+	Budget primitives.Slot
+}
+
+// ReplaySlots returns the number of slots processed to regenerate the state.
+func (c *RegenCost) ReplaySlots() primitives.Slot {
+	return c.Slot - c.BaseSlot
+}
+
+// WithinBudget reports whether the state can be regenerated within the replay budget.
+func (c *RegenCost) WithinBudget() bool {
+	return c.Budget == 0 || c.ReplaySlots() <= c.Budget
+}
+
+// HistoricalStatesOption configures HistoricalStates.
+type HistoricalStatesOption func(*HistoricalStates)
+
+// WithReplayBudget limits the number of slots replayed to regenerate a single state. A budget of 0 is unlimited.
+func WithReplayBudget(budget primitives.Slot) HistoricalStatesOption {
+	return func(hs *HistoricalStates) {
+		hs.budget = budget
+	}
+}
+
+// WithReplayWorkers limits the number of states regenerated concurrently.
+func WithReplayWorkers(n int) HistoricalStatesOption {
+	return func(hs *HistoricalStates) {
+		if n > 0 {
+			hs.workers = make(chan struct{}, n)
+		}
+	}
+}
+
+// WithBoundaryCacheSize sets the number of regenerated epoch boundary states kept in memory.
+func WithBoundaryCacheSize(n int) HistoricalStatesOption {
+	return func(hs *HistoricalStates) {
+		if n > 0 {
+			hs.boundaries = newBoundaryCache(n)
+		}
+	}
+}
+
+// WithStateCache lets HistoricalStates start regenerations from the states cached by the state generator.
+func WithStateCache(c CachedGetter) HistoricalStatesOption {
+	return func(hs *HistoricalStates) {
+		hs.cache = c
+	}
+}
+
+// HistoricalStates regenerates canonical states of past slots. Unlike a Replayer it bounds the work spent on a
+// request: the number of slots replayed for a state is limited by a replay budget, regenerations run on a bounded
+// number of workers and the epoch boundary states it regenerates are cached so that nearby requests start from them.
+type HistoricalStates struct {
+	h          HistoricalAccessor
+	ch         *CanonicalHistory
+	cache      CachedGetter
+	budget     primitives.Slot
+	workers    chan struct{}
+	boundaries *boundaryCache
+}
+
+// NewHistoricalStates creates a HistoricalStates reading blocks and states from the given accessor.
+func NewHistoricalStates(h HistoricalAccessor, cc CanonicalChecker, cs CurrentSlotter, opts ...HistoricalStatesOption) *HistoricalStates {
+	hs := &HistoricalStates{
+		h:          h,
+		workers:    make(chan struct{}, defaultReplayWorkers),
+		boundaries: newBoundaryCache(defaultBoundaryCacheSize),
+	}
+	for _, o := range opts {
+		o(hs)
+	}
+	hs.ch = NewCanonicalHistory(h, cc, cs, WithCache(hs.cache))
+	return hs
+}
+
+// replayPlan holds the base state and the blocks to apply to it to regenerate the state at the target slot.
+type replayPlan struct {
+	target primitives.Slot
+	// base is set when the base state is held in memory, otherwise it is read from the database by baseRoot.
+	base     state.BeaconState
+	baseRoot [32]byte
+	baseSlot primitives.Slot
+	// baseLimit is the slot of the first block applied to the base state, which a state read from the database must
+	// be below.
+	baseLimit primitives.Slot
+	// blocks are ordered by slot.
+	blocks []interfaces.ReadOnlySignedBeaconBlock
+}
+
+// plan walks back from the canonical block of the target slot until it finds a state to start from. It stops with
+// ErrReplayBudgetExceeded as soon as every possible starting state is more than budget slots below the target.
+func (hs *HistoricalStates) plan(ctx context.Context, target, budget primitives.Slot) (*replayPlan, error) {
+	ctx, span := trace.StartSpan(ctx, "historicalStates.plan")
+	defer span.End()
+
+	root, err := hs.ch.BlockRootForSlot(ctx, target)
+	if err != nil {
+		return nil, errors.Wrapf(err, "no canonical block root found below slot=%d", target)
+	}
+	p := &replayPlan{target: target}
+	limit := target + 1
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		b, err := hs.h.Block(ctx, root)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get block %#x", root)
+		}
+		if err := blocks.BeaconBlockIsNil(b); err != nil {
+			return nil, errors.Wrapf(err, "could not get block %#x", root)
+		}
+		slot := b.Block().Slot()
+		found := true
+		if st := hs.boundaries.get(root); st != nil && st.Slot() < limit {
+			p.base, p.baseSlot = st, st.Slot()
+		} else if st := hs.cachedState(root); st != nil && st.Slot() == slot {
+			p.base, p.baseSlot = st, slot
+		} else if hs.h.HasState(ctx, root) {
+			p.baseSlot = slot
+		} else {
+			found = false
+		}
+		if found {
+			p.baseRoot, p.baseLimit = root, limit
+			if budget > 0 && target-p.baseSlot > budget {
+				return nil, errors.Wrapf(ErrReplayBudgetExceeded, "%d slots to replay, budget is %d", target-p.baseSlot, budget)
+			}
+			reverseChain(p.blocks)
+			return p, nil
+		}
+		// Any state below this block is further from the target.
+		if budget > 0 && target-slot >= budget {
+			return nil, errors.Wrapf(ErrReplayBudgetExceeded, "more than %d slots to replay", budget)
+		}
+		p.blocks = append(p.blocks, b)
+		root, limit = b.Block().ParentRoot(), slot
+	}
+}
+
+func (hs *HistoricalStates) cachedState(root [32]byte) state.BeaconState {
+	if hs.cache == nil {
+		return nil
+	}
+	st, err := hs.cache.ByBlockRoot(root)
+	if err != nil {
+		return nil
+	}
+	return st
+}
+
+// RegenCost estimates the cost of regenerating the state at the slot, without regenerating it. The estimate ignores
+// the replay budget, so that callers learn how far over the budget a request is.
+func (hs *HistoricalStates) RegenCost(ctx context.Context, slot primitives.Slot) (*RegenCost, error) {
+	p, err := hs.plan(ctx, slot, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &RegenCost{
+		Slot:     slot,
+		BaseSlot: p.baseSlot,
+		Blocks:   len(p.blocks),
+		Cached:   p.base != nil,
+		Budget:   hs.budget,
+	}, nil
+}
+
+// StateBySlot regenerates the canonical state at the slot. It fails with ErrReplayBudgetExceeded when more slots than
+// the replay budget would need to be replayed, and waits for a free worker before replaying.
+func (hs *HistoricalStates) StateBySlot(ctx context.Context, slot primitives.Slot) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "historicalStates.StateBySlot")
+	defer span.End()
+
+	p, err := hs.plan(ctx, slot, hs.budget)
+	if err != nil {
+		historicalStateRegenerations.WithLabelValues("rejected").Inc()
+		return nil, err
+	}
+	select {
+	case hs.workers <- struct{}{}:
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "context canceled while waiting for a replay worker")
+	}
+	defer func() {
+		<-hs.workers
+	}()
+	st, err := hs.replay(ctx, p)
+	if err != nil {
+		historicalStateRegenerations.WithLabelValues("failed").Inc()
+		return nil, err
+	}
+	historicalStateRegenerations.WithLabelValues("regenerated").Inc()
+	replayBlockCount.Observe(float64(len(p.blocks)))
+	return st, nil
+}
+
+// StateByBlockRoot regenerates the post-state of a canonical block, within the same replay budget as StateBySlot.
+func (hs *HistoricalStates) StateByBlockRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	b, err := hs.h.Block(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get block %#x", blockRoot)
+	}
+	if err := blocks.BeaconBlockIsNil(b); err != nil {
+		return nil, errors.Wrapf(err, "could not get block %#x", blockRoot)
+	}
+	canonical, err := hs.ch.cc.IsCanonical(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not check if block %#x is canonical", blockRoot)
+	}
+	if !canonical {
+		return nil, errors.Errorf("block %#x is not canonical", blockRoot)
+	}
+	return hs.StateBySlot(ctx, b.Block().Slot())
+}
+
+func (hs *HistoricalStates) replay(ctx context.Context, p *replayPlan) (state.BeaconState, error) {
+	st := p.base
+	if st == nil {
+		var err error
+		st, err = hs.h.StateOrError(ctx, p.baseRoot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get state of block %#x", p.baseRoot)
+		}
+		if st.Slot() < p.baseSlot || st.Slot() >= p.baseLimit {
+			return nil, fmt.Errorf("state of block %#x is at slot %d, expected a slot in [%d, %d)", p.baseRoot, st.Slot(), p.baseSlot, p.baseLimit)
+		}
+	}
+
+	// The state at the last epoch boundary up to the target is cached so that requests for nearby slots replay from
+	// it, unless the regeneration starts from that boundary already.
+	boundary, err := slots.EpochStart(slots.ToEpoch(p.target))
+	if err != nil {
+		return nil, err
+	}
+	pending := st.Slot() < boundary
+	lastRoot := p.baseRoot
+	cacheBoundary := func() error {
+		if !pending {
+			return nil
+		}
+		pending = false
+		if st.Slot() < boundary {
+			if st, err = ReplayProcessSlots(ctx, st, boundary); err != nil {
+				return err
+			}
+		}
+		hs.boundaries.add(lastRoot, st)
+		return nil
+	}
+
+	log.WithFields(logrus.Fields{
+		"startSlot": st.Slot(),
+		"endSlot":   p.target,
+		"blocks":    len(p.blocks),
+	}).Debug("Regenerating historical state")
+	for _, b := range p.blocks {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if b.Block().Slot() > boundary {
+			if err := cacheBoundary(); err != nil {
+				return nil, err
+			}
+		}
+		if st, err = executeStateTransitionStateGen(ctx, st, b); err != nil {
+			return nil, err
+		}
+		if lastRoot, err = b.Block().HashTreeRoot(); err != nil {
+			return nil, err
+		}
+	}
+	if err := cacheBoundary(); err != nil {
+		return nil, err
+	}
+	if p.target > st.Slot() {
+		if st, err = ReplayProcessSlots(ctx, st, p.target); err != nil {
+			return nil, err
+		}
+	}
+	return st, nil
+}
+
+// boundaryCache holds regenerated epoch boundary states by the root of the latest block they include.
+type boundaryCache struct {
+	cache *lru.Cache
+	lock  sync.RWMutex
+}
+
+func newBoundaryCache(size int) *boundaryCache {
+	return &boundaryCache{cache: lruwrpr.New(size)}
+}
+
+// get returns a copy of the cached state whose latest block is the given root, if any.
+func (c *boundaryCache) get(blockRoot [32]byte) state.BeaconState {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, ok := c.cache.Get(blockRoot)
+	if !ok || item == nil {
+		boundaryStateCacheMiss.Inc()
+		return nil
+	}
+	boundaryStateCacheHit.Inc()
+	return item.(state.BeaconState).Copy()
+}
+
+func (c *boundaryCache) add(blockRoot [32]byte, st state.BeaconState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Add(blockRoot, st.Copy())
+}
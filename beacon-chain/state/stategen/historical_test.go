@@ -0,0 +1,116 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func historicalTestHistory(t *testing.T) (*mockHistory, primitives.Slot) {
+	var target primitives.Slot = 200
+	specs := []mockHistorySpec{
+		{slot: 50},
+		{slot: 51, savedState: true},
+		{slot: 150},
+		{slot: 151},
+		{slot: 152},
+		{slot: target, canonicalBlock: true},
+	}
+	return newMockHistory(t, specs, target+1), target
+}
+
+func TestHistoricalStates_StateBySlot(t *testing.T) {
+	ctx := context.Background()
+	hist, target := historicalTestHistory(t)
+	hs := NewHistoricalStates(hist, hist, hist)
+
+	cost, err := hs.RegenCost(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(51), cost.BaseSlot)
+	require.Equal(t, primitives.Slot(149), cost.ReplaySlots())
+	require.Equal(t, 4, cost.Blocks)
+	require.Equal(t, false, cost.Cached)
+	require.Equal(t, true, cost.WithinBudget())
+
+	st, err := hs.StateBySlot(ctx, target)
+	require.NoError(t, err)
+	want, err := hist.hiddenStates[hist.slotMap[target]].HashTreeRoot(ctx)
+	require.NoError(t, err)
+	got, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestHistoricalStates_ReplayBudget(t *testing.T) {
+	ctx := context.Background()
+	hist, target := historicalTestHistory(t)
+	hs := NewHistoricalStates(hist, hist, hist, WithReplayBudget(100))
+
+	_, err := hs.StateBySlot(ctx, target)
+	require.ErrorIs(t, err, ErrReplayBudgetExceeded)
+
+	// The cost estimate is not limited by the budget.
+	cost, err := hs.RegenCost(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(149), cost.ReplaySlots())
+	require.Equal(t, false, cost.WithinBudget())
+}
+
+func TestHistoricalStates_BoundaryCache(t *testing.T) {
+	ctx := context.Background()
+	hist, target := historicalTestHistory(t)
+	hs := NewHistoricalStates(hist, hist, hist)
+	_, err := hs.StateBySlot(ctx, target)
+	require.NoError(t, err)
+
+	// The state at the start of the epoch of the target now serves as the base state.
+	cost, err := hs.RegenCost(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, true, cost.Cached)
+	require.Equal(t, primitives.Slot(128), cost.BaseSlot)
+	require.Equal(t, 4, cost.Blocks)
+
+	hs.budget = 100
+	st, err := hs.StateBySlot(ctx, target)
+	require.NoError(t, err)
+	want, err := hist.hiddenStates[hist.slotMap[target]].HashTreeRoot(ctx)
+	require.NoError(t, err)
+	got, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestHistoricalStates_StateByBlockRoot(t *testing.T) {
+	ctx := context.Background()
+	hist, target := historicalTestHistory(t)
+	hs := NewHistoricalStates(hist, hist, hist)
+
+	st, err := hs.StateByBlockRoot(ctx, hist.slotMap[target])
+	require.NoError(t, err)
+	want, err := hist.hiddenStates[hist.slotMap[target]].HashTreeRoot(ctx)
+	require.NoError(t, err)
+	got, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = hs.StateByBlockRoot(ctx, hist.slotMap[150])
+	require.ErrorContains(t, "is not canonical", err)
+
+	hs = NewHistoricalStates(hist, hist, hist, WithReplayBudget(100))
+	_, err = hs.StateByBlockRoot(ctx, hist.slotMap[target])
+	require.ErrorIs(t, err, ErrReplayBudgetExceeded)
+}
+
+func TestHistoricalStates_WaitForWorker(t *testing.T) {
+	hist, target := historicalTestHistory(t)
+	hs := NewHistoricalStates(hist, hist, hist, WithReplayWorkers(1))
+	hs.workers <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	_, err := hs.StateBySlot(ctx, target)
+	require.ErrorContains(t, "waiting for a replay worker", err)
+}
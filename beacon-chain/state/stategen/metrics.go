@@ -25,4 +25,19 @@ var (
 			Help: "Time it took to replay to slot",
 		},
 	)
+	historicalStateRegenerations = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "historical_state_regenerations_total",
+			Help: "The number of historical state regenerations by outcome: regenerated, failed or rejected over budget",
+		},
+		[]string{"outcome"},
+	)
+	boundaryStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_boundary_state_cache_hit_total",
+		Help: "The total number of cache hits on the regenerated epoch boundary state cache.",
+	})
+	boundaryStateCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_boundary_state_cache_miss_total",
+		Help: "The total number of cache misses on the regenerated epoch boundary state cache.",
+	})
 )
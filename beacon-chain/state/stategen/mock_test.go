@@ -153,6 +153,11 @@ func (m *mockHistory) StateOrError(_ context.Context, blockRoot [32]byte) (state
 	return nil, db.ErrNotFoundState
 }
 
+func (m *mockHistory) HasState(_ context.Context, blockRoot [32]byte) bool {
+	_, ok := m.states[blockRoot]
+	return ok
+}
+
 func (m *mockHistory) IsCanonical(_ context.Context, blockRoot [32]byte) (bool, error) {
 	canon, ok := m.canonical[blockRoot]
 	return ok && canon, nil
@@ -275,6 +280,7 @@ func newMockHistory(t *testing.T, hist []mockHistorySpec, current primitives.Slo
 }
 
 var _ HistoryAccessor = &mockHistory{}
+var _ HistoricalAccessor = &mockHistory{}
 var _ CanonicalChecker = &mockHistory{}
 var _ CurrentSlotter = &mockHistory{}
 
@@ -0,0 +1,12 @@
+// Package coverage defines the interface used to ask whether the block for a given slot is
+// available in the database. It is kept separate from the backfill service so that packages
+// such as stategen can depend on it without importing the p2p sync stack.
+package coverage
+
+import "github.com/theQRL/qrysm/consensus-types/primitives"
+
+// AvailableBlocker can be used to check whether the block history for the given slot is present in the db.
+// This interface is typically fulfilled by backfill.Status.
+type AvailableBlocker interface {
+	SlotCovered(primitives.Slot) bool
+}
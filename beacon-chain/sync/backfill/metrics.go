@@ -0,0 +1,54 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfillLowestSlot = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_lowest_slot",
+			Help: "Slot of the lowest block backfilled below the checkpoint sync origin.",
+		},
+	)
+	backfillRemainingSlots = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_remaining_slots",
+			Help: "Number of slots between genesis and the lowest backfilled block that are still missing.",
+		},
+	)
+	backfillBlocksImported = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_blocks_imported_total",
+			Help: "Number of blocks downloaded, verified and saved by backfill.",
+		},
+	)
+	backfillBatchesImported = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_batches_imported_total",
+			Help: "Number of block batches downloaded, verified and saved by backfill.",
+		},
+	)
+	backfillBatchErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "backfill_batch_errors_total",
+			Help: "Number of backfill batches that failed, by the step which failed.",
+		},
+		[]string{"step"},
+	)
+	backfillBatchRequestSeconds = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "backfill_batch_request_seconds",
+			Help:    "Time taken to download a batch of blocks from a peer.",
+			Buckets: []float64{0.1, 0.25, 0.5, 1, 2, 5, 10, 20},
+		},
+	)
+	backfillBatchVerifySeconds = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "backfill_batch_verify_seconds",
+			Help:    "Time taken to verify the parent chain and proposer signatures of a batch of blocks.",
+			Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 5},
+		},
+	)
+)
@@ -0,0 +1,332 @@
+package backfill
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/beacon-chain/p2p"
+	"github.com/theQRL/qrysm/beacon-chain/startup"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/beacon-chain/sync"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/crypto/rand"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/time/slots"
+)
+
+const (
+	// defaultBatchSize is the number of slots requested from a peer in a single BeaconBlocksByRange request.
+	defaultBatchSize = 64
+	// maxPeersToConsider is the number of best finalized peers which are considered when picking a peer to query.
+	maxPeersToConsider = 20
+	// retryInterval is how long the service waits before trying again after failing to find a suitable peer.
+	retryInterval = 5 * time.Second
+)
+
+var (
+	errNoPeers          = errors.New("no suitable peers to backfill from")
+	errRangeNotServed   = errors.New("peer did not serve any block linking to the lowest backfilled block")
+	errInvalidBatchSize = errors.New("backfill batch size must be greater than zero")
+)
+
+// Database describes the set of DB methods used by the backfill service.
+type Database interface {
+	BackfillDB
+	SaveBlocks(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock) error
+	BackfillFinalizedIndex(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock, finalizedChildRoot [32]byte) error
+	StateOrError(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+}
+
+// Service downloads the block history below the origin checkpoint of a checkpoint-synced node. Blocks are requested
+// in batches walking backwards from the origin block, and every batch must link to the lowest block already in the
+// db through its parent roots and carry valid proposer signatures before it is saved.
+type Service struct {
+	ctx                 context.Context
+	cancel              context.CancelFunc
+	enabled             bool
+	store               *Status
+	db                  Database
+	p2p                 p2p.P2P
+	clockWaiter         startup.ClockWaiter
+	initialSyncComplete chan struct{}
+	batchSize           uint64
+	verifier            *verifier
+	genesisRoot         [32]byte
+	rand                *rand.Rand
+}
+
+// ServiceOption represents a functional option for the backfill service constructor.
+type ServiceOption func(*Service) error
+
+// WithEnableBackfill toggles the backfill service. When disabled, the service does nothing on Start.
+func WithEnableBackfill(enabled bool) ServiceOption {
+	return func(s *Service) error {
+		s.enabled = enabled
+		return nil
+	}
+}
+
+// WithBatchSize sets the number of slots requested from a peer in a single batch.
+func WithBatchSize(n uint64) ServiceOption {
+	return func(s *Service) error {
+		if n == 0 {
+			return errInvalidBatchSize
+		}
+		s.batchSize = n
+		return nil
+	}
+}
+
+// WithInitialSyncComplete makes the service wait for initial sync to finish before it starts backfilling,
+// so that following the head of the chain takes priority over downloading history.
+func WithInitialSyncComplete(c chan struct{}) ServiceOption {
+	return func(s *Service) error {
+		s.initialSyncComplete = c
+		return nil
+	}
+}
+
+// NewService initializes the backfill service. The given Status should already have been loaded via Reload.
+func NewService(ctx context.Context, su *Status, db Database, p p2p.P2P, cw startup.ClockWaiter, opts ...ServiceOption) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:         ctx,
+		cancel:      cancel,
+		store:       su,
+		db:          db,
+		p2p:         p,
+		clockWaiter: cw,
+		batchSize:   defaultBatchSize,
+		rand:        rand.NewGenerator(),
+	}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if max := params.BeaconNetworkConfig().MaxRequestBlocks; s.batchSize > max {
+		s.batchSize = max
+	}
+	return s, nil
+}
+
+// Start begins the backfill process in a background routine.
+func (s *Service) Start() {
+	if !s.enabled {
+		log.Info("Backfill service not enabled")
+		return
+	}
+	if s.store.Complete() {
+		log.Info("Backfill service not needed, block history is complete")
+		return
+	}
+	go s.run()
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service. Backfill running in the background does not make the node unhealthy.
+func (*Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	clock, err := s.clockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Backfill service failed to receive genesis data")
+		return
+	}
+	if s.initialSyncComplete != nil {
+		select {
+		case <-s.initialSyncComplete:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+	if err := s.initVerifier(s.ctx); err != nil {
+		log.WithError(err).Error("Could not initialize backfill service")
+		return
+	}
+
+	log.WithFields(logrus.Fields{
+		"lowestSlot": s.store.EndGap(),
+		"batchSize":  s.batchSize,
+	}).Info("Starting backfill of block history below checkpoint sync origin")
+	for !s.store.Complete() {
+		updateMetrics(s.store)
+		if s.ctx.Err() != nil {
+			return
+		}
+		pid, err := s.pickPeer()
+		if err != nil {
+			log.WithError(err).Debug("Waiting for peers to backfill from")
+			s.wait()
+			continue
+		}
+		if err := s.backfillFromPeer(s.ctx, clock, pid); err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			log.WithError(err).WithField("peer", pid).Debug("Could not backfill from peer")
+			s.wait()
+		}
+	}
+	updateMetrics(s.store)
+	log.WithField("lowestSlot", s.store.EndGap()).Info("Backfill complete, retained block history has been downloaded")
+}
+
+func (s *Service) initVerifier(ctx context.Context) error {
+	originRoot, err := s.db.OriginCheckpointBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve origin checkpoint root")
+	}
+	st, err := s.db.StateOrError(ctx, originRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not retrieve origin state for root=%#x", originRoot)
+	}
+	s.genesisRoot, err = s.db.GenesisBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve genesis block root")
+	}
+	s.verifier = newVerifier(st)
+	return nil
+}
+
+// backfillFromPeer requests batches of blocks from a single peer, walking backwards from the lowest block in the db,
+// until backfill is complete or the peer fails to serve a valid batch.
+func (s *Service) backfillFromPeer(ctx context.Context, clock *startup.Clock, pid peer.ID) error {
+	// cursor is the exclusive upper bound of the next range to request.
+	cursor := s.store.EndGap()
+	for !s.store.Complete() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		start := s.store.StartGap()
+		if cursor <= start {
+			if start > params.BeaconConfig().GenesisSlot {
+				// The gap starts at the pruning cutoff, and no block links to the lowest backfilled block above it,
+				// so the history that is retained is complete.
+				s.store.markComplete()
+				return nil
+			}
+			// The whole gap has been scanned without finding the parent of the lowest block.
+			return errRangeNotServed
+		}
+		begin := start
+		if cursor-start > primitives.Slot(s.batchSize) {
+			begin = cursor - primitives.Slot(s.batchSize)
+		}
+		blks, err := s.requestBatch(ctx, clock, pid, begin, cursor)
+		if err != nil {
+			backfillBatchErrors.WithLabelValues("request").Inc()
+			return errors.Wrap(err, "could not request blocks")
+		}
+		if len(blks) == 0 {
+			// Every slot in the range may have been skipped, continue below it.
+			cursor = begin
+			continue
+		}
+		vStart := time.Now()
+		vb, err := s.verifier.verify(blks, s.store.expectedRoot())
+		backfillBatchVerifySeconds.Observe(time.Since(vStart).Seconds())
+		if err != nil {
+			backfillBatchErrors.WithLabelValues("verify").Inc()
+			s.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+			return errors.Wrap(err, "invalid batch")
+		}
+		if err := s.importBatch(ctx, vb); err != nil {
+			backfillBatchErrors.WithLabelValues("import").Inc()
+			return errors.Wrap(err, "could not import batch")
+		}
+		cursor = s.store.EndGap()
+	}
+	return nil
+}
+
+// requestBatch downloads the blocks in the slot range [begin, end) from the given peer. The genesis block is already
+// in the db, so it is dropped from the response.
+func (s *Service) requestBatch(ctx context.Context, clock *startup.Clock, pid peer.ID, begin, end primitives.Slot) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+	req := &qrysmpb.BeaconBlocksByRangeRequest{
+		StartSlot: begin,
+		Count:     uint64(end - begin),
+		Step:      1,
+	}
+	rStart := time.Now()
+	blks, err := sync.SendBeaconBlocksByRangeRequest(ctx, clock, s.p2p, pid, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	backfillBatchRequestSeconds.Observe(time.Since(rStart).Seconds())
+	filtered := make([]interfaces.ReadOnlySignedBeaconBlock, 0, len(blks))
+	for _, b := range blks {
+		if b.Block().Slot() == params.BeaconConfig().GenesisSlot {
+			continue
+		}
+		filtered = append(filtered, b)
+	}
+	return filtered, nil
+}
+
+// importBatch saves a verified batch, links it into the finalized block index and moves the backfill Status down to
+// the lowest block in the batch.
+func (s *Service) importBatch(ctx context.Context, vb verifiedBatch) error {
+	if err := s.db.SaveBlocks(ctx, vb.blocks); err != nil {
+		return err
+	}
+	if err := s.db.BackfillFinalizedIndex(ctx, vb.blocks, s.store.lowestRoot()); err != nil {
+		return err
+	}
+	lowest, root := vb.lowest()
+	parent := lowest.Block().ParentRoot()
+	if err := s.store.Advance(ctx, lowest.Block().Slot(), root, parent); err != nil {
+		return err
+	}
+	if parent == s.genesisRoot {
+		s.store.markComplete()
+	}
+	backfillBatchesImported.Inc()
+	backfillBlocksImported.Add(float64(len(vb.blocks)))
+	log.WithFields(logrus.Fields{
+		"lowestSlot": lowest.Block().Slot(),
+		"blocks":     len(vb.blocks),
+	}).Debug("Imported backfill batch")
+	return nil
+}
+
+// pickPeer chooses a random peer among those which have finalized the origin checkpoint.
+func (s *Service) pickPeer() (peer.ID, error) {
+	originEpoch := slots.ToEpoch(s.store.originSlot())
+	_, pids := s.p2p.Peers().BestFinalized(maxPeersToConsider, originEpoch)
+	candidates := make([]peer.ID, 0, len(pids))
+	for _, pid := range pids {
+		if !s.p2p.Peers().IsBad(pid) {
+			candidates = append(candidates, pid)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", errNoPeers
+	}
+	return candidates[s.rand.Intn(len(candidates))], nil
+}
+
+func (s *Service) wait() {
+	select {
+	case <-s.ctx.Done():
+	case <-time.After(retryInterval):
+	}
+}
+
+func updateMetrics(s *Status) {
+	backfillLowestSlot.Set(float64(s.EndGap()))
+	backfillRemainingSlots.Set(float64(s.EndGap() - s.StartGap()))
+}
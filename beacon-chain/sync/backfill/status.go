@@ -2,9 +2,11 @@ package backfill
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/beacon-chain/db"
+	"github.com/theQRL/qrysm/beacon-chain/sync/backfill/coverage"
 	"github.com/theQRL/qrysm/consensus-types/blocks"
 	"github.com/theQRL/qrysm/consensus-types/interfaces"
 	"github.com/theQRL/qrysm/consensus-types/primitives"
@@ -19,25 +21,39 @@ func NewStatus(store BackfillDB) *Status {
 
 // Status provides a way to update and query the status of a backfill process that may be necessary to track when
 // a node was initialized via checkpoint sync. With checkpoint sync, there will be a gap in node history from genesis
-// until the checkpoint sync origin block. Status provides the means to update the value keeping track of the lower
-// end of the missing block range via the Advance() method, to check whether a Slot is missing from the database
-// via the SlotCovered() method, and to see the current StartGap() and EndGap().
+// until the checkpoint sync origin block. Backfill closes this gap by walking backwards from the origin, so the lower
+// end of the gap stays at genesis while the upper end is moved down via the Advance() method. SlotCovered() checks
+// whether a Slot is missing from the database, and StartGap() and EndGap() describe the current gap.
+// When finalized history is pruned, the slots below the pruning cutoff are reported as missing as well, and
+// the lower end of the gap is raised to the cutoff, as backfill should not download history that will be pruned.
 type Status struct {
+	mu          sync.RWMutex
 	start       primitives.Slot
+	pruned      primitives.Slot
 	end         primitives.Slot
+	origin      primitives.Slot
+	root        [32]byte
+	parent      [32]byte
 	store       BackfillDB
 	genesisSync bool
+	complete    bool
 }
 
 // SlotCovered uses StartGap() and EndGap() to determine if the given slot is covered by the current chain history.
-// If the slot is <= StartGap(), or >= EndGap(), the result is true.
+// If the slot is <= StartGap(), or >= EndGap(), the result is true, unless the slot was pruned.
 // If the slot is between StartGap() and EndGap(), the result is false.
 func (s *Status) SlotCovered(sl primitives.Slot) bool {
-	// short circuit if the node was synced from genesis
-	if s.genesisSync {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	// history below the pruning cutoff has been deleted, except for genesis
+	if sl > 0 && sl < s.pruned {
+		return false
+	}
+	// short circuit if the node was synced from genesis, or backfill has reached genesis
+	if s.genesisSync || s.complete {
 		return true
 	}
-	if s.StartGap() < sl && sl < s.EndGap() {
+	if s.start < sl && sl < s.end {
 		return false
 	}
 	return true
@@ -45,34 +61,112 @@ func (s *Status) SlotCovered(sl primitives.Slot) bool {
 
 // StartGap returns the slot at the beginning of the range that needs to be backfilled.
 func (s *Status) StartGap() primitives.Slot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.start
 }
 
 // EndGap returns the slot at the end of the range that needs to be backfilled.
 func (s *Status) EndGap() primitives.Slot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.end
 }
 
+// Complete returns true when there is no gap left to backfill, either because the node was synced from genesis
+// or because backfill has linked the history below the origin checkpoint back to the genesis block.
+func (s *Status) Complete() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.genesisSync || s.complete
+}
+
+// originSlot is the slot of the origin checkpoint block, ie the upper end of the gap before backfill started.
+func (s *Status) originSlot() primitives.Slot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.origin
+}
+
+// lowestRoot is the root of the lowest block in the db above the gap, ie the origin block until backfill saves a block.
+func (s *Status) lowestRoot() [32]byte {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.root
+}
+
+// expectedRoot is the parent root of the lowest block in the db, ie the root of the next block backfill must find.
+func (s *Status) expectedRoot() [32]byte {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.parent
+}
+
 var ErrAdvancePastOrigin = errors.New("cannot advance backfill Status beyond the origin checkpoint slot")
 
-// Advance advances the backfill position to the given slot & root.
+// Advance moves the upper end of the backfill gap down to the given slot, which is the slot of the block with the
+// given root, and whose parent root is the next block backfill needs to download.
 // It updates the backfill block root entry in the database,
-// and also updates the Status value's copy of the backfill position slot.
-func (s *Status) Advance(ctx context.Context, upTo primitives.Slot, root [32]byte) error {
+// and also updates the Status value's copy of the backfill position.
+func (s *Status) Advance(ctx context.Context, upTo primitives.Slot, root, parent [32]byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if upTo > s.end {
-		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, origin slot=%d", upTo, s.end)
+		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, current lowest slot=%d", upTo, s.end)
+	}
+	if err := s.store.SaveBackfillBlockRoot(ctx, root); err != nil {
+		return err
+	}
+	s.end = upTo
+	s.root = root
+	s.parent = parent
+	return nil
+}
+
+// markComplete is called by the backfill service once the lowest block in the db is a child of the genesis block.
+func (s *Status) markComplete() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.complete = true
+	s.end = s.start
+}
+
+// MarkPruned records that history below the given slot has been deleted from the database. The start of the gap
+// moves up to the cutoff, and backfill is considered complete once the lowest backfilled block reaches it.
+func (s *Status) MarkPruned(before primitives.Slot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.markPruned(before)
+}
+
+func (s *Status) markPruned(before primitives.Slot) {
+	if before <= s.pruned {
+		return
+	}
+	s.pruned = before
+	if s.start < before {
+		s.start = before
+	}
+	if s.end <= s.start {
+		s.complete = true
+		s.end = s.start
 	}
-	s.start = upTo
-	return s.store.SaveBackfillBlockRoot(ctx, root)
 }
 
 // Reload queries the database for backfill status, initializing the internal data and validating the database state.
 func (s *Status) Reload(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pruned, err := s.store.PrunedBeforeSlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error retrieving pruned history slot")
+	}
 	cpRoot, err := s.store.OriginCheckpointBlockRoot(ctx)
 	if err != nil {
 		// mark genesis sync and short circuit further lookups
 		if errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
 			s.genesisSync = true
+			s.pruned = pruned
 			return nil
 		}
 		return err
@@ -84,9 +178,12 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(cpBlock); err != nil {
 		return err
 	}
-	s.end = cpBlock.Block().Slot()
+	s.origin = cpBlock.Block().Slot()
+	s.end = s.origin
+	s.root = cpRoot
+	s.parent = cpBlock.Block().ParentRoot()
 
-	_, err = s.store.GenesisBlockRoot(ctx)
+	genesisRoot, err := s.store.GenesisBlockRoot(ctx)
 	if err != nil {
 		if errors.Is(err, db.ErrNotFoundGenesisBlockRoot) {
 			return errors.Wrap(err, "genesis block root required for checkpoint sync")
@@ -106,17 +203,45 @@ func (s *Status) Reload(ctx context.Context) error {
 		return errors.Wrapf(err, "error retrieving block for backfill root=%#x", bfRoot)
 	}
 	if err := blocks.BeaconBlockIsNil(bfBlock); err != nil {
+		// The lowest backfilled block is gone when backfill reached the pruning cutoff before it moved up.
+		if pruned > 0 {
+			s.end = 0
+			s.markPruned(pruned)
+			return nil
+		}
 		return err
 	}
-	s.start = bfBlock.Block().Slot()
+	// Databases initialized before backfill walked backwards from the origin point the backfill root at genesis,
+	// meaning that no history below the origin has been downloaded yet.
+	if bfRoot != genesisRoot {
+		s.end = bfBlock.Block().Slot()
+		s.root = bfRoot
+		s.parent = bfBlock.Block().ParentRoot()
+	}
+	if s.parent == genesisRoot {
+		s.complete = true
+		s.end = s.start
+	}
+	s.markPruned(pruned)
 	return nil
 }
 
+// StatusFetcher describes the backfill progress exposed to other services, such as the node API.
+type StatusFetcher interface {
+	coverage.AvailableBlocker
+	StartGap() primitives.Slot
+	EndGap() primitives.Slot
+	Complete() bool
+}
+
+var _ StatusFetcher = &Status{}
+
 // BackfillDB describes the set of DB methods that the Status type needs to function.
 type BackfillDB interface {
 	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	GenesisBlockRoot(ctx context.Context) ([32]byte, error)
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	PrunedBeforeSlot(ctx context.Context) (primitives.Slot, error)
 	Block(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
 }
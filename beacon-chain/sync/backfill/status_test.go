@@ -22,6 +22,7 @@ type mockBackfillDB struct {
 	genesisBlockRoot          func(ctx context.Context) ([32]byte, error)
 	originCheckpointBlockRoot func(ctx context.Context) ([32]byte, error)
 	backfillBlockRoot         func(ctx context.Context) ([32]byte, error)
+	prunedBeforeSlot          func(ctx context.Context) (primitives.Slot, error)
 	block                     func(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
 }
 
@@ -55,6 +56,14 @@ func (db *mockBackfillDB) BackfillBlockRoot(ctx context.Context) ([32]byte, erro
 	return [32]byte{}, errEmptyMockDBMethod
 }
 
+// PrunedBeforeSlot defaults to reporting that nothing was pruned, as most tests do not exercise pruning.
+func (db *mockBackfillDB) PrunedBeforeSlot(ctx context.Context) (primitives.Slot, error) {
+	if db.prunedBeforeSlot != nil {
+		return db.prunedBeforeSlot(ctx)
+	}
+	return 0, nil
+}
+
 func (db *mockBackfillDB) Block(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
 	if db.block != nil {
 		return db.block(ctx, blockRoot)
@@ -105,6 +114,24 @@ func TestSlotCovered(t *testing.T) {
 			slot:   100,
 			result: true,
 		},
+		{
+			name:   "pruned false",
+			status: &Status{genesisSync: true, pruned: 50},
+			slot:   49,
+			result: false,
+		},
+		{
+			name:   "genesis never pruned",
+			status: &Status{genesisSync: true, pruned: 50},
+			slot:   0,
+			result: true,
+		},
+		{
+			name:   "equal pruned true",
+			status: &Status{genesisSync: true, pruned: 50},
+			slot:   50,
+			result: true,
+		},
 	}
 	for _, c := range cases {
 		result := c.status.SlotCovered(c.slot)
@@ -122,18 +149,49 @@ func TestAdvance(t *testing.T) {
 		},
 	}
 	s := &Status{end: 100, store: mdb}
-	var root [32]byte
+	var root, parent [32]byte
 	copy(root[:], []byte{0x23, 0x23})
-	require.NoError(t, s.Advance(ctx, 90, root))
+	copy(parent[:], []byte{0x42, 0x42})
+	require.NoError(t, s.Advance(ctx, 90, root, parent))
 	require.Equal(t, root, saveBackfillBuf[0])
-	not := s.SlotCovered(95)
-	require.Equal(t, false, not)
+	require.Equal(t, primitives.Slot(90), s.EndGap())
+	require.Equal(t, root, s.lowestRoot())
+	require.Equal(t, parent, s.expectedRoot())
+	// slots above the new lowest block are now covered, slots below it are still missing.
+	require.Equal(t, true, s.SlotCovered(95))
+	require.Equal(t, false, s.SlotCovered(85))
 
 	// this should still be len 1 after failing to advance
 	require.Equal(t, 1, len(saveBackfillBuf))
-	require.ErrorIs(t, s.Advance(ctx, s.end+1, root), ErrAdvancePastOrigin)
+	require.ErrorIs(t, s.Advance(ctx, s.end+1, root, parent), ErrAdvancePastOrigin)
 	// this has an element in it from the previous test, there shouldn't be an additional one
 	require.Equal(t, 1, len(saveBackfillBuf))
+
+	s.markComplete()
+	require.Equal(t, true, s.Complete())
+	require.Equal(t, true, s.SlotCovered(85))
+}
+
+func TestMarkPruned(t *testing.T) {
+	s := &Status{end: 100}
+	s.MarkPruned(40)
+	require.Equal(t, primitives.Slot(40), s.StartGap())
+	require.Equal(t, primitives.Slot(100), s.EndGap())
+	require.Equal(t, false, s.Complete())
+	require.Equal(t, false, s.SlotCovered(20))
+	require.Equal(t, false, s.SlotCovered(60))
+
+	// a lower cutoff does not move the gap back down.
+	s.MarkPruned(30)
+	require.Equal(t, primitives.Slot(40), s.StartGap())
+
+	// pruning past the lowest backfilled block leaves nothing to backfill.
+	s.MarkPruned(120)
+	require.Equal(t, true, s.Complete())
+	require.Equal(t, primitives.Slot(120), s.StartGap())
+	require.Equal(t, primitives.Slot(120), s.EndGap())
+	require.Equal(t, false, s.SlotCovered(100))
+	require.Equal(t, true, s.SlotCovered(120))
 }
 
 func goodBlockRoot(root [32]byte) func(ctx context.Context) ([32]byte, error) {
@@ -142,8 +200,9 @@ func goodBlockRoot(root [32]byte) func(ctx context.Context) ([32]byte, error) {
 	}
 }
 
-func setupTestBlock(slot primitives.Slot) (interfaces.ReadOnlySignedBeaconBlock, error) {
+func setupTestBlock(slot primitives.Slot, parent [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
 	bRaw := util.NewBeaconBlockZond()
+	bRaw.Block.ParentRoot = parent[:]
 	b, err := blocks.NewSignedBeaconBlock(bRaw)
 	if err != nil {
 		return nil, err
@@ -158,13 +217,23 @@ func TestReload(t *testing.T) {
 	originSlot := primitives.Slot(100)
 	var originRoot [32]byte
 	copy(originRoot[:], []byte{0x01})
-	originBlock, err := setupTestBlock(originSlot)
+	var originParent [32]byte
+	copy(originParent[:], []byte{0x03})
+	originBlock, err := setupTestBlock(originSlot, originParent)
 	require.NoError(t, err)
 
 	backfillSlot := primitives.Slot(50)
 	var backfillRoot [32]byte
-	copy(originRoot[:], []byte{0x02})
-	backfillBlock, err := setupTestBlock(backfillSlot)
+	copy(backfillRoot[:], []byte{0x02})
+	var backfillParent [32]byte
+	copy(backfillParent[:], []byte{0x04})
+	backfillBlock, err := setupTestBlock(backfillSlot, backfillParent)
+	require.NoError(t, err)
+
+	genesisChildSlot := primitives.Slot(1)
+	var genesisChildRoot [32]byte
+	copy(genesisChildRoot[:], []byte{0x05})
+	genesisChildBlock, err := setupTestBlock(genesisChildSlot, params.BeaconConfig().ZeroHash)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -333,8 +402,94 @@ func TestReload(t *testing.T) {
 				},
 				backfillBlockRoot: goodBlockRoot(backfillRoot),
 			},
-			err:      derp,
-			expected: &Status{genesisSync: false, start: backfillSlot, end: originSlot},
+			expected: &Status{genesisSync: false, start: 0, end: backfillSlot, root: backfillRoot, parent: backfillParent},
+		},
+		{
+			name: "backfill root at genesis, backfill not started",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(params.BeaconConfig().ZeroHash),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block: func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					case params.BeaconConfig().ZeroHash:
+						return backfillBlock, nil
+					}
+					return nil, errors.New("not derp")
+				},
+				backfillBlockRoot: goodBlockRoot(params.BeaconConfig().ZeroHash),
+			},
+			expected: &Status{genesisSync: false, start: 0, end: originSlot, root: originRoot, parent: originParent},
+		},
+		{
+			name: "backfill root is a child of genesis, backfill complete",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(params.BeaconConfig().ZeroHash),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block: func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					case genesisChildRoot:
+						return genesisChildBlock, nil
+					}
+					return nil, errors.New("not derp")
+				},
+				backfillBlockRoot: goodBlockRoot(genesisChildRoot),
+			},
+			expected: &Status{genesisSync: false, complete: true, start: 0, end: 0},
+		},
+		{
+			name: "history pruned below backfill block",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(params.BeaconConfig().ZeroHash),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				prunedBeforeSlot: func(ctx context.Context) (primitives.Slot, error) {
+					return 20, nil
+				},
+				block: func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					case backfillRoot:
+						return backfillBlock, nil
+					}
+					return nil, errors.New("not derp")
+				},
+				backfillBlockRoot: goodBlockRoot(backfillRoot),
+			},
+			expected: &Status{start: 20, end: backfillSlot, root: backfillRoot, parent: backfillParent},
+		},
+		{
+			name: "backfill block pruned",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(params.BeaconConfig().ZeroHash),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				prunedBeforeSlot: func(ctx context.Context) (primitives.Slot, error) {
+					return 60, nil
+				},
+				block: func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					case backfillRoot:
+						return nil, nil
+					}
+					return nil, errors.New("not derp")
+				},
+				backfillBlockRoot: goodBlockRoot(backfillRoot),
+			},
+			expected: &Status{complete: true, start: 60, end: 60},
+		},
+		{
+			name: "pruned slot error",
+			db: &mockBackfillDB{
+				prunedBeforeSlot: func(ctx context.Context) (primitives.Slot, error) {
+					return 0, derp
+				},
+			},
+			err: derp,
 		},
 	}
 
@@ -354,5 +509,10 @@ func TestReload(t *testing.T) {
 		require.Equal(t, c.expected.genesisSync, s.genesisSync)
 		require.Equal(t, c.expected.start, s.start)
 		require.Equal(t, c.expected.end, s.end)
+		require.Equal(t, c.expected.complete, s.complete)
+		if !c.expected.genesisSync && !c.expected.complete {
+			require.Equal(t, c.expected.root, s.root)
+			require.Equal(t, c.expected.parent, s.parent)
+		}
 	}
 }
@@ -0,0 +1,107 @@
+package backfill
+
+import (
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/beacon-chain/core/signing"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/crypto/ml_dsa_87"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	"github.com/theQRL/qrysm/network/forks"
+	"github.com/theQRL/qrysm/time/slots"
+)
+
+var (
+	errBatchEmpty            = errors.New("no blocks in batch")
+	errUnexpectedBatchRoot   = errors.New("highest block in batch is not the parent of the lowest backfilled block")
+	errBatchNotChained       = errors.New("blocks in batch do not form a parent-root chain")
+	errProposerIndexTooHigh  = errors.New("proposer index not present in origin state")
+	errInvalidBatchSignature = errors.New("proposer signature verification failed for batch")
+)
+
+// verifiedBatch is a list of blocks, sorted by slot in ascending order, that link to the lowest
+// backfilled block and carry valid proposer signatures.
+type verifiedBatch struct {
+	blocks []interfaces.ReadOnlySignedBeaconBlock
+	roots  [][32]byte
+}
+
+func (vb verifiedBatch) lowest() (interfaces.ReadOnlySignedBeaconBlock, [32]byte) {
+	return vb.blocks[0], vb.roots[0]
+}
+
+// verifier checks the blocks downloaded by backfill. Validators are never removed from the registry, so the
+// origin state holds the public key of every proposer below the origin block.
+type verifier struct {
+	st  state.ReadOnlyBeaconState
+	gvr [32]byte
+}
+
+func newVerifier(st state.ReadOnlyBeaconState) *verifier {
+	return &verifier{st: st, gvr: bytesutil.ToBytes32(st.GenesisValidatorsRoot())}
+}
+
+// verify checks that the given blocks, sorted by slot in ascending order, form a parent-root chain whose
+// highest block has the expected root, and that all of their proposer signatures are valid. The signatures
+// are checked together as a single batch.
+func (v *verifier) verify(blks []interfaces.ReadOnlySignedBeaconBlock, expected [32]byte) (verifiedBatch, error) {
+	if len(blks) == 0 {
+		return verifiedBatch{}, errBatchEmpty
+	}
+	roots := make([][32]byte, len(blks))
+	for i := range blks {
+		root, err := blks[i].Block().HashTreeRoot()
+		if err != nil {
+			return verifiedBatch{}, errors.Wrapf(err, "could not compute root of block at slot %d", blks[i].Block().Slot())
+		}
+		roots[i] = root
+	}
+	if roots[len(roots)-1] != expected {
+		return verifiedBatch{}, errors.Wrapf(errUnexpectedBatchRoot, "expected=%#x, got=%#x", expected, roots[len(roots)-1])
+	}
+	for i := len(blks) - 1; i > 0; i-- {
+		if blks[i].Block().ParentRoot() != roots[i-1] {
+			return verifiedBatch{}, errors.Wrapf(errBatchNotChained, "parent of block at slot %d is not the block at slot %d",
+				blks[i].Block().Slot(), blks[i-1].Block().Slot())
+		}
+	}
+
+	set := ml_dsa_87.NewSet()
+	for i := range blks {
+		bset, err := v.blockSignatureBatch(blks[i], roots[i])
+		if err != nil {
+			return verifiedBatch{}, err
+		}
+		set.Join(bset)
+	}
+	valid, err := set.Verify()
+	if err != nil {
+		return verifiedBatch{}, errors.Wrap(err, "could not verify proposer signature batch")
+	}
+	if !valid {
+		return verifiedBatch{}, errInvalidBatchSignature
+	}
+	return verifiedBatch{blocks: blks, roots: roots}, nil
+}
+
+func (v *verifier) blockSignatureBatch(blk interfaces.ReadOnlySignedBeaconBlock, root [32]byte) (*ml_dsa_87.SignatureBatch, error) {
+	idx := blk.Block().ProposerIndex()
+	if uint64(idx) >= uint64(v.st.NumValidators()) {
+		return nil, errors.Wrapf(errProposerIndexTooHigh, "index=%d, slot=%d", idx, blk.Block().Slot())
+	}
+	epoch := slots.ToEpoch(blk.Block().Slot())
+	fork, err := forks.Fork(epoch)
+	if err != nil {
+		return nil, err
+	}
+	domain, err := signing.Domain(fork, epoch, params.BeaconConfig().DomainBeaconProposer, v.gvr[:])
+	if err != nil {
+		return nil, err
+	}
+	pub := v.st.PubkeyAtIndex(idx)
+	sig := blk.Signature()
+	return signing.BlockSignatureBatch(pub[:], sig[:], domain, func() ([32]byte, error) {
+		return root, nil
+	})
+}
@@ -0,0 +1,94 @@
+package backfill
+
+import (
+	"testing"
+
+	"github.com/theQRL/qrysm/beacon-chain/core/signing"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/blocks"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/crypto/ml_dsa_87"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+func signedChain(t *testing.T, st state.BeaconState, keys []ml_dsa_87.MLDSA87Key, parent [32]byte, n int) ([]interfaces.ReadOnlySignedBeaconBlock, [][32]byte) {
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, n)
+	roots := make([][32]byte, n)
+	for i := 0; i < n; i++ {
+		b := util.NewBeaconBlockZond()
+		b.Block.Slot = primitives.Slot(i + 1)
+		b.Block.ProposerIndex = primitives.ValidatorIndex(i % len(keys))
+		b.Block.ParentRoot = parent[:]
+		sig, err := signing.ComputeDomainAndSign(st, 0, b.Block, params.BeaconConfig().DomainBeaconProposer, keys[b.Block.ProposerIndex])
+		require.NoError(t, err)
+		b.Signature = sig
+		blk, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		blks[i], roots[i] = blk, root
+		parent = root
+	}
+	return blks, roots
+}
+
+func TestVerify(t *testing.T) {
+	st, keys := util.DeterministicGenesisStateZond(t, 4)
+	genesisRoot := [32]byte{0x01}
+	blks, roots := signedChain(t, st, keys, genesisRoot, 4)
+	v := newVerifier(st)
+
+	vb, err := v.verify(blks, roots[len(roots)-1])
+	require.NoError(t, err)
+	lowest, lowestRoot := vb.lowest()
+	require.Equal(t, primitives.Slot(1), lowest.Block().Slot())
+	require.Equal(t, roots[0], lowestRoot)
+	require.Equal(t, genesisRoot, lowest.Block().ParentRoot())
+
+	_, err = v.verify(nil, roots[0])
+	require.ErrorIs(t, err, errBatchEmpty)
+
+	_, err = v.verify(blks, roots[0])
+	require.ErrorIs(t, err, errUnexpectedBatchRoot)
+
+	gapped := []interfaces.ReadOnlySignedBeaconBlock{blks[0], blks[2], blks[3]}
+	_, err = v.verify(gapped, roots[3])
+	require.ErrorIs(t, err, errBatchNotChained)
+}
+
+func TestVerify_BadSignature(t *testing.T) {
+	st, keys := util.DeterministicGenesisStateZond(t, 4)
+	blks, roots := signedChain(t, st, keys, [32]byte{}, 2)
+
+	// Sign the highest block with the wrong key, the root is unchanged because the signature is not part of it.
+	wrong, err := blks[1].PbZondBlock()
+	require.NoError(t, err)
+	sig, err := signing.ComputeDomainAndSign(st, 0, wrong.Block, params.BeaconConfig().DomainBeaconProposer, keys[3])
+	require.NoError(t, err)
+	wrong.Signature = sig
+	blks[1], err = blocks.NewSignedBeaconBlock(wrong)
+	require.NoError(t, err)
+
+	_, err = newVerifier(st).verify(blks, roots[1])
+	require.ErrorIs(t, err, errInvalidBatchSignature)
+}
+
+func TestVerify_ProposerIndexTooHigh(t *testing.T) {
+	st, keys := util.DeterministicGenesisStateZond(t, 4)
+	b := util.NewBeaconBlockZond()
+	b.Block.Slot = 1
+	b.Block.ProposerIndex = 4
+	sig, err := signing.ComputeDomainAndSign(st, 0, b.Block, params.BeaconConfig().DomainBeaconProposer, keys[0])
+	require.NoError(t, err)
+	b.Signature = sig
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	_, err = newVerifier(st).verify([]interfaces.ReadOnlySignedBeaconBlock{blk}, root)
+	require.ErrorIs(t, err, errProposerIndexTooHigh)
+}
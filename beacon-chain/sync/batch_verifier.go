@@ -6,6 +6,7 @@ import (
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/beacon-chain/cache"
 	"github.com/theQRL/qrysm/crypto/ml_dsa_87"
 	"github.com/theQRL/qrysm/monitoring/tracing"
 	"go.opencensus.io/trace"
@@ -65,16 +66,20 @@ func (s *Service) validateWithBatchVerifier(ctx context.Context, message string,
 		log.WithError(resErr).Tracef("Could not perform batch verification of %s", message)
 		verified, err := set.Verify()
 		if err != nil {
+			cache.VerifiedSignatures.Invalidate(set)
 			verErr := errors.Wrapf(err, "Could not verify %s", message)
 			tracing.AnnotateError(span, verErr)
 			return pubsub.ValidationReject, verErr
 		}
 		if !verified {
+			cache.VerifiedSignatures.Invalidate(set)
 			verErr := errors.Errorf("Verification of %s failed", message)
 			tracing.AnnotateError(span, verErr)
 			return pubsub.ValidationReject, verErr
 		}
 	}
+	// Record the signatures, so that they are not verified again once included in a block.
+	set.MarkVerified(cache.VerifiedSignatures)
 	return pubsub.ValidationAccept, nil
 }
 
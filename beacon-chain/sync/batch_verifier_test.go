@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/theQRL/qrysm/beacon-chain/cache"
 	"github.com/theQRL/qrysm/beacon-chain/core/signing"
 	"github.com/theQRL/qrysm/crypto/ml_dsa_87"
 	"github.com/theQRL/qrysm/testing/assert"
@@ -85,3 +86,43 @@ func TestValidateWithBatchVerifier(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateWithBatchVerifier_RecordsVerifiedSignatures(t *testing.T) {
+	_, keys, err := util.DeterministicDepositsAndKeys(2)
+	assert.NoError(t, err)
+	msg := [32]byte{'a'}
+	set := &ml_dsa_87.SignatureBatch{
+		Messages:     [][32]byte{msg},
+		PublicKeys:   [][]ml_dsa_87.PublicKey{{keys[0].PublicKey()}},
+		Signatures:   [][][]byte{{keys[0].Sign(msg[:]).Marshal()}},
+		Descriptions: []string{signing.UnknownSignature},
+	}
+	badSet := &ml_dsa_87.SignatureBatch{
+		Messages:     [][32]byte{msg},
+		PublicKeys:   [][]ml_dsa_87.PublicKey{{keys[0].PublicKey(), keys[1].PublicKey()}},
+		Signatures:   [][][]byte{{set.Signatures[0][0], keys[1].Sign(make([]byte, 32)).Marshal()}},
+		Descriptions: []string{signing.UnknownSignature},
+	}
+	cache.VerifiedSignatures.Clear()
+	t.Cleanup(cache.VerifiedSignatures.Clear)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	svc := &Service{
+		ctx:           ctx,
+		cancel:        cancel,
+		signatureChan: make(chan *signatureVerifier, verifierLimit),
+	}
+	go svc.verifierRoutine()
+
+	res, err := svc.validateWithBatchVerifier(ctx, "random", set)
+	assert.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, res)
+	assert.Equal(t, true, cache.VerifiedSignatures.Verified(keys[0].PublicKey(), msg, set.Signatures[0][0]))
+
+	// A set found invalid does not leave any of its signatures in the cache.
+	res, err = svc.validateWithBatchVerifier(ctx, "random", badSet)
+	assert.NotNil(t, err)
+	assert.Equal(t, pubsub.ValidationReject, res)
+	assert.Equal(t, false, cache.VerifiedSignatures.Verified(keys[0].PublicKey(), msg, set.Signatures[0][0]))
+}
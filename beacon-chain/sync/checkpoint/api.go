@@ -8,6 +8,7 @@ import (
 	"github.com/theQRL/qrysm/api/client"
 	"github.com/theQRL/qrysm/api/client/beacon"
 	"github.com/theQRL/qrysm/beacon-chain/db"
+	"github.com/theQRL/qrysm/config/features"
 	"github.com/theQRL/qrysm/config/params"
 )
 
@@ -47,5 +48,16 @@ func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 	if err != nil {
 		return errors.Wrap(err, "Error retrieving checkpoint origin state and block")
 	}
-	return d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes())
+	if err := d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes()); err != nil {
+		return err
+	}
+	if !features.Get().EnableEIP4881 {
+		return nil
+	}
+	// The deposit snapshot is optional, without it the deposit cache is built from the deposit contract logs.
+	if err := od.DownloadDepositSnapshot(ctx, dl.c); err != nil {
+		log.WithError(err).Warn("Could not download finalized deposit snapshot, all deposit contract logs will be processed")
+		return nil
+	}
+	return saveDepositSnapshot(ctx, d, od.DepositSnapshot())
 }
@@ -0,0 +1,67 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/beacon-chain/cache/depositsnapshot"
+	"github.com/theQRL/qrysm/beacon-chain/db"
+	"github.com/theQRL/qrysm/config/features"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+)
+
+// saveDepositSnapshot validates the finalized deposit snapshot against the origin state saved by SaveOrigin and
+// saves it as the execution chain data, so that the execution service initializes its deposit cache from the
+// snapshot instead of processing all deposit contract logs again.
+func saveDepositSnapshot(ctx context.Context, d db.Database, ds *qrysmpb.DepositSnapshot) error {
+	if !features.Get().EnableEIP4881 {
+		log.Warnf("Ignoring deposit snapshot, it can only be used with --%s", features.EnableEIP4881.Name)
+		return nil
+	}
+	if _, err := depositsnapshot.DepositTreeFromSnapshotProto(ds); err != nil {
+		return errors.Wrap(err, "invalid deposit snapshot")
+	}
+	root, err := d.OriginCheckpointBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get origin checkpoint block root")
+	}
+	st, err := d.State(ctx, root)
+	if err != nil {
+		return errors.Wrap(err, "could not get origin checkpoint state")
+	}
+	if st == nil || st.IsNil() {
+		return errors.New("origin checkpoint state not found")
+	}
+	// The deposits of the snapshot must already be processed by the checkpoint state, as the deposit cache
+	// does not hold the deposits covered by the snapshot.
+	if ds.DepositCount > st.ExecutionDepositIndex() {
+		return errors.Errorf("deposit snapshot has %d deposits, but only %d are processed by the checkpoint state",
+			ds.DepositCount, st.ExecutionDepositIndex())
+	}
+	executionData := st.ExecutionData()
+	if ds.DepositCount == executionData.DepositCount && !bytes.Equal(ds.DepositRoot, executionData.DepositRoot) {
+		return errors.Errorf("deposit snapshot root %#x does not match the deposit root %#x of the checkpoint state",
+			ds.DepositRoot, executionData.DepositRoot)
+	}
+	// The snapshot covers the deposits up to its execution block, so processing deposit logs resumes from there.
+	latest := &qrysmpb.LatestExecutionData{BlockHash: []byte{}}
+	if ds.ExecutionDepth > 0 {
+		latest = &qrysmpb.LatestExecutionData{
+			BlockHeight:        ds.ExecutionDepth,
+			BlockHash:          ds.ExecutionHash,
+			LastRequestedBlock: ds.ExecutionDepth,
+		}
+	}
+	if err := d.SaveExecutionChainData(ctx, &qrysmpb.ExecutionChainData{
+		CurrentExecutionData: latest,
+		DepositSnapshot:      ds,
+	}); err != nil {
+		return errors.Wrap(err, "could not save deposit snapshot")
+	}
+	log.WithField("depositCount", ds.DepositCount).
+		WithField("executionBlockHeight", latest.BlockHeight).
+		Info("Initialized deposits from finalized deposit snapshot")
+	return nil
+}
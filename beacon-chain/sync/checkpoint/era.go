@@ -0,0 +1,270 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/beacon-chain/core/transition"
+	"github.com/theQRL/qrysm/beacon-chain/db"
+	"github.com/theQRL/qrysm/beacon-chain/era"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	"github.com/theQRL/qrysm/encoding/ssz/detect"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/time/slots"
+)
+
+// NewEraInitializer creates an Initializer which imports the era archives of the directory, as written by
+// `qrysmctl db export-era`.
+func NewEraInitializer(dir string) (*EraInitializer, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error checking existence of era directory %s", dir)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+	return &EraInitializer{dir: dir}, nil
+}
+
+// EraInitializer initializes a beacon-node database from era archives. The archives must form a contiguous range
+// of eras starting at era 0 or 1, so that their blocks link to the genesis block of the database. The blocks are
+// replayed through the state transition from the genesis state, verifying their signatures and state roots, and the
+// state reached at the end of every archive must match the state of the archive. The state of the last archive
+// becomes the finalized state the node syncs from.
+type EraInitializer struct {
+	dir string
+}
+
+// Initialize replays and imports the blocks of the archives and marks the state of the last archive as finalized.
+func (ei *EraInitializer) Initialize(ctx context.Context, d db.Database) error {
+	origin, err := d.OriginCheckpointBlockRoot(ctx)
+	if err == nil && origin != params.BeaconConfig().ZeroHash {
+		log.Warnf("Origin checkpoint root %#x found in db, ignoring era import", origin)
+		return nil
+	}
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return errors.Wrap(err, "error while checking database for origin root")
+	}
+	finalized, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error while checking database for finalized checkpoint")
+	}
+	if finalized.Epoch > 0 {
+		log.Warnf("Database is finalized at epoch %d, ignoring era import", finalized.Epoch)
+		return nil
+	}
+
+	files, err := era.List(ei.dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no era archives found in %s", ei.dir)
+	}
+	if files[0].Era > 1 {
+		return fmt.Errorf("era archives start at era %d, they must start at era 0 or 1 to link to genesis", files[0].Era)
+	}
+	if files[len(files)-1].Era == 0 {
+		return errors.New("era archives do not hold any block")
+	}
+	genesisBlock, err := d.GenesisBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block")
+	}
+	headRoot, err := d.GenesisBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block root")
+	}
+	st, err := d.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis state")
+	}
+	if st == nil || st.IsNil() {
+		return errors.New("no genesis state in the database")
+	}
+
+	for i, f := range files {
+		if i > 0 && f.Era != files[i-1].Era+1 {
+			return fmt.Errorf("era archives are not contiguous, era %d follows era %d", f.Era, files[i-1].Era)
+		}
+		if f.Era == 0 {
+			if err := verifyGenesisEra(ctx, f, genesisBlock); err != nil {
+				return err
+			}
+			continue
+		}
+		st, headRoot, err = importEra(ctx, d, f, st, headRoot)
+		if err != nil {
+			return err
+		}
+		log.WithField("era", f.Era).WithField("path", f.Path).Info("Imported era")
+	}
+	return finalizeEra(ctx, d, st, headRoot)
+}
+
+var _ Initializer = &EraInitializer{}
+
+// readEraState reads and decodes the state of the archive.
+func readEraState(r *era.Reader, f era.File) (*detect.VersionedUnmarshaler, state.BeaconState, error) {
+	serState, err := r.State()
+	if err != nil {
+		return nil, nil, err
+	}
+	cf, err := detect.FromState(serState)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not detect config and fork of the state of era %d", f.Era)
+	}
+	st, err := cf.UnmarshalBeaconState(serState)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not unmarshal the state of era %d", f.Era)
+	}
+	if st.Slot() != f.Era.StateSlot() || r.StateSlot() != f.Era.StateSlot() {
+		return nil, nil, fmt.Errorf("state of era %d is at slot %d, expected slot %d", f.Era, st.Slot(), f.Era.StateSlot())
+	}
+	return cf, st, nil
+}
+
+// verifyGenesisEra checks that the state of archive 0 is the genesis state of the database.
+func verifyGenesisEra(ctx context.Context, f era.File, genesisBlock interfaces.ReadOnlySignedBeaconBlock) (err error) {
+	r, err := era.Open(f.Path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := r.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+	_, st, err := readEraState(r, f)
+	if err != nil {
+		return err
+	}
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not compute genesis state root")
+	}
+	if want := genesisBlock.Block().StateRoot(); root != want {
+		return fmt.Errorf("era 0 holds state %#x, the genesis state of the database is %#x", root, want)
+	}
+	return nil
+}
+
+// importEra replays the blocks of the archive on top of the given state, whose latest block is the given parent root,
+// and saves them. It returns the state at the end of the archive, which must match the state of the archive, along
+// with the root of its last block.
+func importEra(
+	ctx context.Context, d db.Database, f era.File, st state.BeaconState, parentRoot [32]byte,
+) (_ state.BeaconState, _ [32]byte, err error) {
+	r, err := era.Open(f.Path)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	defer func() {
+		if cerr := r.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+	cf, eraState, err := readEraState(r, f)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	if r.StartSlot() != f.Era.StartSlot() {
+		return nil, [32]byte{}, fmt.Errorf("blocks of era %d start at slot %d, expected slot %d", f.Era, r.StartSlot(), f.Era.StartSlot())
+	}
+
+	blockRoots := eraState.BlockRoots()
+	n := params.BeaconConfig().SlotsPerHistoricalRoot
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	for slot := r.StartSlot(); slot < r.StateSlot(); slot++ {
+		want := bytesutil.ToBytes32(blockRoots[slot%n])
+		serBlock, err := r.Block(slot)
+		if err != nil {
+			return nil, [32]byte{}, err
+		}
+		if serBlock == nil {
+			// The block roots of a skipped slot repeat the root of the previous block.
+			if want != parentRoot {
+				return nil, [32]byte{}, fmt.Errorf("era %d has no block at slot %d, the state expects block %#x", f.Era, slot, want)
+			}
+			continue
+		}
+		blk, err := cf.UnmarshalBeaconBlock(serBlock)
+		if err != nil {
+			return nil, [32]byte{}, errors.Wrapf(err, "could not unmarshal block at slot %d", slot)
+		}
+		if blk.Block().Slot() != slot {
+			return nil, [32]byte{}, fmt.Errorf("block at slot %d of era %d claims slot %d", slot, f.Era, blk.Block().Slot())
+		}
+		if blk.Block().ParentRoot() != parentRoot {
+			return nil, [32]byte{}, fmt.Errorf("block at slot %d does not descend from block %#x", slot, parentRoot)
+		}
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return nil, [32]byte{}, errors.Wrapf(err, "could not compute root of block at slot %d", slot)
+		}
+		if root != want {
+			return nil, [32]byte{}, fmt.Errorf("block at slot %d has root %#x, the state expects %#x", slot, root, want)
+		}
+		st, err = transition.ExecuteStateTransition(ctx, st, blk)
+		if err != nil {
+			return nil, [32]byte{}, errors.Wrapf(err, "could not replay block at slot %d", slot)
+		}
+		blks = append(blks, blk)
+		parentRoot = root
+	}
+	if st.Slot() < r.StateSlot() {
+		st, err = transition.ProcessSlots(ctx, st, r.StateSlot())
+		if err != nil {
+			return nil, [32]byte{}, errors.Wrapf(err, "could not process slots up to slot %d", r.StateSlot())
+		}
+	}
+	got, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "could not compute root of replayed state")
+	}
+	want, err := eraState.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrapf(err, "could not compute root of the state of era %d", f.Era)
+	}
+	if got != want {
+		return nil, [32]byte{}, fmt.Errorf("replaying era %d leads to state %#x, the archive holds state %#x", f.Era, got, want)
+	}
+	if err := d.SaveBlocks(ctx, blks); err != nil {
+		return nil, [32]byte{}, errors.Wrapf(err, "could not save blocks of era %d", f.Era)
+	}
+	return st, parentRoot, nil
+}
+
+// finalizeEra saves the state of the last archive under the root of its last block and marks that block as the
+// finalized head of the chain, the same way SaveOrigin does for a checkpoint sync.
+func finalizeEra(ctx context.Context, d db.Database, st state.BeaconState, blockRoot [32]byte) error {
+	blk, err := d.Block(ctx, blockRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get block %#x", blockRoot)
+	}
+	if err := d.SaveState(ctx, st, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save state")
+	}
+	if err := d.SaveStateSummary(ctx, &qrysmpb.StateSummary{Slot: st.Slot(), Root: blockRoot[:]}); err != nil {
+		return errors.Wrap(err, "could not save state summary")
+	}
+	if err := d.SaveHeadBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	chkpt := &qrysmpb.Checkpoint{Epoch: slots.ToEpoch(blk.Block().Slot()), Root: blockRoot[:]}
+	if err := d.SaveJustifiedCheckpoint(ctx, chkpt); err != nil {
+		return errors.Wrap(err, "could not mark last era block as justified")
+	}
+	if err := d.SaveFinalizedCheckpoint(ctx, chkpt); err != nil {
+		return errors.Wrap(err, "could not mark last era block as finalized")
+	}
+	log.WithField("root", fmt.Sprintf("%#x", blockRoot)).WithField("slot", blk.Block().Slot()).
+		Info("Imported era archives")
+	return nil
+}
@@ -0,0 +1,110 @@
+package checkpoint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/theQRL/qrysm/beacon-chain/core/transition"
+	"github.com/theQRL/qrysm/beacon-chain/db"
+	testDB "github.com/theQRL/qrysm/beacon-chain/db/testing"
+	"github.com/theQRL/qrysm/beacon-chain/era"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/blocks"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+func writeEraArchive(t *testing.T, dir string, e era.Era, blks []*qrysmpb.SignedBeaconBlockZond, st state.BeaconState) {
+	root, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	f, err := os.Create(filepath.Join(dir, era.FileName(params.BeaconConfig().ConfigName, e, root)))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	w, err := era.NewWriter(f, e.StartSlot())
+	require.NoError(t, err)
+	for _, b := range blks {
+		enc, err := b.MarshalSSZ()
+		require.NoError(t, err)
+		require.NoError(t, w.AddBlock(b.Block.Slot, enc))
+	}
+	enc, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, w.Finish(e.StateSlot(), enc))
+}
+
+func TestEraInitializer(t *testing.T) {
+	ctx := context.Background()
+	genesisState, keys := util.DeterministicGenesisStateZond(t, 64)
+
+	// Build the chain of era 1 with blocks at slots 1 and 2.
+	st := genesisState.Copy()
+	var blks []*qrysmpb.SignedBeaconBlockZond
+	for slot := primitives.Slot(1); slot <= 2; slot++ {
+		b, err := util.GenerateFullBlockZond(st, keys, util.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, wsb)
+		require.NoError(t, err)
+		blks = append(blks, b)
+	}
+	eraState, err := transition.ProcessSlots(ctx, st, era.Era(1).StateSlot())
+	require.NoError(t, err)
+	lastRoot, err := blks[1].Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, blks []*qrysmpb.SignedBeaconBlockZond, st state.BeaconState) (*EraInitializer, db.Database) {
+		dir := t.TempDir()
+		writeEraArchive(t, dir, 1, blks, st)
+		d := testDB.SetupDB(t)
+		require.NoError(t, d.SaveGenesisData(ctx, genesisState))
+		ei, err := NewEraInitializer(dir)
+		require.NoError(t, err)
+		return ei, d
+	}
+
+	t.Run("replays the archives", func(t *testing.T) {
+		ei, d := setup(t, blks, eraState)
+		require.NoError(t, ei.Initialize(ctx, d))
+
+		finalized, err := d.FinalizedCheckpoint(ctx)
+		require.NoError(t, err)
+		require.DeepEqual(t, lastRoot[:], finalized.Root)
+		for _, b := range blks {
+			root, err := b.Block.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, true, d.HasBlock(ctx, root))
+		}
+		saved, err := d.State(ctx, lastRoot)
+		require.NoError(t, err)
+		want, err := eraState.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		got, err := saved.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	})
+	t.Run("invalid block signature", func(t *testing.T) {
+		// The signature is not part of the block root, so the archive still matches the block roots of its state.
+		forged := qrysmpb.CopySignedBeaconBlockZond(blks[1])
+		forged.Signature = blks[0].Signature
+		ei, d := setup(t, []*qrysmpb.SignedBeaconBlockZond{blks[0], forged}, eraState)
+		require.ErrorContains(t, "could not replay block at slot 2", ei.Initialize(ctx, d))
+		require.Equal(t, false, d.HasBlock(ctx, lastRoot))
+	})
+	t.Run("state does not match replay", func(t *testing.T) {
+		forged := eraState.Copy()
+		require.NoError(t, forged.UpdateBalancesAtIndex(0, 1))
+		ei, d := setup(t, blks, forged)
+		require.ErrorContains(t, "replaying era 1 leads to state", ei.Initialize(ctx, d))
+		finalized, err := d.FinalizedCheckpoint(ctx)
+		require.NoError(t, err)
+		require.Equal(t, primitives.Epoch(0), finalized.Epoch)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/theQRL/qrysm/beacon-chain/db"
 	"github.com/theQRL/qrysm/config/params"
 	"github.com/theQRL/qrysm/io/file"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 )
 
 // Initializer describes a type that is able to obtain the checkpoint sync data (BeaconState and SignedBeaconBlock)
@@ -20,8 +21,9 @@ type Initializer interface {
 }
 
 // NewFileInitializer validates the given path information and creates an Initializer which will
-// use the provided state and block files to prepare the node for checkpoint sync.
-func NewFileInitializer(blockPath string, statePath string) (*FileInitializer, error) {
+// use the provided state and block files to prepare the node for checkpoint sync. The deposit snapshot
+// file is optional and may be left empty.
+func NewFileInitializer(blockPath string, statePath string, depositSnapshotPath string) (*FileInitializer, error) {
 	var err error
 	if err = existsAndIsFile(blockPath); err != nil {
 		return nil, err
@@ -29,15 +31,21 @@ func NewFileInitializer(blockPath string, statePath string) (*FileInitializer, e
 	if err = existsAndIsFile(statePath); err != nil {
 		return nil, err
 	}
+	if depositSnapshotPath != "" {
+		if err = existsAndIsFile(depositSnapshotPath); err != nil {
+			return nil, err
+		}
+	}
 	// stat just to make sure it actually exists and is a file
-	return &FileInitializer{blockPath: blockPath, statePath: statePath}, nil
+	return &FileInitializer{blockPath: blockPath, statePath: statePath, depositSnapshotPath: depositSnapshotPath}, nil
 }
 
 // FileInitializer initializes a beacon-node database to use checkpoint sync,
 // using ssz-encoded block and state data stored in files on the local filesystem.
 type FileInitializer struct {
-	blockPath string
-	statePath string
+	blockPath           string
+	statePath           string
+	depositSnapshotPath string
 }
 
 // Initialize is called in the BeaconNode db startup code if an Initializer is present.
@@ -60,7 +68,21 @@ func (fi *FileInitializer) Initialize(ctx context.Context, d db.Database) error
 	if err != nil {
 		return errors.Wrapf(err, "error reading state file %s for checkpoint sync init", fi.blockPath)
 	}
-	return d.SaveOrigin(ctx, serState, serBlock)
+	if err := d.SaveOrigin(ctx, serState, serBlock); err != nil {
+		return err
+	}
+	if fi.depositSnapshotPath == "" {
+		return nil
+	}
+	serSnapshot, err := file.ReadFileAsBytes(fi.depositSnapshotPath)
+	if err != nil {
+		return errors.Wrapf(err, "error reading deposit snapshot file %s for checkpoint sync init", fi.depositSnapshotPath)
+	}
+	ds := &qrysmpb.DepositSnapshot{}
+	if err := ds.UnmarshalSSZ(serSnapshot); err != nil {
+		return errors.Wrapf(err, "error unmarshaling deposit snapshot file %s", fi.depositSnapshotPath)
+	}
+	return saveDepositSnapshot(ctx, d, ds)
 }
 
 var _ Initializer = &FileInitializer{}
@@ -0,0 +1,14 @@
+//go:build !linux && !darwin
+
+package sync
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// processCPUTime is not supported on this platform, the load of the node then only depends on its bandwidth.
+func processCPUTime() (time.Duration, error) {
+	return 0, errors.New("process CPU time is not supported on this platform")
+}
@@ -75,6 +75,24 @@ var (
 			Buckets: []float64{5, 10, 50, 100, 150, 250, 500, 1000, 2000},
 		},
 	)
+	rpcThrottledRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rpc_throttled_requests_total",
+			Help: "Count the number of req/resp requests of a connected peer rejected by the rate limiter.",
+		},
+		[]string{"peer_id", "topic"},
+	)
+	rpcServedBytes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rpc_served_bytes_total",
+			Help: "Count the number of bytes of blocks served to a connected peer in req/resp responses.",
+		},
+		[]string{"peer_id"},
+	)
+	rpcNodeLoad = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "rpc_rate_limiter_node_load",
+		Help: "The load of the node used to scale req/resp rate limits, from 0 when idle to 1 when fully loaded.",
+	})
 	arrivalBlockPropagationHistogram = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "block_arrival_latency_milliseconds",
@@ -0,0 +1,90 @@
+package sync
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"time"
+
+	leakybucket "github.com/theQRL/qrysm/container/leaky-bucket"
+)
+
+// nodeLoadSamplePeriod is how often the CPU usage of the node is sampled.
+const nodeLoadSamplePeriod = 5 * time.Second
+
+const (
+	// Rate limits start shrinking once the load of the node goes over this level...
+	highLoadThreshold = 0.7
+	// ...and are at their lowest when the node is fully loaded.
+	minQuotaFactor = 0.25
+)
+
+// nodeLoad tracks how busy the node is serving req/resp requests, from the CPU usage of the process and the
+// bandwidth used by responses to peers.
+type nodeLoad struct {
+	lock     sync.Mutex
+	cpu      float64
+	cpuTime  time.Duration
+	sampled  time.Time
+	outbound *leakybucket.LeakyBucket
+}
+
+// newNodeLoad creates a nodeLoad. The node is fully loaded when it serves outboundLimit bytes per second, or when the
+// process uses all the CPUs. The outbound bandwidth is ignored when outboundLimit is 0.
+func newNodeLoad(outboundLimit uint64) *nodeLoad {
+	n := &nodeLoad{}
+	if outboundLimit > 0 {
+		n.outbound = leakybucket.NewLeakyBucket(float64(outboundLimit), int64(outboundLimit), time.Second)
+	}
+	n.sampleCPU()
+	return n
+}
+
+// sampleCPU updates the CPU usage of the process since the previous sample.
+func (n *nodeLoad) sampleCPU() {
+	cpuTime, err := processCPUTime()
+	if err != nil {
+		return
+	}
+	now := time.Now()
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if !n.sampled.IsZero() {
+		elapsed := now.Sub(n.sampled) * time.Duration(runtime.NumCPU())
+		if elapsed > 0 {
+			n.cpu = math.Min(float64(cpuTime-n.cpuTime)/float64(elapsed), 1)
+		}
+	}
+	n.cpuTime, n.sampled = cpuTime, now
+}
+
+// addOutbound accounts for bytes sent to a peer.
+func (n *nodeLoad) addOutbound(bytes int64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.outbound != nil {
+		n.outbound.Add(bytes)
+	}
+}
+
+// level returns the load of the node, from 0 when idle to 1 when fully loaded.
+func (n *nodeLoad) level() float64 {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	level := n.cpu
+	if n.outbound != nil {
+		level = math.Max(level, float64(n.outbound.Count())/float64(n.outbound.Capacity()))
+	}
+	return level
+}
+
+// quotaFactor returns the factor applied to the rate limits of peers at the current load of the node.
+func (n *nodeLoad) quotaFactor() float64 {
+	level := n.level()
+	rpcNodeLoad.Set(level)
+	if level <= highLoadThreshold {
+		return 1
+	}
+	return math.Max(1-(level-highLoadThreshold)/(1-highLoadThreshold)*(1-minQuotaFactor), minQuotaFactor)
+}
@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"math"
 	"reflect"
 	"sync"
 	"time"
@@ -8,10 +9,13 @@ import (
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/theQRL/qrysm/beacon-chain/p2p"
 	p2ptypes "github.com/theQRL/qrysm/beacon-chain/p2p/types"
 	"github.com/theQRL/qrysm/cmd/beacon-chain/flags"
+	"github.com/theQRL/qrysm/config/features"
+	"github.com/theQRL/qrysm/config/params"
 	leakybucket "github.com/theQRL/qrysm/container/leaky-bucket"
 	"github.com/trailofbits/go-mutexasserts"
 )
@@ -28,12 +32,17 @@ const rpcLimiterTopic = "rpc-limiter-topic"
 
 type limiter struct {
 	limiterMap map[string]*leakybucket.Collector
-	p2p        p2p.P2P
+	// bytesCollector limits the bytes of blocks served to each peer, it is nil when byte limits are disabled.
+	bytesCollector *leakybucket.Collector
+	load           *nodeLoad
+	trustedFactor  float64
+	p2p            p2p.P2P
 	sync.RWMutex
 }
 
 // Instantiates a multi-rpc protocol rate limiter, providing
-// separate collectors for each topic.
+// separate collectors for each topic. The limits of a peer are scaled
+// by its quota factor, see quotaFactor.
 func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	// add encoding suffix
 	addEncoding := func(topic string) string {
@@ -63,10 +72,40 @@ func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	// BlockByRange requests
 	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV2)] = blockCollectorV2
 
+	if features.Get().EnableLightClient {
+		lightClientCollector := leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+		topicMap[addEncoding(p2p.RPCLightClientBootstrapTopicV1)] = lightClientCollector
+		topicMap[addEncoding(p2p.RPCLightClientFinalityUpdateTopicV1)] = lightClientCollector
+		topicMap[addEncoding(p2p.RPCLightClientOptimisticUpdateTopicV1)] = lightClientCollector
+		// Updates by range are charged per requested period.
+		topicMap[addEncoding(p2p.RPCLightClientUpdatesByRangeTopicV1)] = leakybucket.NewCollector(
+			float64(params.BeaconNetworkConfig().MaxRequestLightClientUpdates),
+			int64(params.BeaconNetworkConfig().MaxRequestLightClientUpdates),
+			blockBucketPeriod,
+			false, /* deleteEmptyBuckets */
+		)
+	}
+
 	// General topic for all rpc requests.
 	topicMap[rpcLimiterTopic] = leakybucket.NewCollector(5, defaultBurstLimit*2, leakyBucketPeriod, false /* deleteEmptyBuckets */)
 
-	return &limiter{limiterMap: topicMap, p2p: p2pProvider}
+	var bytesCollector *leakybucket.Collector
+	if bytesPerSecond := flags.Get().BlockBytesLimit; bytesPerSecond > 0 {
+		bytesBurst := int64(bytesPerSecond) * int64(max(flags.Get().BlockBatchLimitBurstFactor, 1))
+		bytesCollector = leakybucket.NewCollector(float64(bytesPerSecond), bytesBurst, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	}
+	trustedFactor := flags.Get().TrustedPeerRateLimitFactor
+	if trustedFactor <= 0 {
+		trustedFactor = 1
+	}
+
+	return &limiter{
+		limiterMap:     topicMap,
+		bytesCollector: bytesCollector,
+		load:           newNodeLoad(flags.Get().RPCOutboundBytesLimit),
+		trustedFactor:  trustedFactor,
+		p2p:            p2pProvider,
+	}
 }
 
 // Returns the current topic collector for the provided topic.
@@ -93,7 +132,8 @@ func (l *limiter) validateRequest(stream network.Stream, amt uint64) error {
 	if amt == 0 {
 		amt = 1
 	}
-	if amt > uint64(remaining) {
+	if l.cost(pid, amt) > uint64(remaining) {
+		rpcThrottledRequests.WithLabelValues(pid.String(), topic).Inc()
 		l.downscorePeer(pid, topic, "rateLimitExceeded")
 		writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
 		return p2ptypes.ErrRateLimited
@@ -113,7 +153,8 @@ func (l *limiter) validateRawRpcRequest(stream network.Stream, amt uint64) error
 	}
 	remaining := collector.Remaining(pid.String())
 
-	if amt > uint64(remaining) {
+	if l.cost(pid, amt) > uint64(remaining) {
+		rpcThrottledRequests.WithLabelValues(pid.String(), rpcLimiterTopic).Inc()
 		l.downscorePeer(pid, rpcLimiterTopic, "rawRateLimitExceeded")
 		writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
 		return p2ptypes.ErrRateLimited
@@ -134,8 +175,11 @@ func (l *limiter) add(stream network.Stream, amt int64) {
 		log.Errorf("collector with topic '%s' does not exist", topic)
 		return
 	}
-	key := stream.Conn().RemotePeer().String()
-	collector.Add(key, amt)
+	pid := stream.Conn().RemotePeer()
+	if amt > 0 {
+		amt = int64(l.cost(pid, uint64(amt)))
+	}
+	collector.Add(pid.String(), amt)
 }
 
 // adds the cost to our leaky bucket for the peer.
@@ -151,8 +195,87 @@ func (l *limiter) addRawStream(stream network.Stream) {
 		log.Errorf("collector with topic '%s' does not exist", topic)
 		return
 	}
-	key := stream.Conn().RemotePeer().String()
-	collector.Add(key, 1)
+	pid := stream.Conn().RemotePeer()
+	collector.Add(pid.String(), int64(l.cost(pid, 1)))
+}
+
+// validateBytes checks that the peer has not used up the bytes of blocks it may be served. The response being
+// written when the limit is reached is not cut, so the limit may be exceeded by up to one block.
+func (l *limiter) validateBytes(stream network.Stream) error {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.bytesCollector == nil {
+		return nil
+	}
+	pid := stream.Conn().RemotePeer()
+	if l.bytesCollector.Remaining(pid.String()) > 0 {
+		return nil
+	}
+	// Large responses are not a fault of the peer, so it is not downscored.
+	topic := string(stream.Protocol())
+	rpcThrottledRequests.WithLabelValues(pid.String(), topic).Inc()
+	l.topicLogger(topic).WithField("peerID", pid.String()).Debug("Block bytes limit reached")
+	writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
+	return p2ptypes.ErrRateLimited
+}
+
+// addBytes accounts for bytes of blocks served to the peer.
+func (l *limiter) addBytes(stream network.Stream, n int) {
+	if n <= 0 {
+		return
+	}
+	pid := stream.Conn().RemotePeer()
+	rpcServedBytes.WithLabelValues(pid.String()).Add(float64(n))
+	l.load.addOutbound(int64(n))
+
+	l.Lock()
+	defer l.Unlock()
+	if l.bytesCollector != nil {
+		l.bytesCollector.Add(pid.String(), int64(l.cost(pid, uint64(n))))
+	}
+}
+
+// forgetPeer cleans up the state kept for a disconnected peer. Its metrics are deleted, so that their label
+// values are bounded by the connected peers. Its buckets are only removed once empty, as removing a bucket that
+// is still draining would reset the limits of a peer that reconnects.
+func (l *limiter) forgetPeer(pid peer.ID) {
+	rpcThrottledRequests.DeletePartialMatch(prometheus.Labels{"peer_id": pid.String()})
+	rpcServedBytes.DeleteLabelValues(pid.String())
+
+	l.Lock()
+	defer l.Unlock()
+	collectors := make([]*leakybucket.Collector, 0, len(l.limiterMap)+1)
+	for _, collector := range l.limiterMap {
+		collectors = append(collectors, collector)
+	}
+	if l.bytesCollector != nil {
+		collectors = append(collectors, l.bytesCollector)
+	}
+	for _, collector := range collectors {
+		if collector.Count(pid.String()) == 0 {
+			collector.Remove(pid.String())
+		}
+	}
+}
+
+// quotaFactor is the factor by which the rate limits of the peer are multiplied. Trusted peers form a class of their
+// own with a fixed factor. The factor of other peers shrinks as their score drops below zero and as the load of the
+// node grows, down to minQuotaFactor.
+func (l *limiter) quotaFactor(pid peer.ID) float64 {
+	peers := l.p2p.Peers()
+	if peers.IsTrustedPeers(pid) {
+		return l.trustedFactor
+	}
+	scoreFactor := math.Min(math.Max(1+peers.Scorers().Score(pid), minQuotaFactor), 1)
+	return math.Max(scoreFactor*l.load.quotaFactor(), minQuotaFactor)
+}
+
+// cost returns what serving amt to the peer takes from its buckets: a larger quota factor makes every request
+// cheaper, rather than the buckets larger, as the buckets are shared by all peers of a collector. Requests always cost
+// at least 1.
+func (l *limiter) cost(pid peer.ID, amt uint64) uint64 {
+	return uint64(math.Ceil(float64(amt) / l.quotaFactor(pid)))
 }
 
 // frees all the collectors and removes them.
@@ -175,6 +298,10 @@ func (l *limiter) free() {
 		delete(l.limiterMap, t)
 		tempMap[ptr] = true
 	}
+	if l.bytesCollector != nil {
+		l.bytesCollector.Free()
+		l.bytesCollector = nil
+	}
 }
 
 // not to be used outside the rate limiter file as it is unsafe for concurrent usage
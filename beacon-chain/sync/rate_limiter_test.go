@@ -7,10 +7,12 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/theQRL/qrysm/beacon-chain/p2p"
 	mockp2p "github.com/theQRL/qrysm/beacon-chain/p2p/testing"
 	p2ptypes "github.com/theQRL/qrysm/beacon-chain/p2p/types"
+	"github.com/theQRL/qrysm/cmd/beacon-chain/flags"
 	"github.com/theQRL/qrysm/testing/assert"
 	"github.com/theQRL/qrysm/testing/require"
 	"github.com/theQRL/qrysm/testing/util"
@@ -110,3 +112,118 @@ func Test_limiter_retrieveCollector_requiresLock(t *testing.T) {
 	_, err := l.retrieveCollector("")
 	require.ErrorContains(t, "caller must hold read/write lock", err)
 }
+
+func TestRateLimiter_QuotaFactor(t *testing.T) {
+	resetFlags := flags.Get()
+	defer flags.Init(resetFlags)
+	flags.Init(&flags.GlobalFlags{
+		BlockBatchLimit:            64,
+		BlockBatchLimitBurstFactor: 10,
+		TrustedPeerRateLimitFactor: 4,
+	})
+
+	p1 := mockp2p.NewTestP2P(t)
+	trusted := mockp2p.NewTestP2P(t)
+	bad := mockp2p.NewTestP2P(t)
+	neutral := mockp2p.NewTestP2P(t)
+	for _, p := range []*mockp2p.TestP2P{trusted, bad, neutral} {
+		p1.Peers().Add(nil, p.PeerID(), nil, network.DirInbound)
+	}
+	p1.Peers().SetTrustedPeers([]peer.ID{trusted.PeerID()})
+	for range 5 {
+		p1.Peers().Scorers().BadResponsesScorer().Increment(bad.PeerID())
+	}
+	rlimiter := newRateLimiter(p1)
+
+	assert.Equal(t, float64(1), rlimiter.quotaFactor(neutral.PeerID()))
+	assert.Equal(t, float64(4), rlimiter.quotaFactor(trusted.PeerID()))
+	assert.Equal(t, true, rlimiter.quotaFactor(bad.PeerID()) < 1)
+	assert.Equal(t, uint64(64), rlimiter.cost(neutral.PeerID(), 64))
+	assert.Equal(t, uint64(16), rlimiter.cost(trusted.PeerID(), 64))
+	assert.Equal(t, uint64(1), rlimiter.cost(trusted.PeerID(), 1))
+	assert.Equal(t, true, rlimiter.cost(bad.PeerID(), 64) > 64)
+
+	// Under load, the quota of peers shrinks but not the quota of trusted peers.
+	rlimiter.load.cpu = 1
+	assert.Equal(t, minQuotaFactor, rlimiter.quotaFactor(neutral.PeerID()))
+	assert.Equal(t, minQuotaFactor, rlimiter.quotaFactor(bad.PeerID()))
+	assert.Equal(t, float64(4), rlimiter.quotaFactor(trusted.PeerID()))
+}
+
+func TestRateLimiter_ExceedBlockBytes(t *testing.T) {
+	resetFlags := flags.Get()
+	defer flags.Init(resetFlags)
+	flags.Init(&flags.GlobalFlags{
+		BlockBatchLimit:            64,
+		BlockBatchLimitBurstFactor: 2,
+		BlockBytesLimit:            1,
+	})
+
+	p1 := mockp2p.NewTestP2P(t)
+	p2 := mockp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	rlimiter := newRateLimiter(p1)
+
+	topic := p2p.RPCBlocksByRangeTopicV2 + p1.Encoding().ProtocolSuffix()
+	wg := sync.WaitGroup{}
+	p2.BHost.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {
+		defer wg.Done()
+		code, errMsg, err := readStatusCodeNoDeadline(stream, p2.Encoding())
+		require.NoError(t, err, "could not read incoming stream")
+		assert.Equal(t, responseCodeInvalidRequest, code, "not equal response codes")
+		assert.Equal(t, p2ptypes.ErrRateLimited.Error(), errMsg, "not equal errors")
+	})
+	wg.Add(1)
+	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
+	require.NoError(t, err, "could not create stream")
+
+	require.NoError(t, rlimiter.validateBytes(stream))
+	rlimiter.addBytes(stream, 1)
+	require.NoError(t, rlimiter.validateBytes(stream))
+	assert.Equal(t, int64(1), rlimiter.bytesCollector.Remaining(p2.PeerID().String()))
+	// The bytes of the last block may go over the limit, further blocks are refused.
+	rlimiter.addBytes(stream, 5)
+	assert.ErrorContains(t, p2ptypes.ErrRateLimited.Error(), rlimiter.validateBytes(stream))
+	assert.Equal(t, false, p1.Peers().IsBad(p2.PeerID()), "peer is downscored for large responses")
+	require.NoError(t, stream.Close(), "could not close stream")
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestRateLimiter_ForgetPeer(t *testing.T) {
+	p1 := mockp2p.NewTestP2P(t)
+	p2 := mockp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	rlimiter := newRateLimiter(p1)
+
+	pid := p2.PeerID()
+	topic := p2p.RPCBlocksByRangeTopicV2 + p1.Encoding().ProtocolSuffix()
+	collector, err := rlimiter.topicCollector(topic)
+	require.NoError(t, err)
+	collector.Add(pid.String(), 1)
+	rpcThrottledRequests.WithLabelValues(pid.String(), topic).Inc()
+	rpcServedBytes.WithLabelValues(pid.String()).Add(1)
+
+	rlimiter.forgetPeer(pid)
+	assert.Equal(t, false, rpcThrottledRequests.DeleteLabelValues(pid.String(), topic), "throttled requests of the peer were not deleted")
+	assert.Equal(t, false, rpcServedBytes.DeleteLabelValues(pid.String()), "served bytes of the peer were not deleted")
+	// The bucket is still draining, so the peer does not get a fresh limit by reconnecting.
+	assert.Equal(t, int64(1), collector.Count(pid.String()))
+}
+
+func TestNodeLoad_QuotaFactor(t *testing.T) {
+	load := newNodeLoad(1000)
+	assert.Equal(t, float64(1), load.quotaFactor())
+	load.addOutbound(700)
+	assert.Equal(t, float64(1), load.quotaFactor())
+	load.addOutbound(300)
+	assert.Equal(t, true, load.quotaFactor() < 0.3)
+
+	load = newNodeLoad(0)
+	load.addOutbound(1 << 30)
+	assert.Equal(t, float64(1), load.quotaFactor())
+	load.cpu = 0.85
+	assert.Equal(t, true, load.quotaFactor() > minQuotaFactor && load.quotaFactor() < 1)
+}
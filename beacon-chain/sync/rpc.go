@@ -12,6 +12,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/theQRL/qrysm/beacon-chain/p2p"
 	p2ptypes "github.com/theQRL/qrysm/beacon-chain/p2p/types"
+	"github.com/theQRL/qrysm/config/features"
 	"github.com/theQRL/qrysm/config/params"
 	"github.com/theQRL/qrysm/monitoring/tracing"
 	"github.com/theQRL/qrysm/time"
@@ -46,6 +47,9 @@ func (s *Service) registerRPCHandlers() {
 		s.pingHandler,
 	)
 	s.registerRPCHandlersAltair()
+	if features.Get().EnableLightClient {
+		s.registerRPCHandlersLightClient()
+	}
 }
 
 // registerRPCHandlers for altair.
@@ -64,6 +68,26 @@ func (s *Service) registerRPCHandlersAltair() {
 	)
 }
 
+// registerRPCHandlersLightClient registers the req/resp protocols of the light client server.
+func (s *Service) registerRPCHandlersLightClient() {
+	s.registerRPC(
+		p2p.RPCLightClientBootstrapTopicV1,
+		s.lightClientBootstrapRPCHandler,
+	)
+	s.registerRPC(
+		p2p.RPCLightClientUpdatesByRangeTopicV1,
+		s.lightClientUpdatesByRangeRPCHandler,
+	)
+	s.registerRPC(
+		p2p.RPCLightClientFinalityUpdateTopicV1,
+		s.lightClientFinalityUpdateRPCHandler,
+	)
+	s.registerRPC(
+		p2p.RPCLightClientOptimisticUpdateTopicV1,
+		s.lightClientOptimisticUpdateRPCHandler,
+	)
+}
+
 // registerRPC for a given topic with an expected protobuf message type.
 func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 	topic := baseTopic + s.cfg.p2p.Encoding().ProtocolSuffix()
@@ -129,9 +153,9 @@ func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 		// Increment message received counter.
 		messageReceivedCounter.WithLabelValues(topic).Inc()
 
-		// since metadata requests do not have any data in the payload, we
+		// since metadata and light client update requests do not have any data in the payload, we
 		// do not decode anything.
-		if baseTopic == p2p.RPCMetaDataTopicV2 {
+		if hasEmptyRequestBody(baseTopic) {
 			if err := handle(ctx, base, stream); err != nil {
 				messageFailedProcessingCounter.WithLabelValues(topic).Inc()
 				if err != p2ptypes.ErrWrongForkDigestVersion {
@@ -188,6 +212,16 @@ func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 	})
 }
 
+// hasEmptyRequestBody reports whether requests of the given topic carry no payload.
+func hasEmptyRequestBody(baseTopic string) bool {
+	switch baseTopic {
+	case p2p.RPCMetaDataTopicV2, p2p.RPCLightClientFinalityUpdateTopicV1, p2p.RPCLightClientOptimisticUpdateTopicV1:
+		return true
+	default:
+		return false
+	}
+}
+
 // downscorePeer increments the bad-responses counter for the peer and emits a single
 // debug log carrying the new score. Reasons should be stable identifiers so logs are greppable.
 func (s *Service) downscorePeer(peerID peer.ID, reason string) {
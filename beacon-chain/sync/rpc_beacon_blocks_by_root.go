@@ -76,6 +76,9 @@ func (s *Service) beaconBlocksRootRPCHandler(ctx context.Context, msg any, strea
 	s.rateLimiter.add(stream, int64(len(blockRoots)))
 
 	for _, root := range blockRoots {
+		if err := s.rateLimiter.validateBytes(stream); err != nil {
+			return err
+		}
 		blk, err := s.cfg.beaconDB.Block(ctx, root)
 		if err != nil {
 			log.WithError(err).Debug("Could not fetch block")
@@ -16,11 +16,28 @@ import (
 )
 
 // chunkBlockWriter writes the given message as a chunked response to the given network
-// stream.
+// stream, and charges the written bytes to the peer in the rate limiter.
 // response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
 func (s *Service) chunkBlockWriter(stream libp2pcore.Stream, blk interfaces.ReadOnlySignedBeaconBlock) error {
 	SetStreamWriteDeadline(stream, defaultWriteDuration)
-	return WriteBlockChunk(stream, s.cfg.clock, s.cfg.p2p.Encoding(), blk)
+	counter := &countingStream{Stream: stream}
+	err := WriteBlockChunk(counter, s.cfg.clock, s.cfg.p2p.Encoding(), blk)
+	if s.rateLimiter != nil {
+		s.rateLimiter.addBytes(stream, counter.written)
+	}
+	return err
+}
+
+// countingStream counts the bytes written to the stream.
+type countingStream struct {
+	libp2pcore.Stream
+	written int
+}
+
+func (c *countingStream) Write(p []byte) (int, error) {
+	n, err := c.Stream.Write(p)
+	c.written += n
+	return n, err
 }
 
 // WriteBlockChunk writes block chunk object to stream.
@@ -0,0 +1,170 @@
+package sync
+
+import (
+	"context"
+	"math"
+
+	libp2pcore "github.com/libp2p/go-libp2p/core"
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/theQRL/qrysm/beacon-chain/blockchain"
+	"github.com/theQRL/qrysm/beacon-chain/p2p/types"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/blocks"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/network/forks"
+	"github.com/theQRL/qrysm/time/slots"
+)
+
+// lightClientBootstrapRPCHandler responds with the light client bootstrap of the requested block root.
+func (s *Service) lightClientBootstrapRPCHandler(ctx context.Context, msg any, stream libp2pcore.Stream) error {
+	ctx, cancel := context.WithTimeout(ctx, ttfbTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_bootstrap")
+
+	req, ok := msg.(*types.LightClientBootstrapReq)
+	if !ok {
+		return errors.New("message is not type LightClientBootstrapReq")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	root := [32]byte(*req)
+	blk, err := s.cfg.beaconDB.Block(ctx, root)
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch block")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		return err
+	}
+	if err := blocks.BeaconBlockIsNil(blk); err != nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return errors.Wrapf(types.ErrResourceUnavailable, "no block for root %#x", root)
+	}
+	st, err := s.cfg.stateGen.StateByRoot(ctx, root)
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch state")
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return err
+	}
+	bootstrap, err := blockchain.NewLightClientBootstrapFromBeaconState(ctx, st)
+	if err != nil {
+		log.WithError(err).Debug("Could not create light client bootstrap")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		return err
+	}
+	if err := s.writeLightClientChunk(stream, bootstrap.Header.Slot, bootstrap); err != nil {
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientUpdatesByRangeRPCHandler responds with the best light client updates of the requested sync committee
+// periods. The response stops at the first period for which no update is known, as the updates must be consecutive.
+func (s *Service) lightClientUpdatesByRangeRPCHandler(ctx context.Context, msg any, stream libp2pcore.Stream) error {
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_updates_by_range")
+
+	req, ok := msg.(*types.LightClientUpdatesByRangeReq)
+	if !ok {
+		return errors.New("message is not type LightClientUpdatesByRangeReq")
+	}
+	count := min(req.Count, params.BeaconNetworkConfig().MaxRequestLightClientUpdates)
+	if count == 0 || req.StartPeriod > math.MaxUint64-count {
+		s.rateLimiter.add(stream, 1)
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, types.ErrInvalidRequest.Error(), stream)
+		return types.ErrInvalidRequest
+	}
+	if err := s.rateLimiter.validateRequest(stream, count); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, int64(count))
+
+	endPeriod := req.StartPeriod + count - 1
+	updates, err := s.cfg.beaconDB.LightClientUpdates(ctx, req.StartPeriod, endPeriod)
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch light client updates")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		return err
+	}
+	for period := req.StartPeriod; period <= endPeriod; period++ {
+		update, ok := updates[period]
+		if !ok {
+			break
+		}
+		if err := s.writeLightClientChunk(stream, update.AttestedHeader.Slot, update); err != nil {
+			return err
+		}
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientFinalityUpdateRPCHandler responds with the latest light client finality update.
+func (s *Service) lightClientFinalityUpdateRPCHandler(_ context.Context, _ any, stream libp2pcore.Stream) error {
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_finality_update")
+
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	update := s.cfg.chain.LightClientFinalityUpdate()
+	if update == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return errors.Wrap(types.ErrResourceUnavailable, "no light client finality update")
+	}
+	if err := s.writeLightClientChunk(stream, update.AttestedHeader.Slot, update); err != nil {
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientOptimisticUpdateRPCHandler responds with the latest light client optimistic update.
+func (s *Service) lightClientOptimisticUpdateRPCHandler(_ context.Context, _ any, stream libp2pcore.Stream) error {
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_optimistic_update")
+
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	update := s.cfg.chain.LightClientOptimisticUpdate()
+	if update == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return errors.Wrap(types.ErrResourceUnavailable, "no light client optimistic update")
+	}
+	if err := s.writeLightClientChunk(stream, update.AttestedHeader.Slot, update); err != nil {
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// writeLightClientChunk writes a light client object as a chunked response, using the fork digest of the
+// epoch of the given slot as context bytes.
+// response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
+func (s *Service) writeLightClientChunk(stream libp2pcore.Stream, slot primitives.Slot, msg ssz.Marshaler) error {
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	valRoot := s.cfg.clock.GenesisValidatorsRoot()
+	digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(slot), valRoot[:])
+	if err != nil {
+		return err
+	}
+	if err := writeContextToStream(digest[:], stream); err != nil {
+		return err
+	}
+	_, err = s.cfg.p2p.Encoding().EncodeWithMaxLength(stream, msg)
+	return err
+}
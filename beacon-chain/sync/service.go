@@ -107,6 +107,7 @@ type blockchainService interface {
 	blockchain.OptimisticModeFetcher
 	blockchain.SlashingReceiver
 	blockchain.ForkchoiceFetcher
+	blockchain.LightClientUpdateFetcher
 }
 
 // Service is responsible for handling all run time p2p related operations as the
@@ -203,8 +204,8 @@ func (s *Service) Start() {
 	go s.registerHandlers()
 
 	s.cfg.p2p.AddConnectionHandler(s.reValidatePeer, s.sendGoodbye)
-	s.cfg.p2p.AddDisconnectionHandler(func(_ context.Context, _ peer.ID) error {
-		// no-op
+	s.cfg.p2p.AddDisconnectionHandler(func(_ context.Context, pid peer.ID) error {
+		s.rateLimiter.forgetPeer(pid)
 		return nil
 	})
 	s.cfg.p2p.AddPingMethod(s.sendPingRequest)
@@ -215,6 +216,8 @@ func (s *Service) Start() {
 
 	// Update sync metrics.
 	async.RunEvery(s.ctx, syncMetricsInterval, s.updateMetrics)
+	// Track the load of the node for the rate limiter.
+	async.RunEvery(s.ctx, nodeLoadSamplePeriod, s.rateLimiter.load.sampleCPU)
 }
 
 // Stop the regular sync service.
@@ -124,6 +124,20 @@ func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 			digest,
 		)
 	}
+	if features.Get().EnableLightClient {
+		s.subscribe(
+			p2p.LightClientFinalityUpdateTopicFormat,
+			s.validateLightClientFinalityUpdate,
+			s.lightClientUpdateSubscriber,
+			digest,
+		)
+		s.subscribe(
+			p2p.LightClientOptimisticUpdateTopicFormat,
+			s.validateLightClientOptimisticUpdate,
+			s.lightClientUpdateSubscriber,
+			digest,
+		)
+	}
 }
 
 // subscribe to a given topic with a given validator and subscription handler.
@@ -65,3 +65,9 @@ func (s *Service) proposerSlashingSubscriber(ctx context.Context, msg proto.Mess
 	}
 	return nil
 }
+
+// lightClientUpdateSubscriber is a no-op, as gossiped light client updates are only forwarded when they match the
+// ones computed locally, which have already been recorded by the light client server.
+func (*Service) lightClientUpdateSubscriber(_ context.Context, _ proto.Message) error {
+	return nil
+}
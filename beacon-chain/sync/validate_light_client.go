@@ -0,0 +1,104 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/monitoring/tracing"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	qrysmTime "github.com/theQRL/qrysm/time"
+	"github.com/theQRL/qrysm/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// validateLightClientFinalityUpdate only forwards a finality update which matches the one computed locally, and
+// which was received after one third of its signature slot has elapsed.
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*qrlpb.LightClientFinalityUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if update.AttestedHeader == nil || update.FinalizedHeader == nil || update.SyncAggregate == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+	if !s.lightClientSignatureSlotElapsed(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	if !proto.Equal(update, s.cfg.chain.LightClientFinalityUpdate()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	msg.ValidatorData = update
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate only forwards an optimistic update which matches the one computed locally, and
+// which was received after one third of its signature slot has elapsed.
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*qrlpb.LightClientOptimisticUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if update.AttestedHeader == nil || update.SyncAggregate == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+	if !s.lightClientSignatureSlotElapsed(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	if !proto.Equal(update, s.cfg.chain.LightClientOptimisticUpdate()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	msg.ValidatorData = update
+	return pubsub.ValidationAccept, nil
+}
+
+// lightClientSignatureSlotElapsed reports whether one third of the signature slot has elapsed, allowing for the
+// maximum gossip clock disparity.
+func (s *Service) lightClientSignatureSlotElapsed(signatureSlot primitives.Slot) bool {
+	cfg := params.BeaconConfig()
+	slotStart := slots.StartTime(uint64(s.cfg.clock.GenesisTime().Unix()), signatureSlot)
+	earliest := slotStart.
+		Add(time.Duration(cfg.SecondsPerSlot/cfg.IntervalsPerSlot) * time.Second).
+		Add(-params.BeaconNetworkConfig().MaximumGossipClockDisparity)
+	return !qrysmTime.Now().Before(earliest)
+}
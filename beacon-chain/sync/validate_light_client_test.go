@@ -0,0 +1,105 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	mock "github.com/theQRL/qrysm/beacon-chain/blockchain/testing"
+	"github.com/theQRL/qrysm/beacon-chain/p2p"
+	p2ptest "github.com/theQRL/qrysm/beacon-chain/p2p/testing"
+	"github.com/theQRL/qrysm/beacon-chain/startup"
+	mockSync "github.com/theQRL/qrysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func testLightClientOptimisticUpdate(signatureSlot primitives.Slot) *qrlpb.LightClientOptimisticUpdate {
+	return &qrlpb.LightClientOptimisticUpdate{
+		AttestedHeader: &qrlpb.BeaconBlockHeader{
+			Slot:       signatureSlot - 1,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+		SyncAggregate: &qrlpb.SyncAggregate{
+			SyncCommitteeBits: make([]byte, params.BeaconConfig().SyncCommitteeSize/8),
+		},
+		SignatureSlot: signatureSlot,
+	}
+}
+
+func TestValidateLightClientOptimisticUpdate(t *testing.T) {
+	ctx := context.Background()
+	signatureSlot := primitives.Slot(10)
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+
+	tests := []struct {
+		name    string
+		local   *qrlpb.LightClientOptimisticUpdate
+		genesis time.Time
+		want    pubsub.ValidationResult
+	}{
+		{
+			name:    "matches local update",
+			local:   testLightClientOptimisticUpdate(signatureSlot),
+			genesis: time.Now().Add(-secondsPerSlot * time.Duration(signatureSlot+1)),
+			want:    pubsub.ValidationAccept,
+		},
+		{
+			name:    "no local update",
+			genesis: time.Now().Add(-secondsPerSlot * time.Duration(signatureSlot+1)),
+			want:    pubsub.ValidationIgnore,
+		},
+		{
+			name:    "differs from local update",
+			local:   testLightClientOptimisticUpdate(signatureSlot + 1),
+			genesis: time.Now().Add(-secondsPerSlot * time.Duration(signatureSlot+2)),
+			want:    pubsub.ValidationIgnore,
+		},
+		{
+			name:    "received too early",
+			local:   testLightClientOptimisticUpdate(signatureSlot),
+			genesis: time.Now().Add(-secondsPerSlot * time.Duration(signatureSlot)),
+			want:    pubsub.ValidationIgnore,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := p2ptest.NewTestP2P(t)
+			r := &Service{
+				cfg: &config{
+					p2p:         p,
+					chain:       &mock.ChainService{LCOptimisticUpdate: tt.local, Genesis: tt.genesis},
+					clock:       startup.NewClock(tt.genesis, [32]byte{}),
+					initialSync: &mockSync.Sync{IsSyncing: false},
+				},
+			}
+
+			buf := new(bytes.Buffer)
+			_, err := p.Encoding().EncodeGossip(buf, testLightClientOptimisticUpdate(signatureSlot))
+			require.NoError(t, err)
+			topic := p2p.GossipTypeMapping[reflect.TypeFor[*qrlpb.LightClientOptimisticUpdate]()]
+			d, err := r.currentForkDigest()
+			require.NoError(t, err)
+			topic = r.addDigestToTopic(topic, d)
+			m := &pubsub.Message{
+				Message: &pubsubpb.Message{
+					Data:  buf.Bytes(),
+					Topic: &topic,
+				},
+			}
+
+			res, err := r.validateLightClientOptimisticUpdate(ctx, "", m)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
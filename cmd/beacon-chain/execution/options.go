@@ -10,6 +10,8 @@ import (
 	"github.com/theQRL/qrysm/beacon-chain/execution"
 	"github.com/theQRL/qrysm/cmd/beacon-chain/flags"
 	"github.com/theQRL/qrysm/io/file"
+	"github.com/theQRL/qrysm/network"
+	"github.com/theQRL/qrysm/network/authorization"
 	"github.com/urfave/cli/v2"
 )
 
@@ -23,6 +25,10 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "could not read JWT secret file for authenticating execution API")
 	}
+	fallbackEndpoints, err := parseFallbackEngineEndpoints(c, jwtSecret)
+	if err != nil {
+		return nil, err
+	}
 	headers := strings.Split(c.String(flags.ExecutionEngineHeaders.Name), ",")
 	opts := []execution.Option{
 		execution.WithHttpEndpoint(endpoint),
@@ -32,6 +38,9 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 	if len(jwtSecret) > 0 {
 		opts = append(opts, execution.WithHttpEndpointAndJWTSecret(endpoint, jwtSecret))
 	}
+	if len(fallbackEndpoints) > 0 {
+		opts = append(opts, execution.WithFallbackEngineEndpoints(fallbackEndpoints))
+	}
 	return opts, nil
 }
 
@@ -48,6 +57,11 @@ func parseJWTSecretFromFile(c *cli.Context) ([]byte, error) {
 	if jwtSecretFile == "" {
 		return nil, nil
 	}
+	return readJWTSecret(jwtSecretFile)
+}
+
+// readJWTSecret reads a hex-encoded 32 byte JWT secret from a file.
+func readJWTSecret(jwtSecretFile string) ([]byte, error) {
 	enc, err := file.ReadFileAsBytes(jwtSecretFile)
 	if err != nil {
 		return nil, err
@@ -67,6 +81,40 @@ func parseJWTSecretFromFile(c *cli.Context) ([]byte, error) {
 	return secret, nil
 }
 
+// parseFallbackEngineEndpoints returns the additional execution endpoints that engine API calls are multiplexed to.
+// Each endpoint uses its own JWT secret if --execution-fallback-jwt-secret is set, otherwise the --jwt-secret.
+func parseFallbackEngineEndpoints(c *cli.Context, defaultSecret []byte) ([]network.Endpoint, error) {
+	urls := c.StringSlice(flags.ExecutionFallbackEndpoints.Name)
+	secretFiles := c.StringSlice(flags.ExecutionFallbackJWTSecrets.Name)
+	if len(urls) == 0 {
+		if len(secretFiles) > 0 {
+			return nil, fmt.Errorf("--%s requires --%s", flags.ExecutionFallbackJWTSecrets.Name, flags.ExecutionFallbackEndpoints.Name)
+		}
+		return nil, nil
+	}
+	if len(secretFiles) > 0 && len(secretFiles) != len(urls) {
+		return nil, fmt.Errorf("got %d --%s values for %d --%s values",
+			len(secretFiles), flags.ExecutionFallbackJWTSecrets.Name, len(urls), flags.ExecutionFallbackEndpoints.Name)
+	}
+	endpoints := make([]network.Endpoint, len(urls))
+	for i, url := range urls {
+		secret := defaultSecret
+		if len(secretFiles) > 0 {
+			var err error
+			secret, err = readJWTSecret(secretFiles[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not read JWT secret file of execution endpoint %d", i)
+			}
+		}
+		endpoints[i] = network.HttpEndpoint(url)
+		if len(secret) > 0 {
+			endpoints[i].Auth.Method = authorization.Bearer
+			endpoints[i].Auth.Value = string(secret)
+		}
+	}
+	return endpoints, nil
+}
+
 func parseExecutionChainEndpoint(c *cli.Context) (string, error) {
 	if c.String(flags.ExecutionEngineEndpoint.Name) == "" {
 		// TODO(now.youtrack.cloud/issue/TQ-1)
@@ -9,6 +9,7 @@ import (
 	"github.com/theQRL/qrysm/cmd/beacon-chain/flags"
 	"github.com/theQRL/qrysm/encoding/bytesutil"
 	"github.com/theQRL/qrysm/io/file"
+	"github.com/theQRL/qrysm/network/authorization"
 	"github.com/theQRL/qrysm/testing/assert"
 	"github.com/theQRL/qrysm/testing/require"
 	"github.com/urfave/cli/v2"
@@ -103,6 +104,57 @@ func Test_parseJWTSecretFromFile(t *testing.T) {
 	})
 }
 
+func Test_parseFallbackEngineEndpoints(t *testing.T) {
+	writeSecret := func(t *testing.T, secret [32]byte) string {
+		fullPath := filepath.Join(t.TempDir(), "jwt.hex")
+		require.NoError(t, file.WriteFile(fullPath, []byte(fmt.Sprintf("%#x", secret))))
+		return fullPath
+	}
+	defaultSecret := bytesutil.ToBytes32([]byte("default"))
+	t.Run("no fallback endpoints", func(t *testing.T) {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		ctx := cli.NewContext(&app, set, nil)
+		endpoints, err := parseFallbackEngineEndpoints(ctx, defaultSecret[:])
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(endpoints))
+	})
+	t.Run("default secret", func(t *testing.T) {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.Var(cli.NewStringSlice("http://a:8551", "http://b:8551"), flags.ExecutionFallbackEndpoints.Name, "")
+		ctx := cli.NewContext(&app, set, nil)
+		endpoints, err := parseFallbackEngineEndpoints(ctx, defaultSecret[:])
+		require.NoError(t, err)
+		require.Equal(t, 2, len(endpoints))
+		assert.Equal(t, "http://b:8551", endpoints[1].Url)
+		assert.Equal(t, authorization.Bearer, endpoints[1].Auth.Method)
+		assert.Equal(t, string(defaultSecret[:]), endpoints[1].Auth.Value)
+	})
+	t.Run("secret per endpoint", func(t *testing.T) {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		secret := bytesutil.ToBytes32([]byte("fallback"))
+		set.Var(cli.NewStringSlice("http://a:8551"), flags.ExecutionFallbackEndpoints.Name, "")
+		set.Var(cli.NewStringSlice(writeSecret(t, secret)), flags.ExecutionFallbackJWTSecrets.Name, "")
+		ctx := cli.NewContext(&app, set, nil)
+		endpoints, err := parseFallbackEngineEndpoints(ctx, defaultSecret[:])
+		require.NoError(t, err)
+		require.Equal(t, 1, len(endpoints))
+		assert.Equal(t, string(secret[:]), endpoints[0].Auth.Value)
+	})
+	t.Run("mismatched secrets", func(t *testing.T) {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		secret := bytesutil.ToBytes32([]byte("fallback"))
+		set.Var(cli.NewStringSlice("http://a:8551", "http://b:8551"), flags.ExecutionFallbackEndpoints.Name, "")
+		set.Var(cli.NewStringSlice(writeSecret(t, secret)), flags.ExecutionFallbackJWTSecrets.Name, "")
+		ctx := cli.NewContext(&app, set, nil)
+		_, err := parseFallbackEngineEndpoints(ctx, defaultSecret[:])
+		require.ErrorContains(t, "got 1 --execution-fallback-jwt-secret values for 2", err)
+	})
+}
+
 func TestExecutionChainPreregistration_EmptyWeb3Provider(t *testing.T) {
 	app := cli.App{}
 	set := flag.NewFlagSet("test", 0)
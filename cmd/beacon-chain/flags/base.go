@@ -9,12 +9,18 @@ import (
 )
 
 var (
-	// MevRelayEndpoint provides an HTTP access endpoint to a MEV builder network.
+	// MevRelayEndpoint provides HTTP access endpoints to a MEV builder network.
 	MevRelayEndpoint = &cli.StringFlag{
-		Name:  "http-mev-relay",
-		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
+		Name: "http-mev-relay",
+		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder. " +
+			"Use comma-separated endpoints to collect bids from several relays, the highest valid bid is used",
 		Value: "",
 	}
+	// MinBuilderBid sets the lowest builder bid value that is accepted.
+	MinBuilderBid = &cli.Uint64Flag{
+		Name:  "min-builder-bid",
+		Usage: "The lowest builder bid value in shor that is accepted. Lower bids are ignored and the local execution payload is used if no relay bids more",
+	}
 	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
 		Name:  "max-builder-consecutive-missed-slots",
 		Usage: "Number of consecutive skip slot to fallback from using relay/builder to local execution engine for block construction",
@@ -37,6 +43,19 @@ var (
 		Usage: "An execution client http endpoint. Can contain auth header as well in the format",
 		Value: "http://localhost:8551",
 	}
+	// ExecutionFallbackEndpoints defines additional execution client endpoints that engine API calls are sent to.
+	ExecutionFallbackEndpoints = &cli.StringSliceFlag{
+		Name: "execution-fallback-endpoint",
+		Usage: "An additional execution client http endpoint. Engine API calls are sent to every execution endpoint, " +
+			"the first VALID or INVALID verdict is used, and payloads are built on the healthiest endpoint. " +
+			"Can be specified multiple times.",
+	}
+	// ExecutionFallbackJWTSecrets defines the JWT secret files of the additional execution client endpoints.
+	ExecutionFallbackJWTSecrets = &cli.StringSliceFlag{
+		Name: "execution-fallback-jwt-secret",
+		Usage: "A path to a file containing the hex-encoded JWT secret of an --execution-fallback-endpoint, " +
+			"in the same order as the endpoints. If not set, the --jwt-secret is used for every fallback endpoint.",
+	}
 	// ExecutionEngineHeaders defines a list of HTTP headers to send with all execution client requests.
 	ExecutionEngineHeaders = &cli.StringFlag{
 		Name: "execution-headers",
@@ -145,6 +164,13 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the beaconDB. Lower values increase archive migration cost and database pressure.",
 		Value: int(params.MainnetConfig().SlotsPerArchivedPoint),
 	}
+	// HistoryRetentionEpochs specifies the number of finalized epochs of block and state history kept in the beaconDB.
+	HistoryRetentionEpochs = &cli.Uint64Flag{
+		Name: "history-retention-epochs",
+		Usage: "The number of epochs of finalized block and state history to keep in the beaconDB. Older history is " +
+			"pruned once per epoch. Values below the weak subjectivity minimum are raised to it. 0 keeps all history.",
+		Value: 0,
+	}
 	// BlockBatchLimit specifies the requested block batch size.
 	BlockBatchLimit = &cli.IntFlag{
 		Name:  "block-batch-limit",
@@ -157,6 +183,30 @@ var (
 		Usage: "The factor by which block batch limit may increase on burst.",
 		Value: 2,
 	}
+	// BlockBytesLimit specifies the number of bytes of blocks served to a peer per second.
+	BlockBytesLimit = &cli.Uint64Flag{
+		Name: "block-bytes-limit",
+		Usage: "The number of bytes of blocks the local peer serves per second to a single peer in responses to block " +
+			"requests. Bursts of up to block-batch-limit-burst-factor times this limit are allowed. The default lets a " +
+			"peer be served 64 blocks, the default block-batch-limit, of the maximum chunk size every 30 seconds, the " +
+			"period over which block-batch-limit applies, so it only cuts bursts that block-batch-limit allows. 0 " +
+			"disables the limit.",
+		Value: 64 * params.BeaconNetworkConfig().MaxChunkSize / 30, // About 21 MiB/s.
+	}
+	// RPCOutboundBytesLimit specifies the outbound req/resp bandwidth above which the node is considered under load.
+	RPCOutboundBytesLimit = &cli.Uint64Flag{
+		Name: "rpc-outbound-bytes-limit",
+		Usage: "The number of bytes per second the node serves to all peers together in req/resp responses before it " +
+			"considers itself under load and lowers the rate limits of peers. 0 only takes CPU usage into account.",
+		Value: 33554432,
+	}
+	// TrustedPeerRateLimitFactor specifies the factor by which the rate limits of trusted peers are multiplied.
+	TrustedPeerRateLimitFactor = &cli.Float64Flag{
+		Name: "trusted-peer-rate-limit-factor",
+		Usage: "The factor by which the req/resp rate limits of trusted peers are multiplied. The rate limits of " +
+			"trusted peers do not depend on their score or on the load of the node.",
+		Value: 4,
+	}
 	// EnableDebugRPCEndpoints as /v1/beacon/state.
 	EnableDebugRPCEndpoints = &cli.BoolFlag{
 		Name:  "enable-debug-rpc-endpoints",
@@ -222,10 +272,71 @@ var (
 		Usage: "This address will receive the transaction fees produced by any blocks from this node. Validator client can override this value through the preparebeaconproposer api.",
 		Value: params.BeaconConfig().QRLBurnAddress,
 	}
+	// MaxBlockAttestationBytes sets the byte budget for the attestations of proposed blocks.
+	MaxBlockAttestationBytes = &cli.Uint64Flag{
+		Name: "max-block-attestation-bytes",
+		Usage: "The maximum number of bytes taken by the attestations of a proposed block. Attestations are packed by " +
+			"reward per byte within this budget. The default of 0 keeps packing attestations by profitability, " +
+			"limited only by the number of attestations per block.",
+	}
+	// MonitorDutyHistorySize sets the number of duty outcomes the validator monitor keeps per validator.
+	MonitorDutyHistorySize = &cli.IntFlag{
+		Name:  "monitor-duty-history-size",
+		Usage: "The number of duty outcomes the validator monitor keeps in memory for each of the --monitor-indices.",
+		Value: 512,
+	}
+	// MonitorWebhookURLs defines the webhooks that receive the alerts of the validator monitor.
+	MonitorWebhookURLs = &cli.StringSliceFlag{
+		Name: "monitor-webhook-url",
+		Usage: "A URL that receives a JSON POST for every alert raised by the validator monitor: missed proposals, " +
+			"consecutive missed attestations, balance drops and slashings. Can be specified multiple times.",
+	}
+	// MonitorMissedAttestationsThreshold sets the number of consecutive missed attestations that raises an alert.
+	MonitorMissedAttestationsThreshold = &cli.Uint64Flag{
+		Name:  "monitor-missed-attestations-threshold",
+		Usage: "The number of consecutive missed attestations of a monitored validator that raises an alert. 0 disables the alert.",
+		Value: 3,
+	}
+	// MonitorBalanceDropThreshold sets the balance drop over an epoch that raises an alert.
+	MonitorBalanceDropThreshold = &cli.Uint64Flag{
+		Name:  "monitor-balance-drop-threshold",
+		Usage: "The balance drop in Shor of a monitored validator over an epoch that raises an alert. 0 disables the alert.",
+	}
+	// HistoricalStateReplayBudget limits the number of slots replayed to regenerate a historical state.
+	HistoricalStateReplayBudget = &cli.Uint64Flag{
+		Name: "historical-state-replay-budget",
+		Usage: "The maximum number of slots the node replays to regenerate a historical state for an API request. " +
+			"Requests over the budget fail and should be sent to an archive node. The default of 0 is unlimited.",
+		Value: 0,
+	}
+	// HistoricalStateReplayWorkers limits the number of historical states regenerated concurrently.
+	HistoricalStateReplayWorkers = &cli.IntFlag{
+		Name:  "historical-state-replay-workers",
+		Usage: "The number of historical states the node regenerates concurrently for API requests.",
+		Value: 2,
+	}
+	// HistoricalStateCacheSize sets the number of regenerated epoch boundary states kept in memory.
+	HistoricalStateCacheSize = &cli.IntFlag{
+		Name:  "historical-state-cache-size",
+		Usage: "The number of epoch boundary states regenerated for API requests that the node keeps in memory.",
+		Value: 8,
+	}
 	// SlasherDirFlag defines a path on disk where the slasher database is stored.
 	SlasherDirFlag = &cli.StringFlag{
 		Name:  "slasher-datadir",
 		Usage: "Directory for the slasher database",
 		Value: cmd.DefaultDataDir(),
 	}
+	// SlasherBackfillEpochs defines how many finalized epochs the slasher replays from the beacon database on start.
+	SlasherBackfillEpochs = &cli.Uint64Flag{
+		Name: "slasher-backfill-epochs",
+		Usage: "The number of finalized epochs of history the slasher replays from the beacon database on start " +
+			"to detect offenses committed before it was running. The replay resumes after a restart. 0 disables it.",
+	}
+	// SlasherAuditLogFlag defines the file to which the slasher appends detected slashings and their submission.
+	SlasherAuditLogFlag = &cli.StringFlag{
+		Name: "slasher-audit-log",
+		Usage: "Append-only log of the slashings detected by the slasher and of the outcome of their submission " +
+			"to the slashing pool. Defaults to audit.log in the slasher database directory.",
+	}
 )
@@ -14,6 +14,9 @@ type GlobalFlags struct {
 	MaxConcurrentDials         int
 	BlockBatchLimit            int
 	BlockBatchLimitBurstFactor int
+	BlockBytesLimit            uint64
+	RPCOutboundBytesLimit      uint64
+	TrustedPeerRateLimitFactor float64
 }
 
 var globalConfig *GlobalFlags
@@ -41,6 +44,9 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	}
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
+	cfg.BlockBytesLimit = ctx.Uint64(BlockBytesLimit.Name)
+	cfg.RPCOutboundBytesLimit = ctx.Uint64(RPCOutboundBytesLimit.Name)
+	cfg.TrustedPeerRateLimitFactor = ctx.Float64(TrustedPeerRateLimitFactor.Name)
 	cfg.MinimumPeersPerSubnet = ctx.Int(MinPeersPerSubnet.Name)
 	cfg.MaxConcurrentDials = ctx.Int(MaxConcurrentDials.Name)
 	configureMinimumPeers(ctx, cfg)
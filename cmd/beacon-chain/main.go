@@ -21,6 +21,7 @@ import (
 	"github.com/theQRL/qrysm/cmd/beacon-chain/execution"
 	"github.com/theQRL/qrysm/cmd/beacon-chain/flags"
 	jwtcommands "github.com/theQRL/qrysm/cmd/beacon-chain/jwt"
+	"github.com/theQRL/qrysm/cmd/beacon-chain/sync/backfill"
 	"github.com/theQRL/qrysm/cmd/beacon-chain/sync/checkpoint"
 	"github.com/theQRL/qrysm/cmd/beacon-chain/sync/genesis"
 	"github.com/theQRL/qrysm/config/features"
@@ -40,6 +41,8 @@ var appFlags = []cli.Flag{
 	flags.ExecutionEngineEndpoint,
 	flags.ExecutionEngineHeaders,
 	flags.ExecutionJWTSecretFlag,
+	flags.ExecutionFallbackEndpoints,
+	flags.ExecutionFallbackJWTSecrets,
 	flags.RPCHost,
 	flags.RPCPort,
 	flags.CertFlag,
@@ -54,10 +57,14 @@ var appFlags = []cli.Flag{
 	flags.SetGCPercent,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
+	flags.BlockBytesLimit,
+	flags.RPCOutboundBytesLimit,
+	flags.TrustedPeerRateLimitFactor,
 	flags.InteropMockExecutionDataVotesFlag,
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.HistoryRetentionEpochs,
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
@@ -69,10 +76,12 @@ var appFlags = []cli.Flag{
 	flags.MaxConcurrentDials,
 	flags.SuggestedFeeRecipient,
 	flags.MevRelayEndpoint,
+	flags.MinBuilderBid,
 	flags.MaxBuilderEpochMissedSlots,
 	flags.MaxBuilderConsecutiveMissedSlots,
 	flags.EngineEndpointTimeoutSeconds,
 	flags.LocalBlockValueBoost,
+	flags.MaxBlockAttestationBytes,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
@@ -83,6 +92,7 @@ var appFlags = []cli.Flag{
 	cmd.RelayNode,
 	cmd.P2PUDPPort,
 	cmd.P2PTCPPort,
+	cmd.P2PQUICPort,
 	cmd.P2PIP,
 	cmd.P2PHost,
 	cmd.P2PHostDNS,
@@ -119,13 +129,26 @@ var appFlags = []cli.Flag{
 	cmd.RestoreSourceFileFlag,
 	cmd.RestoreTargetDirFlag,
 	cmd.ValidatorMonitorIndicesFlag,
+	flags.MonitorDutyHistorySize,
+	flags.MonitorWebhookURLs,
+	flags.MonitorMissedAttestationsThreshold,
+	flags.MonitorBalanceDropThreshold,
+	flags.HistoricalStateReplayBudget,
+	flags.HistoricalStateReplayWorkers,
+	flags.HistoricalStateCacheSize,
 	cmd.ApiTimeoutFlag,
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
+	checkpoint.DepositSnapshotPath,
 	checkpoint.RemoteURL,
+	checkpoint.EraDir,
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
+	backfill.EnableExperimentalBackfill,
+	backfill.BackfillBatchSize,
 	flags.SlasherDirFlag,
+	flags.SlasherBackfillEpochs,
+	flags.SlasherAuditLogFlag,
 }
 
 func init() {
@@ -283,6 +306,7 @@ func startNode(ctx *cli.Context) error {
 	optFuncs := []func(*cli.Context) (node.Option, error){
 		genesis.BeaconNodeOptions,
 		checkpoint.BeaconNodeOptions,
+		backfill.BeaconNodeOptions,
 	}
 
 	beacon, err := node.New(ctx, optFuncs, opts...)
@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/beacon-chain/node"
 	"github.com/theQRL/qrysm/beacon-chain/sync/checkpoint"
+	"github.com/theQRL/qrysm/config/features"
 	"github.com/urfave/cli/v2"
 )
 
@@ -22,12 +23,26 @@ var (
 		Usage: "Rather than syncing from genesis, you can start processing from a ssz-serialized BeaconState+Block." +
 			" This flag allows you to specify a local file containing the checkpoint Block to load.",
 	}
+	// DepositSnapshotPath optionally provides the finalized deposit snapshot to use with StatePath and BlockPath.
+	DepositSnapshotPath = &cli.PathFlag{
+		Name: "checkpoint-deposit-snapshot",
+		Usage: "Used with --checkpoint-state and --checkpoint-block, this flag allows you to specify a local file " +
+			"containing the ssz-serialized finalized deposit snapshot, to initialize the deposit cache from instead of " +
+			"processing all deposit contract logs. Requires --" + features.EnableEIP4881.Name + ".",
+	}
 	RemoteURL = &cli.StringFlag{
 		Name: "checkpoint-sync-url",
 		Usage: "URL of a synced beacon node to trust in obtaining checkpoint sync data. " +
 			"As an additional safety measure, it is strongly recommended to only use this option in conjunction with " +
 			"--weak-subjectivity-checkpoint flag",
 	}
+	// EraDir defines a flag to initialize the beacon node database from a directory of era archives.
+	EraDir = &cli.PathFlag{
+		Name: "era-dir",
+		Usage: "Rather than syncing from genesis over the network, you can import the finalized history from era " +
+			"archives written by `qrysmctl db export-era`. This flag allows you to specify the directory holding the " +
+			"archives, which must start at era 0 or 1. The blocks are replayed from the genesis state and verified.",
+	}
 )
 
 // BeaconNodeOptions is responsible for determining if the checkpoint sync options have been used, and if so,
@@ -36,7 +51,21 @@ var (
 func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	blockPath := c.Path(BlockPath.Name)
 	statePath := c.Path(StatePath.Name)
+	depositSnapshotPath := c.Path(DepositSnapshotPath.Name)
 	remoteURL := c.String(RemoteURL.Name)
+	eraDir := c.Path(EraDir.Name)
+	if eraDir != "" {
+		if remoteURL != "" || blockPath != "" || statePath != "" {
+			return nil, fmt.Errorf("--era-dir cannot be used with --checkpoint-sync-url, --checkpoint-state or --checkpoint-block")
+		}
+		return func(node *node.BeaconNode) (err error) {
+			node.CheckpointInitializer, err = checkpoint.NewEraInitializer(eraDir)
+			if err != nil {
+				return errors.Wrap(err, "error preparing to import era archives")
+			}
+			return nil
+		}, nil
+	}
 	if remoteURL != "" {
 		return func(node *node.BeaconNode) error {
 			var err error
@@ -57,9 +86,12 @@ func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	if blockPath == "" && statePath != "" {
 		return nil, fmt.Errorf("--checkpoint-state specified, but not --checkpoint-block. both are required")
 	}
+	if depositSnapshotPath != "" && blockPath == "" {
+		return nil, fmt.Errorf("--checkpoint-deposit-snapshot specified, but not --checkpoint-state and --checkpoint-block")
+	}
 
 	return func(node *node.BeaconNode) (err error) {
-		node.CheckpointInitializer, err = checkpoint.NewFileInitializer(blockPath, statePath)
+		node.CheckpointInitializer, err = checkpoint.NewFileInitializer(blockPath, statePath, depositSnapshotPath)
 		if err != nil {
 			return errors.Wrap(err, "error preparing to initialize checkpoint from local ssz files")
 		}
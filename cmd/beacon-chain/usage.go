@@ -7,6 +7,7 @@ import (
 
 	"github.com/theQRL/qrysm/cmd"
 	"github.com/theQRL/qrysm/cmd/beacon-chain/flags"
+	"github.com/theQRL/qrysm/cmd/beacon-chain/sync/backfill"
 	"github.com/theQRL/qrysm/cmd/beacon-chain/sync/checkpoint"
 	"github.com/theQRL/qrysm/cmd/beacon-chain/sync/genesis"
 	"github.com/theQRL/qrysm/config/features"
@@ -54,6 +55,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RelayNode,
 			cmd.P2PUDPPort,
 			cmd.P2PTCPPort,
+			cmd.P2PQUICPort,
 			cmd.DataDirFlag,
 			cmd.VerbosityFlag,
 			cmd.EnableTracingFlag,
@@ -106,10 +108,16 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ExecutionEngineEndpoint,
 			flags.ExecutionEngineHeaders,
 			flags.ExecutionJWTSecretFlag,
+			flags.ExecutionFallbackEndpoints,
+			flags.ExecutionFallbackJWTSecrets,
 			flags.SetGCPercent,
 			flags.SlotsPerArchivedPoint,
+			flags.HistoryRetentionEpochs,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
+			flags.BlockBytesLimit,
+			flags.RPCOutboundBytesLimit,
+			flags.TrustedPeerRateLimitFactor,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
@@ -120,16 +128,31 @@ var appHelpFlagGroups = []flagGroup{
 			flags.MinPeersPerSubnet,
 			flags.MaxConcurrentDials,
 			flags.MevRelayEndpoint,
+			flags.MinBuilderBid,
 			flags.MaxBuilderEpochMissedSlots,
 			flags.MaxBuilderConsecutiveMissedSlots,
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
+			flags.SlasherBackfillEpochs,
+			flags.SlasherAuditLogFlag,
 			flags.LocalBlockValueBoost,
+			flags.MaxBlockAttestationBytes,
+			flags.MonitorDutyHistorySize,
+			flags.MonitorWebhookURLs,
+			flags.MonitorMissedAttestationsThreshold,
+			flags.MonitorBalanceDropThreshold,
+			flags.HistoricalStateReplayBudget,
+			flags.HistoricalStateReplayWorkers,
+			flags.HistoricalStateCacheSize,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
+			checkpoint.DepositSnapshotPath,
 			checkpoint.RemoteURL,
+			checkpoint.EraDir,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
+			backfill.EnableExperimentalBackfill,
+			backfill.BackfillBatchSize,
 		},
 	},
 	{
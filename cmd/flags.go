@@ -120,6 +120,12 @@ var (
 		Usage: "The port used by libp2p.",
 		Value: 13000,
 	}
+	// P2PQUICPort defines the port to be used by the QUIC transport of libp2p.
+	P2PQUICPort = &cli.IntFlag{
+		Name:  "p2p-quic-port",
+		Usage: "The UDP port used by libp2p for QUIC connections, when the QUIC transport is enabled with --enable-quic.",
+		Value: 13000,
+	}
 	// P2PIP defines the local IP to be used by libp2p.
 	P2PIP = &cli.StringFlag{
 		Name:  "p2p-local-ip",
@@ -19,7 +19,8 @@ var downloadFlags = struct {
 var downloadCmd = &cli.Command{
 	Name:    "download",
 	Aliases: []string{"dl"},
-	Usage:   "Download the latest finalized state and the most recent block it integrates. To be used for checkpoint sync.",
+	Usage: "Download the latest finalized state, the most recent block it integrates and the finalized deposit snapshot. " +
+		"To be used for checkpoint sync.",
 	Action: func(cliCtx *cli.Context) error {
 		if err := cliActionDownload(cliCtx); err != nil {
 			log.WithError(err).Fatal("Could not download checkpoint-sync data")
@@ -74,5 +75,16 @@ func cliActionDownload(_ *cli.Context) error {
 	}
 	log.Printf("saved ssz-encoded state to %s", statePath)
 
+	// Older beacon nodes do not serve the deposit snapshot, which is not required for checkpoint sync.
+	if err := od.DownloadDepositSnapshot(ctx, client); err != nil {
+		log.WithError(err).Warn("Could not download finalized deposit snapshot")
+		return nil
+	}
+	snapshotPath, err := od.SaveDepositSnapshot(cwd)
+	if err != nil {
+		return err
+	}
+	log.Printf("saved ssz-encoded deposit snapshot to %s", snapshotPath)
+
 	return nil
 }
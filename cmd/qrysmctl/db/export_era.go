@@ -0,0 +1,87 @@
+package db
+
+import (
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/beacon-chain/db/kv"
+	"github.com/theQRL/qrysm/beacon-chain/era"
+	"github.com/theQRL/qrysm/cmd"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/urfave/cli/v2"
+)
+
+var exportEraFlags = struct {
+	Path      string
+	OutputDir string
+	StartEra  uint64
+	EndEra    uint64
+}{}
+
+var exportEraCmd = &cli.Command{
+	Name: "export-era",
+	Usage: "export the finalized blocks and states of a beacon db as era archives, which a beacon node can import " +
+		"with --era-dir. The beacon node using the db must be stopped",
+	Action: func(cliCtx *cli.Context) error {
+		if err := exportEraAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export era archives")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		cmd.ChainConfigFileFlag,
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &exportEraFlags.Path,
+		},
+		&cli.StringFlag{
+			Name:        "output-dir",
+			Usage:       "directory to write the era archives to",
+			Destination: &exportEraFlags.OutputDir,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-era",
+			Usage:       "first era to export",
+			Destination: &exportEraFlags.StartEra,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-era",
+			Usage:       "last era to export, defaults to the last finalized era",
+			Destination: &exportEraFlags.EndEra,
+		},
+	},
+}
+
+func exportEraAction(cliCtx *cli.Context) error {
+	flags := exportEraFlags
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		if err := params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name), nil); err != nil {
+			return err
+		}
+	}
+	if flags.Path == "" || flags.OutputDir == "" {
+		return errors.New("--path and --output-dir are required")
+	}
+	ctx := cliCtx.Context
+	d, err := kv.NewKVStore(ctx, flags.Path)
+	if err != nil {
+		return errors.Wrap(err, "could not open db")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	end := era.Era(flags.EndEra)
+	if !cliCtx.IsSet("end-era") {
+		if end, err = era.LastFinalizedEra(ctx, d); err != nil {
+			return err
+		}
+	}
+	paths, err := era.Export(ctx, d, flags.OutputDir, era.Era(flags.StartEra), end)
+	if err != nil {
+		return err
+	}
+	log.WithField("count", len(paths)).WithField("dir", flags.OutputDir).Info("Exported era archives")
+	return nil
+}
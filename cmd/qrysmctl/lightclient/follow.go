@@ -0,0 +1,219 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/go-qrl/common/hexutil"
+	"github.com/theQRL/qrysm/api/client"
+	"github.com/theQRL/qrysm/api/client/beacon"
+	lightclientcore "github.com/theQRL/qrysm/beacon-chain/core/light-client"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+	"github.com/theQRL/qrysm/time/slots"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+var followFlags = struct {
+	BeaconNodeHost   string
+	Timeout          time.Duration
+	TrustedBlockRoot string
+	PollInterval     time.Duration
+}{}
+
+var followCmd = &cli.Command{
+	Name:  "follow",
+	Usage: "Follow the chain from a trusted block root using the light client API of a beacon node, printing the headers verified against the sync committee signatures.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionFollow(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not follow the chain")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node to query",
+			Destination: &followFlags.BeaconNodeHost,
+			Value:       "http://localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-url (uses duration format, ex: 2m31s). default: 1m",
+			Destination: &followFlags.Timeout,
+			Value:       time.Minute,
+		},
+		&cli.StringFlag{
+			Name:        "trusted-block-root",
+			Usage:       "hex-encoded root of a trusted block to bootstrap the light client from, ideally a recent finalized block",
+			Destination: &followFlags.TrustedBlockRoot,
+			Required:    true,
+		},
+		&cli.DurationFlag{
+			Name:        "poll-interval",
+			Usage:       "how often the beacon node is polled for new light client updates (uses duration format, ex: 12s). default: one slot",
+			Destination: &followFlags.PollInterval,
+			Value:       time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
+		},
+	},
+}
+
+func cliActionFollow(_ *cli.Context) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	f := followFlags
+
+	rawRoot, err := hexutil.Decode(f.TrustedBlockRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not decode trusted block root")
+	}
+	if len(rawRoot) != 32 {
+		return fmt.Errorf("trusted block root is %d bytes, expected 32", len(rawRoot))
+	}
+	trustedRoot := [32]byte(rawRoot)
+
+	opts := []client.ClientOpt{client.WithTimeout(f.Timeout)}
+	client, err := beacon.NewClient(f.BeaconNodeHost, opts...)
+	if err != nil {
+		return err
+	}
+
+	genesis, err := client.GetGenesis(ctx)
+	if err != nil {
+		return err
+	}
+	// Sync committee signatures commit to the fork version, so use the one of the network the beacon node follows.
+	if !bytes.Equal(genesis.ForkVersion[:], params.BeaconConfig().GenesisForkVersion) {
+		cfg := params.BeaconConfig().Copy()
+		cfg.GenesisForkVersion = genesis.ForkVersion[:]
+		cfg.InitializeForkSchedule()
+		params.OverrideBeaconConfig(cfg)
+	}
+
+	bootstrap, err := client.GetLightClientBootstrap(ctx, trustedRoot)
+	if err != nil {
+		return err
+	}
+	store, err := lightclientcore.NewStore(trustedRoot, bootstrap, genesis.ValidatorsRoot)
+	if err != nil {
+		return err
+	}
+	log.WithFields(headerFields(store.FinalizedHeader)).Info("Bootstrapped light client from trusted block root")
+
+	fl := &follower{
+		client:      client,
+		store:       store,
+		genesisTime: uint64(genesis.Time.Unix()),
+		finalized:   store.FinalizedHeader,
+		optimistic:  store.OptimisticHeader,
+	}
+	ticker := time.NewTicker(f.PollInterval)
+	defer ticker.Stop()
+	for {
+		fl.poll(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// follower polls a beacon node for light client updates and applies them to a light client store.
+type follower struct {
+	client      *beacon.Client
+	store       *lightclientcore.Store
+	genesisTime uint64
+
+	finalized          *qrlpb.BeaconBlockHeader
+	optimistic         *qrlpb.BeaconBlockHeader
+	lastFinalityUpdate *qrlpb.LightClientFinalityUpdate
+	lastOptimistic     *qrlpb.LightClientOptimisticUpdate
+}
+
+func (f *follower) poll(ctx context.Context) {
+	currentSlot := slots.CurrentSlot(f.genesisTime)
+	f.syncCommitteeUpdates(ctx, currentSlot)
+
+	finalityUpdate, err := f.client.GetLightClientFinalityUpdate(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get light client finality update")
+	} else if !proto.Equal(finalityUpdate, f.lastFinalityUpdate) {
+		f.lastFinalityUpdate = finalityUpdate
+		if err := f.store.ProcessFinalityUpdate(finalityUpdate, currentSlot); err != nil {
+			log.WithError(err).Warn("Could not process light client finality update")
+		}
+	}
+
+	optimisticUpdate, err := f.client.GetLightClientOptimisticUpdate(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get light client optimistic update")
+	} else if !proto.Equal(optimisticUpdate, f.lastOptimistic) {
+		f.lastOptimistic = optimisticUpdate
+		if err := f.store.ProcessOptimisticUpdate(optimisticUpdate, currentSlot); err != nil {
+			log.WithError(err).Warn("Could not process light client optimistic update")
+		}
+	}
+
+	if err := f.store.ProcessForceUpdate(currentSlot); err != nil {
+		log.WithError(err).Warn("Could not force light client update")
+	}
+	f.logProgress()
+}
+
+// syncCommitteeUpdates fetches the updates of the sync committee periods the store is missing, so that it
+// learns the sync committees that sign the latest updates.
+func (f *follower) syncCommitteeUpdates(ctx context.Context, currentSlot primitives.Slot) {
+	finalizedPeriod := slots.SyncCommitteePeriod(slots.ToEpoch(f.store.FinalizedHeader.Slot))
+	currentPeriod := slots.SyncCommitteePeriod(slots.ToEpoch(currentSlot))
+	startPeriod := finalizedPeriod
+	if f.store.IsNextSyncCommitteeKnown() {
+		startPeriod++
+	}
+	if startPeriod > currentPeriod {
+		return
+	}
+	count := min(currentPeriod-startPeriod+1, params.BeaconNetworkConfig().MaxRequestLightClientUpdates)
+	updates, err := f.client.GetLightClientUpdatesByRange(ctx, startPeriod, count)
+	if err != nil {
+		log.WithError(err).Debug("Could not get light client updates")
+		return
+	}
+	for _, update := range updates {
+		if err := f.store.ProcessUpdate(update, currentSlot); err != nil {
+			log.WithError(err).WithField("attestedSlot", update.AttestedHeader.Slot).Debug("Could not process light client update")
+		}
+	}
+}
+
+// logProgress prints the finalized and optimistic headers when they changed since the last poll.
+func (f *follower) logProgress() {
+	if f.store.FinalizedHeader != f.finalized {
+		f.finalized = f.store.FinalizedHeader
+		log.WithFields(headerFields(f.finalized)).Info("Verified finalized header")
+	}
+	if f.store.OptimisticHeader != f.optimistic {
+		f.optimistic = f.store.OptimisticHeader
+		log.WithFields(headerFields(f.optimistic)).Info("Verified optimistic header")
+	}
+}
+
+func headerFields(h *qrlpb.BeaconBlockHeader) log.Fields {
+	fields := log.Fields{
+		"slot":      h.Slot,
+		"stateRoot": fmt.Sprintf("%#x", h.StateRoot),
+	}
+	if root, err := h.HashTreeRoot(); err == nil {
+		fields["blockRoot"] = fmt.Sprintf("%#x", root)
+	}
+	return fields
+}
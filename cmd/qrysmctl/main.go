@@ -6,6 +6,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/theQRL/qrysm/cmd/qrysmctl/checkpointsync"
 	"github.com/theQRL/qrysm/cmd/qrysmctl/db"
+	"github.com/theQRL/qrysm/cmd/qrysmctl/lightclient"
 	"github.com/theQRL/qrysm/cmd/qrysmctl/p2p"
 	"github.com/theQRL/qrysm/cmd/qrysmctl/testnet"
 	"github.com/theQRL/qrysm/cmd/qrysmctl/validator"
@@ -28,6 +29,7 @@ func main() {
 func init() {
 	qrysmctlCommands = append(qrysmctlCommands, checkpointsync.Commands...)
 	qrysmctlCommands = append(qrysmctlCommands, db.Commands...)
+	qrysmctlCommands = append(qrysmctlCommands, lightclient.Commands...)
 	qrysmctlCommands = append(qrysmctlCommands, p2p.Commands...)
 	qrysmctlCommands = append(qrysmctlCommands, testnet.Commands...)
 	qrysmctlCommands = append(qrysmctlCommands, weaksubjectivity.Commands...)
@@ -0,0 +1,52 @@
+// Package flags contains all configuration runtime flags for
+// the standalone slasher.
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// BeaconRESTApiProviderFlag defines the REST API endpoints of the beacon nodes followed by the slasher.
+	BeaconRESTApiProviderFlag = &cli.StringSliceFlag{
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node REST API endpoint(s) to follow. Blocks processed by any of the beacon nodes are checked " +
+			"for slashable offenses, and detected slashings are submitted to all of them.",
+		Value: cli.NewStringSlice("http://127.0.0.1:3500"),
+	}
+	// BeaconRPCProviderFlag defines the gRPC endpoints used to stream the events of the beacon nodes.
+	BeaconRPCProviderFlag = &cli.StringSliceFlag{
+		Name: "beacon-rpc-provider",
+		Usage: "Beacon node gRPC endpoint(s) to stream block events from with StreamEvents, instead of the REST API " +
+			"server-sent events. Endpoints are given in the same order as --beacon-rest-api-provider.",
+	}
+	// CertFlag defines a flag for the TLS certificate of the beacon node gRPC endpoints.
+	CertFlag = &cli.StringFlag{
+		Name:  "tls-cert",
+		Usage: "Certificate for secure gRPC connections to the beacon nodes.",
+	}
+	// AuditLogFlag defines the file to which the slasher appends detected slashings and their submission.
+	AuditLogFlag = &cli.StringFlag{
+		Name: "audit-log",
+		Usage: "Append-only log of the slashings detected by the slasher and of the outcome of their submission " +
+			"to the beacon nodes. Defaults to audit.log in the slasher database directory.",
+	}
+	// HTTPHostFlag defines the host on which the slashings detected by the slasher are served.
+	HTTPHostFlag = &cli.StringFlag{
+		Name:  "http-host",
+		Usage: "Host on which the REST API listing the detected slashings listens.",
+		Value: "127.0.0.1",
+	}
+	// HTTPPortFlag defines the port on which the slashings detected by the slasher are served.
+	HTTPPortFlag = &cli.IntFlag{
+		Name:  "http-port",
+		Usage: "Port on which the REST API listing the detected slashings listens. 0 disables it.",
+		Value: 3510,
+	}
+	// MonitoringPortFlag defines the http port used to serve prometheus metrics.
+	MonitoringPortFlag = &cli.IntFlag{
+		Name:  "monitoring-port",
+		Usage: "Port used to listening and respond metrics for prometheus.",
+		Value: 8082,
+	}
+)
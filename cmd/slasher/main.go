@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	runtimeDebug "runtime/debug"
+
+	joonix "github.com/joonix/log"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/cmd"
+	"github.com/theQRL/qrysm/cmd/slasher/flags"
+	"github.com/theQRL/qrysm/io/logs"
+	"github.com/theQRL/qrysm/monitoring/journald"
+	prefixed "github.com/theQRL/qrysm/runtime/logging/logrus-prefixed-formatter"
+	"github.com/theQRL/qrysm/runtime/version"
+	"github.com/theQRL/qrysm/slasher/node"
+	"github.com/urfave/cli/v2"
+)
+
+var appFlags = []cli.Flag{
+	cmd.VerbosityFlag,
+	cmd.LogFormat,
+	cmd.LogFileName,
+	cmd.ConfigFileFlag,
+	cmd.DataDirFlag,
+	cmd.ChainConfigFileFlag,
+	cmd.MonitoringHostFlag,
+	cmd.DisableMonitoringFlag,
+	flags.BeaconRESTApiProviderFlag,
+	flags.BeaconRPCProviderFlag,
+	flags.CertFlag,
+	flags.AuditLogFlag,
+	flags.HTTPHostFlag,
+	flags.HTTPPortFlag,
+	flags.MonitoringPortFlag,
+}
+
+func init() {
+	appFlags = cmd.WrapFlags(appFlags)
+}
+
+func main() {
+	app := cli.App{}
+	app.Name = "slasher"
+	app.Usage = "standalone slasher following beacon nodes to detect slashable offenses on separate hardware"
+	app.Action = run
+	app.Version = version.Version()
+
+	app.Flags = appFlags
+
+	// logging/config setup cargo-culted from beaconchain
+	app.Before = func(ctx *cli.Context) error {
+		// Load flags from config file, if specified.
+		if err := cmd.LoadFlagsFromConfig(ctx, app.Flags); err != nil {
+			return err
+		}
+
+		verbosity := ctx.String(cmd.VerbosityFlag.Name)
+		level, err := logrus.ParseLevel(verbosity)
+		if err != nil {
+			return err
+		}
+		logrus.SetLevel(level)
+
+		format := ctx.String(cmd.LogFormat.Name)
+		switch format {
+		case "text":
+			formatter := new(prefixed.TextFormatter)
+			formatter.TimestampFormat = "2006-01-02 15:04:05"
+			formatter.FullTimestamp = true
+			// If persistent log files are written - we disable the log messages coloring because
+			// the colors are ANSI codes and seen as gibberish in the log files.
+			formatter.DisableColors = ctx.String(cmd.LogFileName.Name) != ""
+			logrus.SetFormatter(formatter)
+		case "fluentd":
+			f := joonix.NewFormatter()
+			if err := joonix.DisableTimestampFormat(f); err != nil {
+				panic(err)
+			}
+			logrus.SetFormatter(f)
+		case "json":
+			logrus.SetFormatter(&logrus.JSONFormatter{})
+		case "journald":
+			if err := journald.Enable(level); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown log format %s", format)
+		}
+
+		logFileName := ctx.String(cmd.LogFileName.Name)
+		if logFileName != "" {
+			if err := logs.ConfigurePersistentLogging(logFileName); err != nil {
+				log.WithError(err).Error("Failed to configuring logging to disk.")
+			}
+		}
+		return cmd.ValidateNoArgs(ctx)
+	}
+
+	defer func() {
+		if x := recover(); x != nil {
+			log.Errorf("Runtime panic: %v\n%v", x, string(runtimeDebug.Stack()))
+			panic(x)
+		}
+	}()
+
+	if err := app.Run(os.Args); err != nil {
+		log.Error(err.Error())
+	}
+}
+
+func run(ctx *cli.Context) error {
+	slasher, err := node.New(ctx)
+	if err != nil {
+		return err
+	}
+	slasher.Start()
+	return nil
+}
@@ -46,6 +46,19 @@ var (
 		Usage: "Beacon node REST API provider endpoint(s). Use comma-separated URLs for failover",
 		Value: "http://127.0.0.1:3500",
 	}
+	// DoppelgangerEpochsFlag defines the number of epochs keys are checked for doppelgangers before they may sign.
+	DoppelgangerEpochsFlag = &cli.Uint64Flag{
+		Name: "doppelganger-epochs",
+		Usage: "Number of epochs during which a key must not be seen live by the beacon nodes before it may sign, " +
+			"when doppelganger protection is enabled with --enable-doppelganger",
+		Value: 2,
+	}
+	// DoppelgangerBeaconNodesFlag defines the beacon nodes queried for the liveness of validators by the doppelganger check.
+	DoppelgangerBeaconNodesFlag = &cli.StringFlag{
+		Name: "doppelganger-beacon-rest-api-providers",
+		Usage: "Comma-separated beacon node REST API endpoints queried for the liveness of validators by the doppelganger " +
+			"check. A key seen live by any of them does not sign. Defaults to --beacon-rest-api-provider",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -327,6 +340,27 @@ var (
 		Usage: "Allows users to specify the output directory to export their slashing protection EIP-3076 standard JSON File",
 		Value: "",
 	}
+	// SlashingProtectionMinimalFlag exports only the highest signed slot and epochs of each key
+	// instead of every signed block and attestation of the validator database.
+	SlashingProtectionMinimalFlag = &cli.BoolFlag{
+		Name:  "minimal",
+		Usage: "Exports only the highest signed slot and epochs of each key, as in the minimal EIP-3076 format, instead of the complete history of signed blocks and attestations",
+		Value: false,
+	}
+	// SlashingProtectionMergeFlag merges the imported slashing protection history with the existing one
+	// and reports the records that conflict with it.
+	SlashingProtectionMergeFlag = &cli.BoolFlag{
+		Name:  "merge",
+		Usage: "Merges the imported slashing protection history with the history already in the validator database, skipping records already present and reporting the ones that would be slashable",
+		Value: false,
+	}
+	// MergeSlashingProtectionImportsFlag merges the slashing protection history imported through the
+	// validator RPC with the existing one, as the --merge flag of the import command does.
+	MergeSlashingProtectionImportsFlag = &cli.BoolFlag{
+		Name:  "merge-slashing-protection-imports",
+		Usage: "Merges the slashing protection history imported through the validator RPC with the history already in the validator database, skipping records already present and reporting the ones that would be slashable",
+		Value: false,
+	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
 		Name:  "graffiti-file",
@@ -46,6 +46,8 @@ var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
 	flags.BeaconRPCGatewayProviderFlag,
 	flags.BeaconRESTApiProviderFlag,
+	flags.DoppelgangerEpochsFlag,
+	flags.DoppelgangerBeaconNodesFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
 	flags.DisablePenaltyRewardLogFlag,
@@ -60,6 +62,7 @@ var appFlags = []cli.Flag{
 	flags.GrpcRetryDelayFlag,
 	flags.GrpcHeadersFlag,
 	flags.GPRCGatewayCorsDomain,
+	flags.MergeSlashingProtectionImportsFlag,
 	flags.DisableAccountMetricsFlag,
 	flags.MonitoringPortFlag,
 	flags.SlasherRPCProviderFlag,
@@ -68,6 +68,12 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "could not export slashing protection history")
 	}
+	if cliCtx.Bool(flags.SlashingProtectionMinimalFlag.Name) {
+		eipJSON, err = slashingprotection.MinimalProtectionJSON(eipJSON)
+		if err != nil {
+			return errors.Wrap(err, "could not reduce slashing protection history to its minimal form")
+		}
+	}
 
 	// Check if JSON data is empty and issue a warning about common problems to the user.
 	if eipJSON == nil || len(eipJSON.Data) == 0 {
@@ -23,7 +23,8 @@ import (
 // 2. Open the validator database.
 // 3. Read the JSON file from user input.
 // 4. Call the function which actually imports the data from
-// the standard slashing protection JSON file into our database.
+// the standard slashing protection JSON file into our database,
+// or merges it with the existing history if --merge is set.
 func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 	var err error
 	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
@@ -74,6 +75,15 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 	}
 	log.Infof("Starting import of slashing protection file %s", protectionFilePath)
 	buf := bytes.NewBuffer(enc)
+	if cliCtx.Bool(flags.SlashingProtectionMergeFlag.Name) {
+		report, err := slashingprotection.MergeStandardProtectionJSON(cliCtx.Context, valDB, buf)
+		if err != nil {
+			return err
+		}
+		slashingprotection.LogMergeReport(report)
+		log.Infof("Slashing protection JSON successfully merged into %s", dataDir)
+		return nil
+	}
 	if err := slashingprotection.ImportStandardProtectionJSON(
 		cliCtx.Context, valDB, buf,
 	); err != nil {
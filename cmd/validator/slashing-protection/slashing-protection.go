@@ -21,6 +21,7 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionExportDirFlag,
+				flags.SlashingProtectionMinimalFlag,
 				features.Mainnet,
 				cmd.AcceptTosFlag,
 			}),
@@ -46,6 +47,7 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionJSONFileFlag,
+				flags.SlashingProtectionMergeFlag,
 				features.Mainnet,
 				cmd.AcceptTosFlag,
 			}),
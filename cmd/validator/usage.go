@@ -85,6 +85,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.BeaconRPCProviderFlag,
 			flags.BeaconRPCGatewayProviderFlag,
 			flags.BeaconRESTApiProviderFlag,
+			flags.DoppelgangerEpochsFlag,
+			flags.DoppelgangerBeaconNodesFlag,
 			flags.CertFlag,
 			flags.DisablePenaltyRewardLogFlag,
 			flags.GraffitiFlag,
@@ -97,6 +99,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GrpcRetryDelayFlag,
 			flags.GPRCGatewayCorsDomain,
 			flags.GrpcHeadersFlag,
+			flags.MergeSlashingProtectionImportsFlag,
 			flags.SlasherRPCProviderFlag,
 			flags.SlasherCertFlag,
 			flags.DisableAccountMetricsFlag,
@@ -44,6 +44,7 @@ type Flags struct {
 	EnableDoppelGanger                  bool // EnableDoppelGanger enables doppelganger protection on startup for the validator.
 	EnableHistoricalSpaceRepresentation bool // EnableHistoricalSpaceRepresentation enables the saving of registry validators in separate buckets to save space
 	EnableBeaconRESTApi                 bool // EnableBeaconRESTApi enables experimental usage of the beacon REST API by the validator when querying a beacon node
+	EnableBeaconRESTApiSSZ              bool // EnableBeaconRESTApiSSZ makes the validator exchange blocks, aggregates and duties with the beacon REST API in SSZ.
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
 	EnableFullSSZDataLogging  bool // Enables logging for full ssz data on rejected gossip messages
@@ -61,6 +62,7 @@ type Flags struct {
 	EnableStartOptimistic     bool // EnableStartOptimistic treats every block as optimistic at startup.
 
 	DisableResourceManager     bool // Disables running the node with libp2p's resource manager.
+	EnableQUIC                 bool // EnableQUIC adds a QUIC listener to the libp2p host and prefers QUIC when dialing peers that advertise it.
 	DisableStakinContractCheck bool // Disables check for deposit contract when proposing blocks
 	DisableLastEpochTargets    bool // Disables treating blocks from the previous epoch as viable checkpoint roots when computing attestation pre-state.
 
@@ -72,6 +74,8 @@ type Flags struct {
 
 	AggregateParallel bool // AggregateParallel aggregates attestations in parallel.
 
+	EnableLightClient bool // EnableLightClient enables the light client server.
+
 	// KeystoreImportDebounceInterval specifies the time duration the validator waits to reload new keys if they have
 	// changed on disk. This feature is for advanced use cases only.
 	KeystoreImportDebounceInterval time.Duration
@@ -224,6 +228,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(disableResourceManager)
 		cfg.DisableResourceManager = true
 	}
+	if ctx.IsSet(enableQUIC.Name) {
+		logEnabled(enableQUIC)
+		cfg.EnableQUIC = true
+	}
 	if ctx.IsSet(EnableEIP4881.Name) {
 		logEnabled(EnableEIP4881)
 		cfg.EnableEIP4881 = true
@@ -236,6 +244,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(disableLastEpochTargets)
 		cfg.DisableLastEpochTargets = true
 	}
+	if ctx.IsSet(enableLightClient.Name) {
+		logEnabled(enableLightClient)
+		cfg.EnableLightClient = true
+	}
 	cfg.AggregateIntervals = [3]time.Duration{aggregateFirstInterval.Value, aggregateSecondInterval.Value, aggregateThirdInterval.Value}
 	Init(cfg)
 	return nil
@@ -265,6 +277,10 @@ func ConfigureValidator(ctx *cli.Context) error {
 		logEnabled(EnableBeaconRESTApi)
 		cfg.EnableBeaconRESTApi = true
 	}
+	if ctx.Bool(enableBeaconRESTApiSSZ.Name) {
+		logEnabled(enableBeaconRESTApiSSZ)
+		cfg.EnableBeaconRESTApiSSZ = true
+	}
 	cfg.KeystoreImportDebounceInterval = ctx.Duration(dynamicKeyReloadDebounceInterval.Name)
 	Init(cfg)
 	return nil
@@ -111,6 +111,11 @@ var (
 		Name:  "enable-beacon-rest-api",
 		Usage: "Experimental enable of the beacon REST API when querying a beacon node",
 	}
+	enableBeaconRESTApiSSZ = &cli.BoolFlag{
+		Name: "enable-beacon-rest-api-ssz",
+		Usage: "Exchanges blocks, aggregate attestations and duties with the beacon REST API in SSZ rather than JSON, " +
+			"falling back to JSON for beacon nodes that do not serve SSZ. Requires --enable-beacon-rest-api",
+	}
 	disableVerboseSigVerification = &cli.BoolFlag{
 		Name:  "disable-verbose-sig-verification",
 		Usage: "Disables identifying invalid signatures if batch verification fails when processing block. Verbose verification is on by default.",
@@ -131,6 +136,10 @@ var (
 		Name:  "disable-resource-manager",
 		Usage: "Disables running the libp2p resource manager",
 	}
+	enableQUIC = &cli.BoolFlag{
+		Name:  "enable-quic",
+		Usage: "Enables the QUIC transport for libp2p, listening on --p2p-quic-port next to TCP",
+	}
 
 	// DisableRegistrationCache a flag for disabling the validator registration cache and use db instead.
 	DisableRegistrationCache = &cli.BoolFlag{
@@ -151,6 +160,11 @@ var (
 		Name:  "disable-last-epoch-targets",
 		Usage: "Disables treating blocks from the previous epoch as viable checkpoint roots when computing attestation pre-state.",
 	}
+	enableLightClient = &cli.BoolFlag{
+		Name: "enable-light-client",
+		Usage: "Enables the light client server: light client updates are persisted, served over the beacon API and " +
+			"p2p req/resp, and published on gossip and the events stream",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -167,6 +181,7 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 	enableSlashingProtectionPruning,
 	enableDoppelGangerProtection,
 	EnableBeaconRESTApi,
+	enableBeaconRESTApiSSZ,
 }...)
 
 // E2EValidatorFlags contains a list of the validator feature flags to be tested in E2E.
@@ -198,10 +213,12 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	aggregateThirdInterval,
 	EnableEIP4881,
 	disableResourceManager,
+	enableQUIC,
 	DisableRegistrationCache,
 	disableAggregateParallel,
 	forceHeadFlag,
 	disableLastEpochTargets,
+	enableLightClient,
 }...)...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
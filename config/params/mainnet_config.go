@@ -25,6 +25,7 @@ var mainnetNetworkConfig = &NetworkConfig{
 	AttestationSubnetCount:          64,
 	AttestationPropagationSlotRange: 32,
 	MaxRequestBlocks:                1 << 10, // 1024
+	MaxRequestLightClientUpdates:    1 << 7,  // 128
 	TtfbTimeout:                     35 * time.Second,
 	RespTimeout:                     50 * time.Second,
 	MaximumGossipClockDisparity:     500 * time.Millisecond,
@@ -17,6 +17,9 @@ type SignatureBatch struct {
 	PublicKeys   [][]PublicKey
 	Messages     [][32]byte
 	Descriptions []string
+	// Skipped holds the signatures left out of the batch by RemoveVerified. The batch is
+	// only valid if they are, so they must be invalidated along with it when it fails.
+	Skipped *SignatureBatch
 }
 
 // NewSet constructs an empty signature batch object.
@@ -35,6 +38,12 @@ func (s *SignatureBatch) Join(set *SignatureBatch) *SignatureBatch {
 	s.PublicKeys = append(s.PublicKeys, set.PublicKeys...)
 	s.Messages = append(s.Messages, set.Messages...)
 	s.Descriptions = append(s.Descriptions, set.Descriptions...)
+	if set.Skipped != nil {
+		if s.Skipped == nil {
+			s.Skipped = NewSet()
+		}
+		s.Skipped.Join(set.Skipped)
+	}
 	return s
 }
 
@@ -43,6 +52,67 @@ func (s *SignatureBatch) Verify() (bool, error) {
 	return VerifyMultipleSignatures(s.Signatures, s.Messages, s.PublicKeys)
 }
 
+// VerifiedSignatures records the signatures that were already verified successfully,
+// so that verifying them again can be skipped.
+type VerifiedSignatures interface {
+	Verified(pubKey PublicKey, msg [32]byte, sig []byte) bool
+	MarkVerified(pubKey PublicKey, msg [32]byte, sig []byte)
+}
+
+// MarkVerified records every signature of the batch as verified. It must only be
+// called once the whole batch verified successfully.
+func (s *SignatureBatch) MarkVerified(v VerifiedSignatures) {
+	for i, msg := range s.Messages {
+		for j, sig := range s.Signatures[i] {
+			v.MarkVerified(s.PublicKeys[i][j], msg, sig)
+		}
+	}
+}
+
+// RemoveVerified returns a batch without the signatures recorded as verified,
+// along with the number of signatures left out. Messages whose signatures were
+// all verified are dropped. The signatures left out are kept in the Skipped batch
+// of the returned batch. The current batch is left untouched.
+func (s *SignatureBatch) RemoveVerified(v VerifiedSignatures) (int, *SignatureBatch) {
+	removed := 0
+	set := NewSet()
+	skipped := NewSet()
+	if s.Skipped != nil {
+		skipped.Join(s.Skipped)
+	}
+	for i, msg := range s.Messages {
+		var sigs, skippedSigs [][]byte
+		var pubs, skippedPubs []PublicKey
+		for j, sig := range s.Signatures[i] {
+			if v.Verified(s.PublicKeys[i][j], msg, sig) {
+				removed++
+				skippedSigs = append(skippedSigs, sig)
+				skippedPubs = append(skippedPubs, s.PublicKeys[i][j])
+				continue
+			}
+			sigs = append(sigs, sig)
+			pubs = append(pubs, s.PublicKeys[i][j])
+		}
+		if len(skippedSigs) != 0 {
+			skipped.Signatures = append(skipped.Signatures, skippedSigs)
+			skipped.PublicKeys = append(skipped.PublicKeys, skippedPubs)
+			skipped.Messages = append(skipped.Messages, msg)
+			skipped.Descriptions = append(skipped.Descriptions, s.Descriptions[i])
+		}
+		if len(sigs) == 0 {
+			continue
+		}
+		set.Signatures = append(set.Signatures, sigs)
+		set.PublicKeys = append(set.PublicKeys, pubs)
+		set.Messages = append(set.Messages, msg)
+		set.Descriptions = append(set.Descriptions, s.Descriptions[i])
+	}
+	if len(skipped.Messages) != 0 {
+		set.Skipped = skipped
+	}
+	return removed, set
+}
+
 // VerifyVerbosely verifies signatures as a whole at first, if fails, fallback
 // to verify each single signature to identify invalid ones.
 func (s *SignatureBatch) VerifyVerbosely() (bool, error) {
@@ -106,11 +176,16 @@ func (s *SignatureBatch) Copy() *SignatureBatch {
 		copy(messages[i][:], s.Messages[i][:])
 	}
 	copy(descriptions, s.Descriptions)
+	var skipped *SignatureBatch
+	if s.Skipped != nil {
+		skipped = s.Skipped.Copy()
+	}
 	return &SignatureBatch{
 		Signatures:   signatures,
 		PublicKeys:   pubkeys,
 		Messages:     messages,
 		Descriptions: descriptions,
+		Skipped:      skipped,
 	}
 }
 
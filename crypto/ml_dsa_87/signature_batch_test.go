@@ -388,6 +388,59 @@ func TestSignatureBatch_RemoveDuplicates(t *testing.T) {
 	}
 }
 
+type verifiedSignatures map[string]bool
+
+func (v verifiedSignatures) key(pubKey PublicKey, msg [32]byte, sig []byte) string {
+	return string(pubKey.Marshal()) + string(msg[:]) + string(sig)
+}
+
+func (v verifiedSignatures) Verified(pubKey PublicKey, msg [32]byte, sig []byte) bool {
+	return v[v.key(pubKey, msg, sig)]
+}
+
+func (v verifiedSignatures) MarkVerified(pubKey PublicKey, msg [32]byte, sig []byte) {
+	v[v.key(pubKey, msg, sig)] = true
+}
+
+func TestSignatureBatch_RemoveVerified(t *testing.T) {
+	verified := verifiedSignatures{}
+	seen := NewValidSignatureSet(t, "seen", 2)
+	seen.MarkVerified(verified)
+
+	unseen := NewValidSignatureSet(t, "unseen", 2)
+	// A message with a verified and an unverified signature keeps only the unverified one.
+	mixed := &SignatureBatch{
+		Signatures:   [][][]byte{{unseen.Signatures[0][0], seen.Signatures[0][0]}},
+		PublicKeys:   [][]PublicKey{{unseen.PublicKeys[0][0], seen.PublicKeys[0][0]}},
+		Messages:     [][32]byte{seen.Messages[0]},
+		Descriptions: []string{"mixed"},
+	}
+
+	set := NewSet().Join(seen.Copy()).Join(unseen.Copy()).Join(mixed)
+	removed, res := set.RemoveVerified(verified)
+	assert.Equal(t, 3, removed)
+	require.Equal(t, 3, len(res.Messages))
+	assert.DeepEqual(t, unseen.Messages, res.Messages[:2])
+	assert.DeepEqual(t, unseen.Descriptions, res.Descriptions[:2])
+	assert.Equal(t, 1, len(res.Signatures[2]))
+	assert.DeepEqual(t, unseen.Signatures[0][0], res.Signatures[2][0])
+	assert.Equal(t, 5, len(set.Messages), "Original batch should not be modified")
+	assert.Equal(t, 2, len(set.Signatures[4]), "Original batch should not be modified")
+	// The signatures left out are kept, so that they can be invalidated with the batch.
+	require.NotNil(t, res.Skipped)
+	assert.DeepEqual(t, [][32]byte{seen.Messages[0], seen.Messages[1], seen.Messages[0]}, res.Skipped.Messages)
+	assert.DeepEqual(t, seen.Signatures[0][0], res.Skipped.Signatures[2][0])
+	joined := NewSet().Join(res)
+	assert.Equal(t, 3, len(joined.Skipped.Messages))
+
+	// A different signature of a verified message is not considered verified.
+	other := seen.Copy()
+	other.Signatures[0][0] = unseen.Signatures[1][0]
+	removed, res = other.RemoveVerified(verified)
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, 1, len(res.Messages))
+}
+
 func NewValidSignatureSet(t *testing.T, msgBody string, num int) *SignatureBatch {
 	set := &SignatureBatch{
 		Signatures:   make([][][]byte, num),
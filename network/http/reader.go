@@ -1,6 +1,7 @@
 package http
 
 import (
+	"mime"
 	"net/http"
 	"regexp"
 	"strconv"
@@ -56,3 +57,17 @@ func RespondWithSsz(req *http.Request) bool {
 func IsRequestSsz(req *http.Request) bool {
 	return req.Header.Get("Content-Type") == api.OctetStreamMediaType
 }
+
+// IsRequestContentTypeSupported checks if the request body is either JSON or SSZ, based on the Content-Type header.
+// A request without a Content-Type header is interpreted as JSON.
+func IsRequestContentTypeSupported(req *http.Request) bool {
+	contentType := req.Header.Get("Content-Type")
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == api.JsonMediaType || mediaType == api.OctetStreamMediaType
+}
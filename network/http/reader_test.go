@@ -85,3 +85,26 @@ func TestSSZRequested(t *testing.T) {
 		assert.Equal(t, false, result)
 	})
 }
+
+func TestIsRequestContentTypeSupported(t *testing.T) {
+	tests := []struct {
+		contentType string
+		want        bool
+	}{
+		{contentType: "", want: true},
+		{contentType: jsonMediaType, want: true},
+		{contentType: jsonMediaType + "; charset=utf-8", want: true},
+		{contentType: octetStreamMediaType, want: true},
+		{contentType: "text/plain", want: false},
+		{contentType: "This is Sparta!!!", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			request := httptest.NewRequest("POST", "http://foo.example", nil)
+			if tt.contentType != "" {
+				request.Header.Set("Content-Type", tt.contentType)
+			}
+			assert.Equal(t, tt.want, IsRequestContentTypeSupported(request))
+		})
+	}
+}
@@ -6,8 +6,9 @@ import (
 )
 
 const (
-	NextSyncCommitteeIndex = uint64(55)
-	FinalizedRootIndex     = uint64(105)
+	CurrentSyncCommitteeIndex = uint64(54)
+	NextSyncCommitteeIndex    = uint64(55)
+	FinalizedRootIndex        = uint64(105)
 )
 
 func (x *SyncCommittee) Equals(other *SyncCommittee) bool {
@@ -22,8 +23,9 @@ func (x *SyncCommittee) Equals(other *SyncCommittee) bool {
 	return true
 }
 
+// FloorLog2 returns the depth of the given generalized index, which is also the length of its Merkle branch.
 func FloorLog2(x uint64) int {
-	return bits.Len64(uint64(x - 1))
+	return bits.Len64(x) - 1
 }
 
 func isEmptyWithLength(bb [][]byte, length uint64) bool {
@@ -34,8 +36,10 @@ func isEmptyWithLength(bb [][]byte, length uint64) bool {
 	if len(bb) != l {
 		return false
 	}
+	// A branch is empty when every node is either unset or the zero hash, which is how
+	// an empty branch looks once it has gone through SSZ encoding.
 	for _, b := range bb {
-		if !bytes.Equal(b, []byte{}) {
+		if len(b) != 0 && !bytes.Equal(b, make([]byte, 32)) {
 			return false
 		}
 	}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+func TestFloorLog2(t *testing.T) {
+	tests := []struct {
+		x    uint64
+		want int
+	}{
+		{x: 1, want: 0},
+		{x: 2, want: 1},
+		{x: 3, want: 1},
+		{x: 4, want: 2},
+		{x: CurrentSyncCommitteeIndex, want: 5},
+		{x: NextSyncCommitteeIndex, want: 5},
+		{x: 64, want: 6},
+		{x: FinalizedRootIndex, want: 6},
+		{x: 128, want: 7},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, FloorLog2(tt.x), "FloorLog2(%d)", tt.x)
+	}
+}
@@ -5469,6 +5469,182 @@ func (h *HistoricalSummary) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// MarshalSSZ ssz marshals the DepositSnapshot object
+func (d *DepositSnapshot) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the DepositSnapshot object to a target array
+func (d *DepositSnapshot) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(84)
+
+	// Offset (0) 'Finalized'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(d.Finalized) * 32
+
+	// Field (1) 'DepositRoot'
+	if size := len(d.DepositRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
+		return
+	}
+	dst = append(dst, d.DepositRoot...)
+
+	// Field (2) 'DepositCount'
+	dst = ssz.MarshalUint64(dst, d.DepositCount)
+
+	// Field (3) 'ExecutionHash'
+	if size := len(d.ExecutionHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ExecutionHash", size, 32)
+		return
+	}
+	dst = append(dst, d.ExecutionHash...)
+
+	// Field (4) 'ExecutionDepth'
+	dst = ssz.MarshalUint64(dst, d.ExecutionDepth)
+
+	// Field (0) 'Finalized'
+	if size := len(d.Finalized); size > 32 {
+		err = ssz.ErrListTooBigFn("--.Finalized", size, 32)
+		return
+	}
+	for ii := 0; ii < len(d.Finalized); ii++ {
+		if size := len(d.Finalized[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.Finalized[ii]", size, 32)
+			return
+		}
+		dst = append(dst, d.Finalized[ii]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the DepositSnapshot object
+func (d *DepositSnapshot) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Finalized'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 84 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'DepositRoot'
+	if cap(d.DepositRoot) == 0 {
+		d.DepositRoot = make([]byte, 0, len(buf[4:36]))
+	}
+	d.DepositRoot = append(d.DepositRoot, buf[4:36]...)
+
+	// Field (2) 'DepositCount'
+	d.DepositCount = ssz.UnmarshallUint64(buf[36:44])
+
+	// Field (3) 'ExecutionHash'
+	if cap(d.ExecutionHash) == 0 {
+		d.ExecutionHash = make([]byte, 0, len(buf[44:76]))
+	}
+	d.ExecutionHash = append(d.ExecutionHash, buf[44:76]...)
+
+	// Field (4) 'ExecutionDepth'
+	d.ExecutionDepth = ssz.UnmarshallUint64(buf[76:84])
+
+	// Field (0) 'Finalized'
+	{
+		buf = tail[o0:]
+		num, err := ssz.DivideInt2(len(buf), 32, 32)
+		if err != nil {
+			return err
+		}
+		d.Finalized = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(d.Finalized[ii]) == 0 {
+				d.Finalized[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			d.Finalized[ii] = append(d.Finalized[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the DepositSnapshot object
+func (d *DepositSnapshot) SizeSSZ() (size int) {
+	size = 84
+
+	// Field (0) 'Finalized'
+	size += len(d.Finalized) * 32
+
+	return
+}
+
+// HashTreeRoot ssz hashes the DepositSnapshot object
+func (d *DepositSnapshot) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the DepositSnapshot object with a hasher
+func (d *DepositSnapshot) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Finalized'
+	{
+		if size := len(d.Finalized); size > 32 {
+			err = ssz.ErrListTooBigFn("--.Finalized", size, 32)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range d.Finalized {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		numItems := uint64(len(d.Finalized))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, 32)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, 32)
+		}
+	}
+
+	// Field (1) 'DepositRoot'
+	if size := len(d.DepositRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.DepositRoot", size, 32)
+		return
+	}
+	hh.PutBytes(d.DepositRoot)
+
+	// Field (2) 'DepositCount'
+	hh.PutUint64(d.DepositCount)
+
+	// Field (3) 'ExecutionHash'
+	if size := len(d.ExecutionHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ExecutionHash", size, 32)
+		return
+	}
+	hh.PutBytes(d.ExecutionHash)
+
+	// Field (4) 'ExecutionDepth'
+	hh.PutUint64(d.ExecutionDepth)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
 // MarshalSSZ ssz marshals the Status object
 func (s *Status) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -23,6 +23,9 @@ mainnet = {
     "withdrawal.size": "16",
     "logs_bloom.size": "256",
     "extra_data.size": "32",
+    "current_sync_committee_branch.depth": "5", # floorlog2(CURRENT_SYNC_COMMITTEE_INDEX)
+    "next_sync_committee_branch.depth": "5", # floorlog2(NEXT_SYNC_COMMITTEE_INDEX)
+    "finality_branch.depth": "6", # floorlog2(FINALIZED_ROOT_INDEX)
 }
 
 minimal = {
@@ -41,6 +44,9 @@ minimal = {
     "withdrawal.size": "4",
     "logs_bloom.size": "256",
     "extra_data.size": "32",
+    "current_sync_committee_branch.depth": "5",
+    "next_sync_committee_branch.depth": "5",
+    "finality_branch.depth": "6",
 }
 
 ###### Rules definitions #######
@@ -0,0 +1,150 @@
+package beaconclient
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-qrl/common/hexutil"
+	"github.com/theQRL/qrysm/api/client"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	qrlpb "github.com/theQRL/qrysm/proto/qrl/v1"
+)
+
+const (
+	eventsPath = "/qrl/v1/events"
+	blockTopic = "block"
+	// maxEventSize bounds the size of a single server-sent event line.
+	maxEventSize = 1 << 20
+)
+
+// blockEvent is a block processed by a followed beacon node.
+type blockEvent struct {
+	slot primitives.Slot
+	root [32]byte
+	node *beaconNode
+}
+
+// streamBlockEvents sends the blocks processed by the beacon node to the channel until the stream fails or the
+// context is canceled. The events come from the StreamEvents gRPC endpoint when the node has one, and from the
+// server-sent events of the REST API otherwise.
+func (n *beaconNode) streamBlockEvents(ctx context.Context, events chan<- *blockEvent) error {
+	if n.events != nil {
+		return n.streamGRPCBlockEvents(ctx, events)
+	}
+	return n.streamRESTBlockEvents(ctx, events)
+}
+
+func (n *beaconNode) streamGRPCBlockEvents(ctx context.Context, events chan<- *blockEvent) error {
+	stream, err := n.events.StreamEvents(ctx, &qrlpb.StreamEventsRequest{Topics: []string{blockTopic}})
+	if err != nil {
+		return errors.Wrap(err, "could not subscribe to block events")
+	}
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			return errors.Wrap(err, "could not receive block event")
+		}
+		if ev.Event != blockTopic {
+			continue
+		}
+		blk := &qrlpb.EventBlock{}
+		if err := ev.Data.UnmarshalTo(blk); err != nil {
+			return errors.Wrap(err, "could not decode block event")
+		}
+		if err := n.send(ctx, events, blk.Slot, blk.Block); err != nil {
+			return err
+		}
+	}
+}
+
+func (n *beaconNode) streamRESTBlockEvents(ctx context.Context, events chan<- *blockEvent) error {
+	u := n.rest.BaseURL().ResolveReference(&url.URL{Path: eventsPath})
+	u.RawQuery = url.Values{"topics": []string{blockTopic}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if n.rest.Token() != "" {
+		req.Header.Set("Authorization", "Bearer "+n.rest.Token())
+	}
+	resp, err := n.rest.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not subscribe to block events")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close event stream")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return client.Non200Err(resp)
+	}
+	return n.readBlockEvents(ctx, resp.Body, events)
+}
+
+// readBlockEvents parses the server-sent events of the REST API, dispatching an event on each empty line.
+func (n *beaconNode) readBlockEvents(ctx context.Context, r io.Reader, events chan<- *blockEvent) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxEventSize)
+	var name, data string
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if name == blockTopic && data != "" {
+				if err := n.sendJSON(ctx, events, data); err != nil {
+					return err
+				}
+			}
+			name, data = "", ""
+		case strings.HasPrefix(line, "event:"):
+			name = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data += strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.Wrap(err, "could not read event stream")
+	}
+	return io.EOF
+}
+
+func (n *beaconNode) sendJSON(ctx context.Context, events chan<- *blockEvent, data string) error {
+	ev := struct {
+		Slot  string `json:"slot"`
+		Block string `json:"block"`
+	}{}
+	if err := json.Unmarshal([]byte(data), &ev); err != nil {
+		return errors.Wrap(err, "could not decode block event")
+	}
+	slot, err := strconv.ParseUint(ev.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "could not parse block event slot %s", ev.Slot)
+	}
+	root, err := hexutil.Decode(ev.Block)
+	if err != nil {
+		return errors.Wrapf(err, "could not parse block event root %s", ev.Block)
+	}
+	return n.send(ctx, events, primitives.Slot(slot), root)
+}
+
+func (n *beaconNode) send(ctx context.Context, events chan<- *blockEvent, slot primitives.Slot, root []byte) error {
+	if len(root) != 32 {
+		return errors.Errorf("got %d byte block root, expected 32 bytes", len(root))
+	}
+	select {
+	case events <- &blockEvent{slot: slot, root: bytesutil.ToBytes32(root), node: n}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
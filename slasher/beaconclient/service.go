@@ -0,0 +1,445 @@
+// Package beaconclient follows one or more beacon nodes over their REST API, or their StreamEvents gRPC endpoint,
+// to feed the blocks and attestations they process to a standalone slasher and submit the slashings it detects.
+package beaconclient
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/api/client"
+	"github.com/theQRL/qrysm/api/client/beacon"
+	"github.com/theQRL/qrysm/async/event"
+	"github.com/theQRL/qrysm/beacon-chain/core/feed"
+	statefeed "github.com/theQRL/qrysm/beacon-chain/core/feed/state"
+	"github.com/theQRL/qrysm/beacon-chain/operations/slashings"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/shared"
+	"github.com/theQRL/qrysm/beacon-chain/state"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	"github.com/theQRL/qrysm/encoding/ssz/detect"
+	qrlpbservice "github.com/theQRL/qrysm/proto/qrl/service"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/proto/qrysm/v1alpha1/attestation"
+	"github.com/theQRL/qrysm/time/slots"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// reconnectDelay is how long to wait before following a beacon node again after its event stream failed.
+	reconnectDelay = 5 * time.Second
+	// requestTimeout bounds the REST requests sent to the beacon nodes.
+	requestTimeout = 30 * time.Second
+	// maxResponseSize allows downloading the head state of a beacon node.
+	maxResponseSize = 1 << 30
+)
+
+// Config of the beacon node follower.
+type Config struct {
+	// RESTEndpoints are the REST APIs of the followed beacon nodes.
+	RESTEndpoints []string
+	// GRPCEndpoints optionally stream the events of the beacon node at the same position in RESTEndpoints over
+	// the StreamEvents gRPC endpoint, instead of the server-sent events of the REST API.
+	GRPCEndpoints []string
+	// GRPCCert is the TLS certificate of the gRPC endpoints, which are insecure when it is empty.
+	GRPCCert                string
+	IndexedAttestationsFeed *event.Feed
+	BeaconBlockHeadersFeed  *event.Feed
+}
+
+// beaconNode is a followed beacon node.
+type beaconNode struct {
+	rest   *beacon.Client
+	conn   *grpc.ClientConn
+	events qrlpbservice.EventsClient
+}
+
+// Service follows beacon nodes, sending the headers and the indexed attestations of the blocks they process to the
+// slasher feeds. It provides the information about the chain a slasher needs from a beacon node, and submits the
+// slashings to the operations pool of the beacon nodes, which verify them.
+type Service struct {
+	cfg         *Config
+	ctx         context.Context
+	cancel      context.CancelFunc
+	nodes       []*beaconNode
+	blockEvents chan *blockEvent
+	unmarshaler *detect.VersionedUnmarshaler
+	lock        sync.RWMutex
+	headSlot    primitives.Slot
+	seen        map[[32]byte]primitives.Slot
+	stateFeed   event.Feed
+	wg          sync.WaitGroup
+}
+
+type committeeKey struct {
+	slot  primitives.Slot
+	index primitives.CommitteeIndex
+}
+
+// NewService creates a follower of the beacon nodes of the config.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if len(cfg.RESTEndpoints) == 0 {
+		return nil, errors.New("no beacon node REST API endpoint to follow")
+	}
+	if len(cfg.GRPCEndpoints) > 0 && len(cfg.GRPCEndpoints) != len(cfg.RESTEndpoints) {
+		return nil, errors.Errorf(
+			"got %d beacon node gRPC endpoints for %d REST API endpoints", len(cfg.GRPCEndpoints), len(cfg.RESTEndpoints),
+		)
+	}
+	transportSecurity := grpc.WithTransportCredentials(insecure.NewCredentials())
+	if cfg.GRPCCert != "" {
+		creds, err := credentials.NewClientTLSFromFile(cfg.GRPCCert, "")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load gRPC TLS certificate")
+		}
+		transportSecurity = grpc.WithTransportCredentials(creds)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg:         cfg,
+		ctx:         ctx,
+		cancel:      cancel,
+		blockEvents: make(chan *blockEvent, 16),
+		seen:        make(map[[32]byte]primitives.Slot),
+	}
+	for i, endpoint := range cfg.RESTEndpoints {
+		rest, err := beacon.NewClient(endpoint, client.WithMaxBodySize(maxResponseSize))
+		if err != nil {
+			cancel()
+			return nil, errors.Wrapf(err, "invalid beacon node REST API endpoint %s", endpoint)
+		}
+		n := &beaconNode{rest: rest}
+		if len(cfg.GRPCEndpoints) > 0 {
+			conn, err := grpc.DialContext(ctx, cfg.GRPCEndpoints[i], transportSecurity)
+			if err != nil {
+				cancel()
+				return nil, errors.Wrapf(err, "could not dial beacon node gRPC endpoint %s", cfg.GRPCEndpoints[i])
+			}
+			n.conn = conn
+			n.events = qrlpbservice.NewEventsClient(conn)
+		}
+		s.nodes = append(s.nodes, n)
+	}
+	return s, nil
+}
+
+// Start following the beacon nodes. The chain config of the followed network must be active.
+func (s *Service) Start() {
+	vu, err := detect.FromForkVersion(bytesutil.ToBytes4(params.BeaconConfig().GenesisForkVersion))
+	if err != nil {
+		log.WithError(err).Error("Could not find the fork of the followed network")
+		return
+	}
+	s.unmarshaler = vu
+	for _, n := range s.nodes {
+		s.wg.Add(1)
+		go s.follow(n)
+	}
+	s.wg.Add(1)
+	go s.processBlockEvents()
+}
+
+// Stop following the beacon nodes.
+func (s *Service) Stop() error {
+	s.cancel()
+	s.wg.Wait()
+	for _, n := range s.nodes {
+		if n.conn != nil {
+			if err := n.conn.Close(); err != nil {
+				log.WithError(err).Debug("Could not close gRPC connection")
+			}
+		}
+	}
+	return nil
+}
+
+// Status of the follower.
+func (*Service) Status() error {
+	return nil
+}
+
+// Genesis returns the genesis of the chain followed by the first beacon node that answers.
+func (s *Service) Genesis(ctx context.Context) (*beacon.Genesis, error) {
+	var err error
+	for _, n := range s.nodes {
+		var genesis *beacon.Genesis
+		genesis, err = n.rest.GetGenesis(ctx)
+		if err == nil {
+			return genesis, nil
+		}
+		log.WithError(err).WithField("endpoint", n.rest.NodeURL()).Debug("Could not get genesis")
+	}
+	return nil, err
+}
+
+func (s *Service) follow(n *beaconNode) {
+	defer s.wg.Done()
+	for {
+		err := n.streamBlockEvents(s.ctx, s.blockEvents)
+		if s.ctx.Err() != nil {
+			return
+		}
+		log.WithError(err).WithField("endpoint", n.rest.NodeURL()).Warn("Lost the block events of the beacon node, reconnecting")
+		select {
+		case <-time.After(reconnectDelay):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Blocks are processed one at a time, so a block processed by several beacon nodes is only fed to slasher once.
+func (s *Service) processBlockEvents() {
+	defer s.wg.Done()
+	for {
+		select {
+		case ev := <-s.blockEvents:
+			if _, ok := s.seen[ev.root]; ok {
+				continue
+			}
+			if err := s.processBlock(s.ctx, ev); err != nil {
+				log.WithError(err).WithFields(logrus.Fields{
+					"endpoint": ev.node.rest.NodeURL(),
+					"slot":     ev.slot,
+					"root":     ev.root,
+				}).Warn("Could not process block")
+				continue
+			}
+			s.seen[ev.root] = ev.slot
+			s.setHeadSlot(ev.slot)
+			s.prune(ev.slot)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) processBlock(ctx context.Context, ev *blockEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	enc, err := ev.node.rest.GetBlock(ctx, beacon.IdFromRoot(ev.root))
+	if err != nil {
+		return err
+	}
+	blk, err := s.unmarshaler.UnmarshalBeaconBlock(enc)
+	if err != nil {
+		return errors.Wrap(err, "could not unmarshal block")
+	}
+	header, err := blk.Header()
+	if err != nil {
+		return errors.Wrap(err, "could not get block header")
+	}
+	// Committees are only shared by the attestations of the block, as blocks of other forks may see other shufflings.
+	committees := make(map[primitives.Epoch]map[committeeKey][]primitives.ValidatorIndex)
+	stateRoot := bytesutil.ToBytes32(blk.Block().StateRoot())
+	atts := make([]*qrysmpb.IndexedAttestation, 0, len(blk.Block().Body().Attestations()))
+	for _, att := range blk.Block().Body().Attestations() {
+		if att.Data == nil {
+			continue
+		}
+		committee, err := attestationCommittee(ctx, ev.node, stateRoot, committees, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return err
+		}
+		indexedAtt, err := attestation.ConvertToIndexed(ctx, att, committee)
+		if err != nil {
+			return err
+		}
+		atts = append(atts, indexedAtt)
+	}
+
+	s.cfg.BeaconBlockHeadersFeed.Send(header)
+	for _, att := range atts {
+		s.cfg.IndexedAttestationsFeed.Send(att)
+	}
+	s.stateFeed.Send(&feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{
+			Slot:        blk.Block().Slot(),
+			BlockRoot:   ev.root,
+			SignedBlock: blk,
+			Verified:    true,
+		},
+	})
+	return nil
+}
+
+// StateFeed sends a BlockProcessed event for each block processed by the followed beacon nodes, so the slasher
+// can tell when the slashings it submitted are included.
+func (s *Service) StateFeed() *event.Feed {
+	return &s.stateFeed
+}
+
+// attestationCommittee returns the committee of an attestation, from the committees of its epoch computed by the
+// beacon node in the post-state of the block including it, so attestations are indexed with the shuffling of their
+// own fork. The committees fetched for an epoch are kept in the cache of the block.
+func attestationCommittee(
+	ctx context.Context,
+	n *beaconNode,
+	stateRoot [32]byte,
+	cache map[primitives.Epoch]map[committeeKey][]primitives.ValidatorIndex,
+	slot primitives.Slot,
+	index primitives.CommitteeIndex,
+) ([]primitives.ValidatorIndex, error) {
+	epoch := slots.ToEpoch(slot)
+	committees, ok := cache[epoch]
+	if !ok {
+		resp, err := n.rest.GetCommittees(ctx, beacon.IdFromRoot(stateRoot), epoch)
+		if err != nil {
+			return nil, err
+		}
+		committees, err = committeesByKey(resp)
+		if err != nil {
+			return nil, err
+		}
+		cache[epoch] = committees
+	}
+	committee, ok := committees[committeeKey{slot: slot, index: index}]
+	if !ok {
+		return nil, errors.Errorf("no committee %d at slot %d", index, slot)
+	}
+	return committee, nil
+}
+
+// prune forgets the blocks older than the previous epoch of the slot.
+func (s *Service) prune(slot primitives.Slot) {
+	epoch := slots.ToEpoch(slot)
+	if epoch < 2 {
+		return
+	}
+	for root, blockSlot := range s.seen {
+		if slots.ToEpoch(blockSlot) < epoch-1 {
+			delete(s.seen, root)
+		}
+	}
+}
+
+func (s *Service) setHeadSlot(slot primitives.Slot) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if slot > s.headSlot {
+		s.headSlot = slot
+	}
+}
+
+// HeadSlot returns the highest slot of the blocks processed by the followed beacon nodes.
+func (s *Service) HeadSlot() primitives.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.headSlot
+}
+
+// HeadState downloads the head state of the first beacon node that answers.
+func (s *Service) HeadState(ctx context.Context) (state.BeaconState, error) {
+	var err error
+	for _, n := range s.nodes {
+		var enc []byte
+		enc, err = n.rest.GetState(ctx, beacon.IdHead)
+		if err != nil {
+			log.WithError(err).WithField("endpoint", n.rest.NodeURL()).Debug("Could not get head state")
+			continue
+		}
+		vu, err := detect.FromState(enc)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not detect the version of the head state")
+		}
+		return vu.UnmarshalBeaconState(enc)
+	}
+	return nil, errors.Wrap(err, "could not get head state")
+}
+
+// Syncing is false when any of the followed beacon nodes is synced.
+func (s *Service) Syncing() bool {
+	ctx, cancel := context.WithTimeout(s.ctx, requestTimeout)
+	defer cancel()
+	for _, n := range s.nodes {
+		status, err := n.rest.GetSyncStatus(ctx)
+		if err != nil {
+			log.WithError(err).WithField("endpoint", n.rest.NodeURL()).Debug("Could not get sync status")
+			continue
+		}
+		if !status.IsSyncing {
+			s.setHeadSlot(status.HeadSlot)
+			return false
+		}
+	}
+	return true
+}
+
+// InsertAttesterSlashing submits the attester slashing to all the followed beacon nodes. It fails when none of them
+// accepted it.
+func (s *Service) InsertAttesterSlashing(ctx context.Context, _ state.ReadOnlyBeaconState, slashing *qrysmpb.AttesterSlashing) error {
+	return s.submit(ctx, slashings.ErrAttesterSlashingPending, func(ctx context.Context, c *beacon.Client) error {
+		return c.SubmitAttesterSlashing(ctx, slashing)
+	})
+}
+
+// InsertProposerSlashing submits the proposer slashing to all the followed beacon nodes. It fails when none of them
+// accepted it.
+func (s *Service) InsertProposerSlashing(ctx context.Context, _ state.ReadOnlyBeaconState, slashing *qrysmpb.ProposerSlashing) error {
+	return s.submit(ctx, slashings.ErrProposerSlashingPending, func(ctx context.Context, c *beacon.Client) error {
+		return c.SubmitProposerSlashing(ctx, slashing)
+	})
+}
+
+// submit sends a slashing to the followed beacon nodes. The REST API only reports in its error message that a
+// slashing was refused because its validators are already pending in the pool, so such refusals are returned as
+// the pending error of the slashings pool, the slashing being pooled.
+func (s *Service) submit(ctx context.Context, pending error, submit func(context.Context, *beacon.Client) error) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	var err error
+	accepted := false
+	for _, n := range s.nodes {
+		submitErr := submit(ctx, n.rest)
+		if submitErr == nil {
+			accepted = true
+			continue
+		}
+		if strings.Contains(submitErr.Error(), pending.Error()) {
+			log.WithError(submitErr).WithField("endpoint", n.rest.NodeURL()).Debug("Slashing is already pending in beacon node")
+			err = errors.Wrap(pending, submitErr.Error())
+			continue
+		}
+		log.WithError(submitErr).WithField("endpoint", n.rest.NodeURL()).Warn("Beacon node rejected slashing")
+		if !errors.Is(err, pending) {
+			err = submitErr
+		}
+	}
+	if accepted {
+		return nil
+	}
+	return err
+}
+
+func committeesByKey(resp []*shared.Committee) (map[committeeKey][]primitives.ValidatorIndex, error) {
+	committees := make(map[committeeKey][]primitives.ValidatorIndex, len(resp))
+	for _, c := range resp {
+		slot, err := strconv.ParseUint(c.Slot, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse committee slot %s", c.Slot)
+		}
+		index, err := strconv.ParseUint(c.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse committee index %s", c.Index)
+		}
+		validators := make([]primitives.ValidatorIndex, len(c.Validators))
+		for i, v := range c.Validators {
+			validator, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not parse committee validator %s", v)
+			}
+			validators[i] = primitives.ValidatorIndex(validator)
+		}
+		committees[committeeKey{slot: primitives.Slot(slot), index: primitives.CommitteeIndex(index)}] = validators
+	}
+	return committees, nil
+}
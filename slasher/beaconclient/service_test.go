@@ -0,0 +1,221 @@
+package beaconclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-bitfield"
+	"github.com/theQRL/qrysm/async/event"
+	"github.com/theQRL/qrysm/beacon-chain/core/feed"
+	statefeed "github.com/theQRL/qrysm/beacon-chain/core/feed/state"
+	"github.com/theQRL/qrysm/beacon-chain/operations/slashings"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	"github.com/theQRL/qrysm/encoding/ssz/detect"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/testing/util"
+)
+
+func TestNewService_EndpointsMismatch(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{})
+	require.ErrorContains(t, "no beacon node REST API endpoint", err)
+	_, err = NewService(context.Background(), &Config{
+		RESTEndpoints: []string{"http://127.0.0.1:3500", "http://127.0.0.1:3501"},
+		GRPCEndpoints: []string{"127.0.0.1:4000"},
+	})
+	require.ErrorContains(t, "got 1 beacon node gRPC endpoints for 2 REST API endpoints", err)
+}
+
+func TestService_FollowsBlocks(t *testing.T) {
+	blk := util.NewBeaconBlockZond()
+	blk.Block.Slot = 5
+	blk.Block.ProposerIndex = 3
+	blk.Block.StateRoot = bytesutil.PadTo([]byte("state"), 32)
+	blk.Block.Body.Attestations = []*qrysmpb.Attestation{util.HydrateAttestation(&qrysmpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1101},
+		Data:            &qrysmpb.AttestationData{Slot: 4},
+		Signatures:      [][]byte{make([]byte, 4627), make([]byte, 4627)},
+	})}
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	enc, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	submitted := make(chan string, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/qrl/v1/events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "block", r.URL.Query().Get("topics"))
+		event := fmt.Sprintf("event: block\ndata: {\"slot\":\"5\",\"block\":\"%#x\",\"execution_optimistic\":false}\n\n", root)
+		// The same block is announced twice, and fed to slasher once.
+		_, err := io.WriteString(w, "event: head\ndata: {}\n\n"+event+event)
+		require.NoError(t, err)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	})
+	mux.HandleFunc(fmt.Sprintf("/qrl/v1/beacon/blocks/%#x", root), func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write(enc)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc(fmt.Sprintf("/qrl/v1/beacon/states/%#x/committees", blk.Block.StateRoot), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "0", r.URL.Query().Get("epoch"))
+		_, err := io.WriteString(w, `{"data":[{"index":"0","slot":"4","validators":["7","8","9"]}]}`)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/qrl/v1/beacon/pool/proposer_slashings", func(_ http.ResponseWriter, r *http.Request) {
+		submitted <- r.Method
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	headersFeed, attsFeed := new(event.Feed), new(event.Feed)
+	s, err := NewService(context.Background(), &Config{
+		RESTEndpoints:           []string{srv.URL},
+		BeaconBlockHeadersFeed:  headersFeed,
+		IndexedAttestationsFeed: attsFeed,
+	})
+	require.NoError(t, err)
+	headers := make(chan *qrysmpb.SignedBeaconBlockHeader, 2)
+	headersSub := headersFeed.Subscribe(headers)
+	defer headersSub.Unsubscribe()
+	atts := make(chan *qrysmpb.IndexedAttestation, 2)
+	attsSub := attsFeed.Subscribe(atts)
+	defer attsSub.Unsubscribe()
+	processed := make(chan *feed.Event, 2)
+	processedSub := s.StateFeed().Subscribe(processed)
+	defer processedSub.Unsubscribe()
+	s.Start()
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	select {
+	case header := <-headers:
+		assert.Equal(t, primitives.Slot(5), header.Header.Slot)
+		assert.Equal(t, primitives.ValidatorIndex(3), header.Header.ProposerIndex)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive block header")
+	}
+	select {
+	case att := <-atts:
+		assert.DeepEqual(t, []uint64{7, 9}, att.AttestingIndices)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive indexed attestation")
+	}
+	select {
+	case e := <-processed:
+		require.Equal(t, statefeed.BlockProcessed, int(e.Type))
+		data, ok := e.Data.(*statefeed.BlockProcessedData)
+		require.Equal(t, true, ok)
+		assert.Equal(t, primitives.Slot(5), data.Slot)
+		assert.Equal(t, primitives.Slot(5), data.SignedBlock.Block().Slot())
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive block processed event")
+	}
+	select {
+	case <-headers:
+		t.Fatal("Received the same block header twice")
+	case <-time.After(100 * time.Millisecond):
+	}
+	assert.Equal(t, primitives.Slot(5), s.HeadSlot())
+
+	header := util.HydrateSignedBeaconHeader(&qrysmpb.SignedBeaconBlockHeader{})
+	require.NoError(t, s.InsertProposerSlashing(context.Background(), nil, &qrysmpb.ProposerSlashing{
+		Header_1: header,
+		Header_2: header,
+	}))
+	assert.Equal(t, http.MethodPost, <-submitted)
+}
+
+func TestService_processBlock_CommitteesOfFork(t *testing.T) {
+	// Two forks compute different committees for the same epoch.
+	forks := []struct {
+		stateRoot []byte
+		root      [32]byte
+		want      []uint64
+	}{
+		{stateRoot: bytesutil.PadTo([]byte("a"), 32), want: []uint64{7}},
+		{stateRoot: bytesutil.PadTo([]byte("b"), 32), want: []uint64{1}},
+	}
+	mux := http.NewServeMux()
+	for i, validators := range []string{`["7","8"]`, `["1","2"]`} {
+		blk := util.NewBeaconBlockZond()
+		blk.Block.Slot = 5
+		blk.Block.StateRoot = forks[i].stateRoot
+		blk.Block.Body.Attestations = []*qrysmpb.Attestation{util.HydrateAttestation(&qrysmpb.Attestation{
+			AggregationBits: bitfield.Bitlist{0b101},
+			Data:            &qrysmpb.AttestationData{Slot: 4},
+			Signatures:      [][]byte{make([]byte, 4627)},
+		})}
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		forks[i].root = root
+		enc, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		mux.HandleFunc(fmt.Sprintf("/qrl/v1/beacon/blocks/%#x", root), func(w http.ResponseWriter, _ *http.Request) {
+			_, err := w.Write(enc)
+			require.NoError(t, err)
+		})
+		body := fmt.Sprintf(`{"data":[{"index":"0","slot":"4","validators":%s}]}`, validators)
+		mux.HandleFunc(fmt.Sprintf("/qrl/v1/beacon/states/%#x/committees", forks[i].stateRoot), func(w http.ResponseWriter, _ *http.Request) {
+			_, err := io.WriteString(w, body)
+			require.NoError(t, err)
+		})
+	}
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	attsFeed := new(event.Feed)
+	s, err := NewService(context.Background(), &Config{
+		RESTEndpoints:           []string{srv.URL},
+		BeaconBlockHeadersFeed:  new(event.Feed),
+		IndexedAttestationsFeed: attsFeed,
+	})
+	require.NoError(t, err)
+	s.unmarshaler, err = detect.FromForkVersion(bytesutil.ToBytes4(params.BeaconConfig().GenesisForkVersion))
+	require.NoError(t, err)
+	atts := make(chan *qrysmpb.IndexedAttestation, 1)
+	attsSub := attsFeed.Subscribe(atts)
+	defer attsSub.Unsubscribe()
+
+	for _, fork := range forks {
+		require.NoError(t, s.processBlock(context.Background(), &blockEvent{slot: 5, root: fork.root, node: s.nodes[0]}))
+		assert.DeepEqual(t, fork.want, (<-atts).AttestingIndices)
+	}
+}
+
+func TestService_InsertSlashing_Pending(t *testing.T) {
+	attesterRefusal := "Could not insert attester slashing into pool: " + slashings.ErrAttesterSlashingPending.Error()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/qrl/v1/beacon/pool/attester_slashings", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, err := io.WriteString(w, fmt.Sprintf(`{"code":500,"message":%q}`, attesterRefusal))
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/qrl/v1/beacon/pool/proposer_slashings", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, err := io.WriteString(w, `{"code":500,"message":"Could not insert proposer slashing into pool: validator already slashed"}`)
+		require.NoError(t, err)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	s, err := NewService(context.Background(), &Config{RESTEndpoints: []string{srv.URL}})
+	require.NoError(t, err)
+	att := util.HydrateIndexedAttestation(&qrysmpb.IndexedAttestation{AttestingIndices: []uint64{1}})
+	err = s.InsertAttesterSlashing(context.Background(), nil, &qrysmpb.AttesterSlashing{Attestation_1: att, Attestation_2: att})
+	assert.Equal(t, true, errors.Is(err, slashings.ErrAttesterSlashingPending))
+
+	header := util.HydrateSignedBeaconHeader(&qrysmpb.SignedBeaconBlockHeader{})
+	err = s.InsertProposerSlashing(context.Background(), nil, &qrysmpb.ProposerSlashing{Header_1: header, Header_2: header})
+	require.ErrorContains(t, "validator already slashed", err)
+	assert.Equal(t, false, errors.Is(err, slashings.ErrProposerSlashingPending))
+}
@@ -0,0 +1,60 @@
+package node
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	slasherqrysm "github.com/theQRL/qrysm/beacon-chain/rpc/qrysm/slasher"
+	"github.com/theQRL/qrysm/beacon-chain/slasher"
+)
+
+// httpService serves the slashings detected by the slasher over the same REST endpoints as the beacon node.
+type httpService struct {
+	server     *http.Server
+	lock       sync.RWMutex
+	failStatus error
+}
+
+func newHTTPService(addr string, fetcher slasher.SlashingRecordsFetcher) *httpService {
+	slasherServer := &slasherqrysm.Server{SlashingRecordsFetcher: fetcher}
+	router := mux.NewRouter()
+	router.HandleFunc("/qrysm/slasher/slashings", slasherServer.ListSlashings).Methods(http.MethodGet)
+	router.HandleFunc("/qrysm/slasher/validators/{validator_index}/slashings", slasherServer.ListValidatorSlashings).Methods(http.MethodGet)
+	return &httpService{
+		server: &http.Server{
+			Addr:              addr,
+			Handler:           router,
+			ReadHeaderTimeout: time.Second,
+		},
+	}
+}
+
+// Start serving the REST API.
+func (s *httpService) Start() {
+	go func() {
+		log.WithField("address", s.server.Addr).Info("Starting REST API server")
+		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Errorf("Could not listen to host:port :%s", s.server.Addr)
+			s.lock.Lock()
+			s.failStatus = err
+			s.lock.Unlock()
+		}
+	}()
+}
+
+// Stop the REST API server gracefully.
+func (s *httpService) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// Status checks for any service failure conditions.
+func (s *httpService) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.failStatus
+}
@@ -0,0 +1,240 @@
+// Package node is the main process which handles the lifecycle of
+// the runtime services in a standalone slasher process, which follows
+// beacon nodes to detect slashable offenses on separate hardware.
+package node
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/api/client/beacon"
+	"github.com/theQRL/qrysm/async/event"
+	"github.com/theQRL/qrysm/beacon-chain/db/kv"
+	"github.com/theQRL/qrysm/beacon-chain/db/slasherkv"
+	"github.com/theQRL/qrysm/beacon-chain/slasher"
+	"github.com/theQRL/qrysm/beacon-chain/startup"
+	"github.com/theQRL/qrysm/cmd"
+	"github.com/theQRL/qrysm/cmd/slasher/flags"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/monitoring/prometheus"
+	"github.com/theQRL/qrysm/runtime"
+	"github.com/theQRL/qrysm/runtime/version"
+	"github.com/theQRL/qrysm/slasher/beaconclient"
+	"github.com/urfave/cli/v2"
+)
+
+// genesisRetryDelay is how long to wait before asking the beacon nodes for the genesis again.
+const genesisRetryDelay = 10 * time.Second
+
+// SlasherNode defines a standalone slasher that follows beacon nodes, detects slashable offenses
+// in the blocks they process and submits the slashings to their operations pools.
+type SlasherNode struct {
+	cliCtx    *cli.Context
+	ctx       context.Context
+	cancel    context.CancelFunc
+	db        *slasherkv.Store
+	services  *runtime.ServiceRegistry // Lifecycle and service store.
+	follower  *beaconclient.Service
+	clock     *startup.ClockSynchronizer
+	closeOnce sync.Once
+	lock      sync.RWMutex
+	stop      chan struct{} // Channel to wait for termination notifications.
+}
+
+// New creates a new instance of the standalone slasher.
+func New(cliCtx *cli.Context) (*SlasherNode, error) {
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
+		if err := params.LoadChainConfigFile(chainConfigFileName, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(cliCtx.Context)
+	node := &SlasherNode{
+		cliCtx:   cliCtx,
+		ctx:      ctx,
+		cancel:   cancel,
+		services: runtime.NewServiceRegistry(),
+		clock:    startup.NewClockSynchronizer(),
+		stop:     make(chan struct{}),
+	}
+
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	log.WithField("databasePath", dbPath).Info("Opening slasher database")
+	d, err := slasherkv.NewKVStore(ctx, dbPath)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not open slasher database")
+	}
+	node.db = d
+
+	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
+		if err := node.registerPrometheusService(); err != nil {
+			node.closeOnError()
+			return nil, err
+		}
+	}
+	if err := node.registerSlasherService(); err != nil {
+		node.closeOnError()
+		return nil, err
+	}
+	return node, nil
+}
+
+// Releases the database and the context of a node which failed to be created.
+func (n *SlasherNode) closeOnError() {
+	if err := n.db.Close(); err != nil {
+		log.WithError(err).Error("Failed to close database")
+	}
+	n.cancel()
+}
+
+// Start the slasher once the genesis of the followed chain is known, and wait until it is stopped.
+func (n *SlasherNode) Start() {
+	n.lock.Lock()
+	log.WithFields(logrus.Fields{
+		"version": version.Version(),
+	}).Info("Starting slasher node")
+	stop := n.stop
+	n.lock.Unlock()
+
+	go func() {
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+		defer signal.Stop(sigc)
+		<-sigc
+		log.Info("Got interrupt, shutting down...")
+		go n.Close()
+		for i := 10; i > 0; i-- {
+			<-sigc
+			if i > 1 {
+				log.WithField("times", i-1).Info("Already shutting down, interrupt more to panic.")
+			}
+		}
+		panic("Panic closing the slasher node") // lint:nopanic -- This is just resurfacing the original panic.
+	}()
+
+	if err := n.waitForGenesis(); err != nil {
+		log.WithError(err).Error("Could not start slasher")
+		n.Close()
+	} else {
+		n.lock.Lock()
+		n.services.StartAll()
+		n.lock.Unlock()
+	}
+
+	// Wait for stop channel to be closed.
+	<-stop
+}
+
+// Close handles graceful shutdown of the system.
+func (n *SlasherNode) Close() {
+	n.closeOnce.Do(func() {
+		n.lock.Lock()
+		defer n.lock.Unlock()
+
+		log.Info("Stopping slasher node")
+		n.services.StopAll()
+		if err := n.db.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+		n.cancel()
+		close(n.stop)
+	})
+}
+
+// waitForGenesis activates the chain config of the network of the beacon nodes and sets the genesis clock.
+func (n *SlasherNode) waitForGenesis() error {
+	var genesis *beacon.Genesis
+	for {
+		ctx, cancel := context.WithTimeout(n.ctx, genesisRetryDelay)
+		var err error
+		genesis, err = n.follower.Genesis(ctx)
+		cancel()
+		if err == nil {
+			break
+		}
+		log.WithError(err).Warn("Could not get genesis from the beacon nodes, retrying")
+		select {
+		case <-time.After(genesisRetryDelay):
+		case <-n.ctx.Done():
+			return n.ctx.Err()
+		}
+	}
+	cfg, err := params.ByVersion(genesis.ForkVersion)
+	if err != nil {
+		return errors.Wrapf(err, "beacon nodes follow an unknown network with genesis fork version %#x, use --%s",
+			genesis.ForkVersion, cmd.ChainConfigFileFlag.Name)
+	}
+	if cfg.ConfigName != params.BeaconConfig().ConfigName {
+		log.WithField("network", cfg.ConfigName).Info("Following the network of the beacon nodes")
+		params.OverrideBeaconConfig(cfg)
+	}
+	return n.clock.SetClock(startup.NewClock(genesis.Time, genesis.ValidatorsRoot))
+}
+
+func (n *SlasherNode) registerPrometheusService() error {
+	service := prometheus.NewService(
+		fmt.Sprintf("%s:%d", n.cliCtx.String(cmd.MonitoringHostFlag.Name), n.cliCtx.Int(flags.MonitoringPortFlag.Name)),
+		n.services,
+	)
+	logrus.AddHook(prometheus.NewLogrusCollector())
+	return n.services.RegisterService(service)
+}
+
+func (n *SlasherNode) registerSlasherService() error {
+	attestationsFeed, blockHeadersFeed := new(event.Feed), new(event.Feed)
+	follower, err := beaconclient.NewService(n.ctx, &beaconclient.Config{
+		RESTEndpoints:           n.cliCtx.StringSlice(flags.BeaconRESTApiProviderFlag.Name),
+		GRPCEndpoints:           n.cliCtx.StringSlice(flags.BeaconRPCProviderFlag.Name),
+		GRPCCert:                n.cliCtx.String(flags.CertFlag.Name),
+		IndexedAttestationsFeed: attestationsFeed,
+		BeaconBlockHeadersFeed:  blockHeadersFeed,
+	})
+	if err != nil {
+		return err
+	}
+	n.follower = follower
+	if err := n.services.RegisterService(follower); err != nil {
+		return err
+	}
+
+	auditLogPath := n.cliCtx.String(flags.AuditLogFlag.Name)
+	if auditLogPath == "" {
+		auditLogPath = filepath.Join(n.db.DatabasePath(), "audit.log")
+	}
+	slasherSrv, err := slasher.New(n.ctx, &slasher.ServiceConfig{
+		IndexedAttestationsFeed: attestationsFeed,
+		BeaconBlockHeadersFeed:  blockHeadersFeed,
+		Database:                n.db,
+		StateNotifier:           follower,
+		SlashingPoolInserter:    follower,
+		HeadStateFetcher:        follower,
+		SyncChecker:             follower,
+		ClockWaiter:             n.clock,
+		AuditLogPath:            auditLogPath,
+	})
+	if err != nil {
+		return err
+	}
+	if err := n.services.RegisterService(slasherSrv); err != nil {
+		return err
+	}
+
+	if port := n.cliCtx.Int(flags.HTTPPortFlag.Name); port != 0 {
+		addr := net.JoinHostPort(n.cliCtx.String(flags.HTTPHostFlag.Name), strconv.Itoa(port))
+		return n.services.RegisterService(newHTTPService(addr, slasherSrv))
+	}
+	return nil
+}
@@ -37,21 +37,6 @@ func (m *MockValidatorClient) EXPECT() *MockValidatorClientMockRecorder {
 	return m.recorder
 }
 
-// CheckDoppelGanger mocks base method.
-func (m *MockValidatorClient) CheckDoppelGanger(arg0 context.Context, arg1 *qrysmpb.DoppelGangerRequest) (*qrysmpb.DoppelGangerResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckDoppelGanger", arg0, arg1)
-	ret0, _ := ret[0].(*qrysmpb.DoppelGangerResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CheckDoppelGanger indicates an expected call of CheckDoppelGanger.
-func (mr *MockValidatorClientMockRecorder) CheckDoppelGanger(arg0, arg1 any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDoppelGanger", reflect.TypeOf((*MockValidatorClient)(nil).CheckDoppelGanger), arg0, arg1)
-}
-
 // DomainData mocks base method.
 func (m *MockValidatorClient) DomainData(arg0 context.Context, arg1 *qrysmpb.DomainRequest) (*qrysmpb.DomainResponse, error) {
 	m.ctrl.T.Helper()
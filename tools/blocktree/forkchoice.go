@@ -0,0 +1,177 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/emicklei/dot"
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/debug"
+)
+
+const forkChoicePath = "/qrl/v1/debug/fork_choice"
+
+// forkChoiceGraph is the JSON rendering of a fork choice dump: one entry per node and one edge from every node to its
+// parent, when the parent is part of the dump.
+type forkChoiceGraph struct {
+	JustifiedCheckpoint string            `json:"justified_checkpoint"`
+	FinalizedCheckpoint string            `json:"finalized_checkpoint"`
+	HeadRoot            string            `json:"head_root"`
+	ProposerBoostRoot   string            `json:"proposer_boost_root"`
+	Nodes               []*forkChoiceNode `json:"nodes"`
+	Edges               []*forkChoiceEdge `json:"edges"`
+}
+
+type forkChoiceNode struct {
+	Root               string `json:"root"`
+	Slot               string `json:"slot"`
+	Weight             string `json:"weight"`
+	Balance            string `json:"balance"`
+	JustifiedEpoch     string `json:"justified_epoch"`
+	FinalizedEpoch     string `json:"finalized_epoch"`
+	ExecutionBlockHash string `json:"execution_block_hash"`
+	Validity           string `json:"validity"`
+	ProposerBoost      bool   `json:"proposer_boost"`
+	Head               bool   `json:"head"`
+}
+
+type forkChoiceEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// readForkChoiceDump reads a fork choice dump from a file, or from the debug endpoint of a beacon node.
+func readForkChoiceDump(file, beaconURL string) (*debug.GetForkChoiceDumpResponse, error) {
+	var r io.Reader
+	if file != "" {
+		f, err := os.Open(file) // #nosec G304 -- the file is given by the user.
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		r = f
+	} else {
+		client := &http.Client{Timeout: 30 * time.Second}
+		resp, err := client.Get(strings.TrimSuffix(beaconURL, "/") + forkChoicePath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not request fork choice dump")
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+			return nil, fmt.Errorf("fork choice dump request failed with status %d: %s", resp.StatusCode, body)
+		}
+		r = resp.Body
+	}
+	dump := &debug.GetForkChoiceDumpResponse{}
+	if err := json.NewDecoder(r).Decode(dump); err != nil {
+		return nil, errors.Wrap(err, "could not decode fork choice dump")
+	}
+	return dump, nil
+}
+
+// forkChoiceJSON converts the dump to a graph of nodes and edges.
+func forkChoiceJSON(dump *debug.GetForkChoiceDumpResponse) *forkChoiceGraph {
+	g := &forkChoiceGraph{
+		Nodes: make([]*forkChoiceNode, 0, len(dump.ForkChoiceNodes)),
+		Edges: make([]*forkChoiceEdge, 0, len(dump.ForkChoiceNodes)),
+	}
+	if dump.JustifiedCheckpoint != nil {
+		g.JustifiedCheckpoint = dump.JustifiedCheckpoint.Root
+	}
+	if dump.FinalizedCheckpoint != nil {
+		g.FinalizedCheckpoint = dump.FinalizedCheckpoint.Root
+	}
+	if dump.ExtraData != nil {
+		g.HeadRoot = dump.ExtraData.HeadRoot
+		g.ProposerBoostRoot = dump.ExtraData.ProposerBoostRoot
+	}
+	roots := make(map[string]bool, len(dump.ForkChoiceNodes))
+	for _, n := range dump.ForkChoiceNodes {
+		roots[n.BlockRoot] = true
+	}
+	for _, n := range dump.ForkChoiceNodes {
+		node := &forkChoiceNode{
+			Root:               n.BlockRoot,
+			Slot:               n.Slot,
+			Weight:             n.Weight,
+			JustifiedEpoch:     n.JustifiedEpoch,
+			FinalizedEpoch:     n.FinalizedEpoch,
+			ExecutionBlockHash: n.ExecutionBlockHash,
+			Validity:           n.Validity,
+			Head:               n.BlockRoot == g.HeadRoot,
+		}
+		if n.ExtraData != nil {
+			node.Balance = n.ExtraData.Balance
+			node.ProposerBoost = n.ExtraData.ProposerBoost
+		}
+		g.Nodes = append(g.Nodes, node)
+		if roots[n.ParentRoot] {
+			g.Edges = append(g.Edges, &forkChoiceEdge{From: n.BlockRoot, To: n.ParentRoot})
+		}
+	}
+	return g
+}
+
+// forkChoiceDOT renders the dump as a Graphviz graph. The head is drawn in bold, the node holding the proposer boost
+// in red, optimistic nodes dashed and the justified and finalized checkpoints filled.
+func forkChoiceDOT(dump *debug.GetForkChoiceDumpResponse) *dot.Graph {
+	fc := forkChoiceJSON(dump)
+	graph := dot.NewGraph(dot.Directed)
+	graph.Attr("rankdir", "RL")
+	graph.Attr("labeljust", "l")
+
+	nodes := make(map[string]dot.Node, len(fc.Nodes))
+	for _, n := range fc.Nodes {
+		label := fmt.Sprintf("slot: %s\nroot: %s\nweight: %s\nbalance: %s\njustified: %s finalized: %s\nexecution: %s\n%s",
+			n.Slot, shortRoot(n.Root), n.Weight, n.Balance, n.JustifiedEpoch, n.FinalizedEpoch, shortRoot(n.ExecutionBlockHash), n.Validity)
+		if n.ProposerBoost {
+			label += "\nproposer boost"
+		}
+		dn := graph.Node(n.Root).Box().Attr("label", label)
+		var styles []string
+		switch n.Root {
+		case fc.FinalizedCheckpoint:
+			styles = append(styles, "filled")
+			dn.Attr("fillcolor", "lightgrey")
+		case fc.JustifiedCheckpoint:
+			styles = append(styles, "filled")
+			dn.Attr("fillcolor", "lightblue")
+		}
+		if n.Head {
+			styles = append(styles, "bold")
+		}
+		if n.Validity == "OPTIMISTIC" {
+			styles = append(styles, "dashed")
+		}
+		if len(styles) > 0 {
+			dn.Attr("style", strings.Join(styles, ","))
+		}
+		if n.ProposerBoost {
+			dn.Attr("color", "red")
+		}
+		nodes[n.Root] = dn
+	}
+	for _, e := range fc.Edges {
+		graph.Edge(nodes[e.From], nodes[e.To])
+	}
+	return graph
+}
+
+// shortRoot returns the first two bytes of a hex encoded root, as the block tree built from the database does.
+func shortRoot(root string) string {
+	root = strings.TrimPrefix(root, "0x")
+	if len(root) > 4 {
+		return root[:4]
+	}
+	return root
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+const testDump = `{
+  "justified_checkpoint": {"epoch": "1", "root": "0xaa00"},
+  "finalized_checkpoint": {"epoch": "0", "root": "0xaa00"},
+  "fork_choice_nodes": [
+    {"slot": "128", "block_root": "0xaa00", "parent_root": "0x0000", "justified_epoch": "0", "finalized_epoch": "0", "weight": "300", "validity": "VALID", "execution_block_hash": "0x0a00", "extra_data": {"balance": "0"}},
+    {"slot": "129", "block_root": "0xbb00", "parent_root": "0xaa00", "justified_epoch": "1", "finalized_epoch": "0", "weight": "100", "validity": "VALID", "execution_block_hash": "0x0b00", "extra_data": {"balance": "100"}},
+    {"slot": "130", "block_root": "0xcc00", "parent_root": "0xaa00", "justified_epoch": "1", "finalized_epoch": "0", "weight": "200", "validity": "OPTIMISTIC", "execution_block_hash": "0x0c00", "extra_data": {"balance": "50", "proposer_boost": true}}
+  ],
+  "extra_data": {"head_root": "0xcc00", "proposer_boost_root": "0xcc00"}
+}`
+
+func TestForkChoiceGraph(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "dump.json")
+	require.NoError(t, os.WriteFile(file, []byte(testDump), 0600))
+	d, err := readForkChoiceDump(file, "")
+	require.NoError(t, err)
+
+	g := forkChoiceJSON(d)
+	require.Equal(t, 3, len(g.Nodes))
+	// The parent of the tree root is not part of the dump.
+	require.DeepEqual(t, []*forkChoiceEdge{{From: "0xbb00", To: "0xaa00"}, {From: "0xcc00", To: "0xaa00"}}, g.Edges)
+	require.Equal(t, "50", g.Nodes[2].Balance)
+	require.Equal(t, true, g.Nodes[2].ProposerBoost)
+	require.Equal(t, true, g.Nodes[2].Head)
+	require.Equal(t, false, g.Nodes[1].Head)
+
+	out := forkChoiceDOT(d).String()
+	require.Equal(t, true, strings.Contains(out, "weight: 200"))
+	require.Equal(t, true, strings.Contains(out, "proposer boost"))
+	require.Equal(t, true, strings.Contains(out, "bold,dashed"))
+}
@@ -5,14 +5,20 @@
   - Given a DB, start slot and end slot. This tool computes the graphviz data
   - needed to construct the block tree in graphviz data format. Then one can paste
   - the data in a Graph rendering engine (ie. http://www.webgraphviz.com/) to see the visual format.
+    *
+  - Given a fork choice dump, either read from the /qrl/v1/debug/fork_choice endpoint of a beacon node
+  - or from a file holding the response of that endpoint, it renders the fork choice tree instead, with
+  - the weight, balance, checkpoints, validity and proposer boost of every node, in graphviz or JSON format.
 */
 package main
 
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/emicklei/dot"
@@ -26,6 +32,10 @@ var (
 	datadir   = flag.String("datadir", "", "Path to data directory.")
 	startSlot = flag.Uint("startSlot", 0, "Start slot of the block tree")
 	endSlot   = flag.Uint("endSlot", 0, "Start slot of the block tree")
+	// Fork choice dump
+	beacon = flag.String("beacon", "", "URL of a beacon node HTTP API to read the fork choice dump from, e.g. http://localhost:3500.")
+	dump   = flag.String("dump", "", "Path to a file holding a fork choice dump, as returned by /qrl/v1/debug/fork_choice.")
+	format = flag.String("format", "dot", "Output format of the fork choice tree, dot or json.")
 )
 
 // Used for tree, each node is a representation of a node in the graph
@@ -37,6 +47,13 @@ type node struct {
 
 func main() {
 	flag.Parse()
+	if *beacon != "" || *dump != "" {
+		if err := renderForkChoice(); err != nil {
+			panic(err)
+		}
+		return
+	}
+
 	database, err := db.NewDB(context.Background(), *datadir)
 	if err != nil {
 		panic(err)
@@ -100,3 +117,21 @@ func main() {
 
 	fmt.Println(graph.String())
 }
+
+func renderForkChoice() error {
+	d, err := readForkChoiceDump(*dump, *beacon)
+	if err != nil {
+		return err
+	}
+	switch *format {
+	case "dot":
+		fmt.Println(forkChoiceDOT(d).String())
+		return nil
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(forkChoiceJSON(d))
+	default:
+		return fmt.Errorf("unknown format %q, expected dot or json", *format)
+	}
+}
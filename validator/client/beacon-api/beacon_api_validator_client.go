@@ -37,10 +37,6 @@ func (c *beaconApiValidatorClient) GetDuties(ctx context.Context, in *qrysmpb.Du
 	return c.getDuties(ctx, in)
 }
 
-func (c *beaconApiValidatorClient) CheckDoppelGanger(ctx context.Context, in *qrysmpb.DoppelGangerRequest) (*qrysmpb.DoppelGangerResponse, error) {
-	return c.checkDoppelGanger(ctx, in)
-}
-
 func (c *beaconApiValidatorClient) DomainData(ctx context.Context, in *qrysmpb.DomainRequest) (*qrysmpb.DomainResponse, error) {
 	if len(in.Domain) != 4 {
 		return nil, errors.Errorf("invalid domain type: %s", hexutil.Encode(in.Domain))
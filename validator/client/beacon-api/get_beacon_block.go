@@ -5,13 +5,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	neturl "net/url"
+	"strconv"
 
 	"github.com/pkg/errors"
 	"github.com/theQRL/go-qrl/common/hexutil"
+	"github.com/theQRL/qrysm/api"
 	"github.com/theQRL/qrysm/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/shared"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/validator"
+	"github.com/theQRL/qrysm/config/features"
 	"github.com/theQRL/qrysm/consensus-types/primitives"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/runtime/version"
 )
 
 type abstractProduceBlockResponseJson struct {
@@ -27,6 +34,10 @@ func (c beaconApiValidatorClient) getBeaconBlock(ctx context.Context, slot primi
 		queryParams.Add("graffiti", hexutil.Encode(graffiti))
 	}
 
+	if features.Get().EnableBeaconRESTApiSSZ {
+		return c.produceBlockV3(ctx, buildURL(fmt.Sprintf("/qrl/v3/validator/blocks/%d", slot), queryParams))
+	}
+
 	queryUrl := buildURL(fmt.Sprintf("/qrl/v1/validator/blocks/%d", slot), queryParams)
 
 	// Since we don't know yet what the json looks like, we unmarshal into an abstract structure that has only a version
@@ -61,3 +72,84 @@ func (c beaconApiValidatorClient) getBeaconBlock(ctx context.Context, slot primi
 	}
 	return response, nil
 }
+
+// produceBlockV3Response is a block produced by the /qrl/v3/validator/blocks endpoint. The beacon node returns it as
+// SSZ when asked for, with the consensus version and whether the block is blinded in the response headers, and as
+// JSON otherwise.
+type produceBlockV3Response struct {
+	validator.ProduceBlockV3Response
+	block *qrysmpb.GenericBeaconBlock
+}
+
+func (r *produceBlockV3Response) setHeaders(h http.Header) {
+	r.Version = h.Get(api.VersionHeader)
+	r.ExecutionPayloadBlinded = h.Get(api.ExecutionPayloadBlindedHeader) == "true"
+	r.ExecutionPayloadValue = h.Get(api.ExecutionPayloadValueHeader)
+}
+
+// UnmarshalSSZ decodes the SSZ encoded block of the type given by the response headers.
+func (r *produceBlockV3Response) UnmarshalSSZ(buf []byte) error {
+	if r.Version != version.String(version.Zond) {
+		return errors.Errorf("unsupported consensus version `%s`", r.Version)
+	}
+	if r.ExecutionPayloadBlinded {
+		blk := &qrysmpb.BlindedBeaconBlockZond{}
+		if err := blk.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+		r.block = &qrysmpb.GenericBeaconBlock{Block: &qrysmpb.GenericBeaconBlock_BlindedZond{BlindedZond: blk}, IsBlinded: true}
+		return nil
+	}
+	blk := &qrysmpb.BeaconBlockZond{}
+	if err := blk.UnmarshalSSZ(buf); err != nil {
+		return err
+	}
+	r.block = &qrysmpb.GenericBeaconBlock{Block: &qrysmpb.GenericBeaconBlock_Zond{Zond: blk}}
+	return nil
+}
+
+// genericBlock returns the produced block, decoding it from the JSON response if it was not received as SSZ.
+func (r *produceBlockV3Response) genericBlock() (*qrysmpb.GenericBeaconBlock, error) {
+	blk := r.block
+	if blk == nil {
+		if r.Version != version.String(version.Zond) {
+			return nil, errors.Errorf("unsupported consensus version `%s`", r.Version)
+		}
+		decoder := json.NewDecoder(bytes.NewReader(r.Data))
+		decoder.DisallowUnknownFields()
+		var err error
+		if r.ExecutionPayloadBlinded {
+			jsonBlock := &shared.BlindedBeaconBlockZond{}
+			if err := decoder.Decode(jsonBlock); err != nil {
+				return nil, errors.Wrap(err, "failed to decode blinded zond block response json")
+			}
+			blk, err = jsonBlock.ToGeneric()
+		} else {
+			jsonBlock := &shared.BeaconBlockZond{}
+			if err := decoder.Decode(jsonBlock); err != nil {
+				return nil, errors.Wrap(err, "failed to decode zond block response json")
+			}
+			blk, err = jsonBlock.ToGeneric()
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get zond block")
+		}
+	}
+	if r.ExecutionPayloadValue != "" {
+		value, err := strconv.ParseUint(r.ExecutionPayloadValue, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse execution payload value")
+		}
+		blk.PayloadValue = value
+	}
+	return blk, nil
+}
+
+// produceBlockV3 requests a block from the /qrl/v3/validator/blocks endpoint, preferring an SSZ encoded response.
+func (c beaconApiValidatorClient) produceBlockV3(ctx context.Context, queryUrl string) (*qrysmpb.GenericBeaconBlock, error) {
+	resp := &produceBlockV3Response{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, queryUrl, resp); err != nil {
+		return nil, errors.Wrap(err, "failed to query GET REST endpoint")
+	}
+	return resp.genericBlock()
+}
@@ -5,11 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/theQRL/go-qrl/common/hexutil"
+	"github.com/theQRL/qrysm/api"
 	"github.com/theQRL/qrysm/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/qrysm/config/features"
 	"github.com/theQRL/qrysm/consensus-types/primitives"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 	"github.com/theQRL/qrysm/testing/assert"
@@ -160,3 +165,47 @@ func TestGetBeaconBlock_ZondValid(t *testing.T) {
 
 	assert.DeepEqual(t, expectedBeaconBlock, beaconBlock)
 }
+
+func TestGetBeaconBlock_ZondValidSSZ(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableBeaconRESTApiSSZ: true})
+	defer resetCfg()
+
+	zondProtoBeaconBlock := test_helpers.GenerateProtoZondBeaconBlock()
+	sszBlock, err := zondProtoBeaconBlock.MarshalSSZ()
+	require.NoError(t, err)
+
+	const slot = primitives.Slot(1)
+	randaoReveal := []byte{2}
+	graffiti := []byte{3}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(fmt.Sprintf("/qrl/v3/validator/blocks/%d", slot), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, sszAcceptHeader, r.Header.Get("Accept"))
+		assert.Equal(t, hexutil.Encode(randaoReveal), r.URL.Query().Get("randao_reveal"))
+		w.Header().Set("Content-Type", api.OctetStreamMediaType)
+		w.Header().Set(api.VersionHeader, "zond")
+		w.Header().Set(api.ExecutionPayloadBlindedHeader, "false")
+		w.Header().Set(api.ExecutionPayloadValueHeader, "123")
+		_, err := w.Write(sszBlock)
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	jsonRestHandler := beaconApiJsonRestHandler{
+		timeout: time.Second * 5,
+		host:    server.URL,
+		ssz:     true,
+	}
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	beaconBlock, err := validatorClient.getBeaconBlock(context.Background(), slot, randaoReveal, graffiti)
+	require.NoError(t, err)
+
+	expectedBeaconBlock := &qrysmpb.GenericBeaconBlock{
+		Block: &qrysmpb.GenericBeaconBlock_Zond{
+			Zond: zondProtoBeaconBlock,
+		},
+		PayloadValue: 123,
+	}
+	assert.DeepEqual(t, expectedBeaconBlock, beaconBlock)
+}
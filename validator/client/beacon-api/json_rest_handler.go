@@ -13,17 +13,36 @@ import (
 	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/api"
 	"github.com/theQRL/qrysm/api/gateway/apimiddleware"
+	"github.com/theQRL/qrysm/config/features"
 )
 
+// sszAcceptHeader prefers SSZ encoded responses and falls back to JSON for endpoints that only serve JSON.
+const sszAcceptHeader = api.OctetStreamMediaType + ";q=1.0," + api.JsonMediaType + ";q=0.9"
+
 type jsonRestHandler interface {
 	GetRestJsonResponse(ctx context.Context, query string, responseJson any) (*apimiddleware.DefaultErrorJson, error)
 	PostRestJson(ctx context.Context, apiEndpoint string, headers map[string]string, data *bytes.Buffer, responseJson any) (*apimiddleware.DefaultErrorJson, error)
 }
 
+// sszResponse is implemented by the response types that can also be decoded from an SSZ encoded body. When SSZ is
+// enabled, the handler asks the beacon node for SSZ when decoding into such a type.
+type sszResponse interface {
+	UnmarshalSSZ(buf []byte) error
+}
+
+// sszHeaderResponse is implemented by SSZ responses that need the response headers to decode the body, e.g. to learn
+// the type of the block that was produced.
+type sszHeaderResponse interface {
+	sszResponse
+	setHeaders(h http.Header)
+}
+
 type beaconApiJsonRestHandler struct {
 	timeout time.Duration
 	host    string
 	hostSet *beaconAPIHostSet
+	// ssz negotiates SSZ encoded responses for the response types that support them.
+	ssz bool
 }
 
 type beaconAPIHostSet struct {
@@ -86,6 +105,7 @@ func newBeaconAPIJSONRestHandler(host string, timeout time.Duration) beaconApiJs
 		timeout: timeout,
 		host:    host,
 		hostSet: newBeaconAPIHostSet(host),
+		ssz:     features.Get().EnableBeaconRESTApiSSZ,
 	}
 }
 
@@ -127,6 +147,7 @@ func (c beaconApiJsonRestHandler) doGetRestJsonResponse(ctx context.Context, hos
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create request with context")
 	}
+	c.setAcceptHeader(req, responseJson)
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -155,10 +176,12 @@ func (c beaconApiJsonRestHandler) doPostRestJson(
 		return nil, errors.Wrap(err, "failed to create request with context")
 	}
 
+	req.Header.Set("Content-Type", api.JsonMediaType)
 	for headerKey, headerValue := range headers {
 		req.Header.Set(headerKey, headerValue)
 	}
-	req.Header.Set("Content-Type", api.JsonMediaType)
+	c.setAcceptHeader(req, responseJson)
+	observeRequest(req.Header.Get("Content-Type"), len(data))
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -240,6 +263,16 @@ func (c beaconApiJsonRestHandler) PostRestJson(ctx context.Context, apiEndpoint
 	return errorJSON, err
 }
 
+// setAcceptHeader asks for an SSZ encoded response when SSZ is enabled and the response type can be decoded from SSZ.
+func (c beaconApiJsonRestHandler) setAcceptHeader(req *http.Request, responseJson any) {
+	if !c.ssz {
+		return
+	}
+	if _, ok := responseJson.(sszResponse); ok {
+		req.Header.Set("Accept", sszAcceptHeader)
+	}
+}
+
 func decodeJsonResp(resp *http.Response, responseJson any) (*apimiddleware.DefaultErrorJson, error) {
 	if resp.StatusCode != http.StatusOK {
 		// Read the body up-front so we can surface it verbatim if it isn't
@@ -260,13 +293,34 @@ func decodeJsonResp(resp *http.Response, responseJson any) (*apimiddleware.Defau
 		return errorJson, errors.Errorf("error %d: %s", errorJson.Code, errorJson.Message)
 	}
 
-	if responseJson != nil {
-		decoder := json.NewDecoder(resp.Body)
+	if responseJson == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read response body for %s", resp.Request.URL)
+	}
+	start := time.Now()
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == api.OctetStreamMediaType {
+		sszResp, ok := responseJson.(sszResponse)
+		if !ok {
+			return nil, errors.Errorf("unexpected SSZ response for %s", resp.Request.URL)
+		}
+		if h, ok := sszResp.(sszHeaderResponse); ok {
+			h.setHeaders(resp.Header)
+		}
+		if err := sszResp.UnmarshalSSZ(body); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode response ssz for %s", resp.Request.URL)
+		}
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(body))
 		decoder.DisallowUnknownFields()
 		if err := decoder.Decode(responseJson); err != nil {
 			return nil, errors.Wrapf(err, "failed to decode response json for %s", resp.Request.URL)
 		}
 	}
+	observeResponse(contentType, len(body), time.Since(start))
 
 	return nil, nil
 }
@@ -11,10 +11,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/theQRL/qrysm/api"
 	"github.com/theQRL/qrysm/api/gateway/apimiddleware"
 	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/beacon"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 	"github.com/theQRL/qrysm/testing/assert"
 	"github.com/theQRL/qrysm/testing/require"
+	test_helpers "github.com/theQRL/qrysm/validator/client/beacon-api/test-helpers"
 )
 
 func TestGetRestJsonResponse_Valid(t *testing.T) {
@@ -56,6 +59,76 @@ func TestGetRestJsonResponse_Valid(t *testing.T) {
 	assert.DeepEqual(t, genesisJson, responseJson)
 }
 
+func TestGetRestJsonResponse_SSZ(t *testing.T) {
+	const endpoint = "/example/rest/api/endpoint"
+
+	att := &qrysmpb.Attestation{
+		AggregationBits: []byte{0b11},
+		Data: &qrysmpb.AttestationData{
+			Slot:            1,
+			CommitteeIndex:  2,
+			BeaconBlockRoot: test_helpers.FillByteSlice(32, 3),
+			Source:          &qrysmpb.Checkpoint{Epoch: 4, Root: test_helpers.FillByteSlice(32, 5)},
+			Target:          &qrysmpb.Checkpoint{Epoch: 6, Root: test_helpers.FillByteSlice(32, 7)},
+		},
+		Signatures: [][]byte{test_helpers.FillByteSlice(4627, 8)},
+	}
+	sszAtt, err := att.MarshalSSZ()
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != sszAcceptHeader {
+			_, err := w.Write([]byte(`{"data":null}`))
+			require.NoError(t, err)
+			return
+		}
+		w.Header().Set("Content-Type", api.OctetStreamMediaType)
+		_, err := w.Write(sszAtt)
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Run("ssz", func(t *testing.T) {
+		jsonRestHandler := beaconApiJsonRestHandler{
+			timeout: time.Second * 5,
+			host:    server.URL,
+			ssz:     true,
+		}
+		resp := &aggregateAttestationSSZResponse{}
+		_, err := jsonRestHandler.GetRestJsonResponse(context.Background(), endpoint, resp)
+		require.NoError(t, err)
+		assert.DeepSSZEqual(t, att, resp.attestation)
+		assert.Equal(t, true, resp.Data == nil)
+	})
+	t.Run("json when disabled", func(t *testing.T) {
+		jsonRestHandler := beaconApiJsonRestHandler{
+			timeout: time.Second * 5,
+			host:    server.URL,
+		}
+		resp := &aggregateAttestationSSZResponse{}
+		_, err := jsonRestHandler.GetRestJsonResponse(context.Background(), endpoint, resp)
+		require.NoError(t, err)
+		assert.Equal(t, true, resp.Data == nil)
+		assert.Equal(t, true, resp.attestation == nil)
+	})
+	t.Run("response type without ssz", func(t *testing.T) {
+		mux.HandleFunc("/unexpected", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", api.OctetStreamMediaType)
+			_, err := w.Write(sszAtt)
+			require.NoError(t, err)
+		})
+		jsonRestHandler := beaconApiJsonRestHandler{
+			timeout: time.Second * 5,
+			host:    server.URL,
+			ssz:     true,
+		}
+		_, err := jsonRestHandler.GetRestJsonResponse(context.Background(), "/unexpected", &beacon.GetGenesisResponse{})
+		assert.ErrorContains(t, "unexpected SSZ response", err)
+	})
+}
+
 func TestGetRestJsonResponse_Error(t *testing.T) {
 	const endpoint = "/example/rest/api/endpoint"
 
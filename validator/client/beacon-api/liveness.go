@@ -0,0 +1,71 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/validator"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+)
+
+// LivenessProvider queries the liveness of validators from a single beacon node.
+type LivenessProvider struct {
+	host            string
+	jsonRestHandler jsonRestHandler
+}
+
+// NewLivenessProviders returns a liveness provider for each of the comma-separated beacon node hosts.
+// Unlike the other REST clients, the hosts are not used for failover: each one is queried on its own,
+// so that a validator seen live by any of them is noticed.
+func NewLivenessProviders(hosts string, timeout time.Duration) []*LivenessProvider {
+	split := splitBeaconAPIHosts(hosts)
+	providers := make([]*LivenessProvider, len(split))
+	for i, host := range split {
+		providers[i] = &LivenessProvider{
+			host:            host,
+			jsonRestHandler: newBeaconAPIJSONRestHandler(host, timeout),
+		}
+	}
+	return providers
+}
+
+// Host returns the beacon node host queried by the provider.
+func (p *LivenessProvider) Host() string {
+	return p.host
+}
+
+// Liveness returns whether each of the validators was seen live by the beacon node during the epoch.
+func (p *LivenessProvider) Liveness(ctx context.Context, epoch primitives.Epoch, indices []primitives.ValidatorIndex) (map[primitives.ValidatorIndex]bool, error) {
+	stringIndices := make([]string, len(indices))
+	for i, index := range indices {
+		stringIndices[i] = strconv.FormatUint(uint64(index), 10)
+	}
+	marshalledIndices, err := json.Marshal(stringIndices)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal validator indices")
+	}
+
+	url := fmt.Sprintf("/qrl/v1/validator/liveness/%d", epoch)
+	resp := &validator.GetLivenessResponse{}
+	if _, err := p.jsonRestHandler.PostRestJson(ctx, url, nil, bytes.NewBuffer(marshalledIndices), resp); err != nil {
+		return nil, errors.Wrapf(err, "failed to send POST data to `%s` REST URL", url)
+	}
+
+	liveness := make(map[primitives.ValidatorIndex]bool, len(resp.Data))
+	for _, l := range resp.Data {
+		if l == nil {
+			return nil, errors.New("liveness is nil")
+		}
+		index, err := strconv.ParseUint(l.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse validator index %s", l.Index)
+		}
+		liveness[primitives.ValidatorIndex(index)] = l.IsLive
+	}
+	return liveness, nil
+}
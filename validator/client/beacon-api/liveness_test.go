@@ -0,0 +1,55 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/theQRL/qrysm/beacon-chain/rpc/qrl/validator"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/validator/client/beacon-api/mock"
+)
+
+func TestNewLivenessProviders(t *testing.T) {
+	providers := NewLivenessProviders("http://a:3500, http://b:3500,", time.Second)
+	require.Equal(t, 2, len(providers))
+	assert.Equal(t, "http://a:3500", providers[0].Host())
+	assert.Equal(t, "http://b:3500", providers[1].Host())
+}
+
+func TestLivenessProvider_Liveness(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	marshalledIndices, err := json.Marshal([]string{"1", "2"})
+	require.NoError(t, err)
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().PostRestJson(
+		ctx,
+		"/qrl/v1/validator/liveness/3",
+		nil,
+		bytes.NewBuffer(marshalledIndices),
+		&validator.GetLivenessResponse{},
+	).Return(
+		nil,
+		nil,
+	).SetArg(
+		4,
+		validator.GetLivenessResponse{Data: []*validator.ValidatorLiveness{
+			{Index: "1", IsLive: true},
+			{Index: "2", IsLive: false},
+		}},
+	).Times(1)
+
+	provider := &LivenessProvider{jsonRestHandler: jsonRestHandler}
+	liveness, err := provider.Liveness(ctx, 3, []primitives.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[primitives.ValidatorIndex]bool{1: true, 2: false}, liveness)
+}
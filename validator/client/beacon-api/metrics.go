@@ -0,0 +1,56 @@
+package beacon_api
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/theQRL/qrysm/api"
+)
+
+var (
+	// Block and attestation payloads are dominated by ML-DSA-87 signatures and public keys, which take twice as many
+	// bytes hex encoded in JSON as in SSZ. The size and decoding time are tracked per encoding so that both can be
+	// compared on a given link.
+	beaconAPIRequestBytes = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "validator_beacon_api_request_bytes",
+			Help:    "Size of the bodies sent to the beacon node REST API, by encoding.",
+			Buckets: prometheus.ExponentialBuckets(256, 4, 8),
+		},
+		[]string{"encoding"},
+	)
+	beaconAPIResponseBytes = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "validator_beacon_api_response_bytes",
+			Help:    "Size of the bodies received from the beacon node REST API, by encoding.",
+			Buckets: prometheus.ExponentialBuckets(256, 4, 8),
+		},
+		[]string{"encoding"},
+	)
+	beaconAPIDecodeSeconds = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "validator_beacon_api_decode_seconds",
+			Help:    "Time spent decoding the bodies received from the beacon node REST API, by encoding.",
+			Buckets: prometheus.ExponentialBuckets(0.00001, 4, 8),
+		},
+		[]string{"encoding"},
+	)
+)
+
+func encodingLabel(contentType string) string {
+	if contentType == api.OctetStreamMediaType {
+		return "ssz"
+	}
+	return "json"
+}
+
+func observeRequest(contentType string, size int) {
+	beaconAPIRequestBytes.WithLabelValues(encodingLabel(contentType)).Observe(float64(size))
+}
+
+func observeResponse(contentType string, size int, decodeTime time.Duration) {
+	label := encodingLabel(contentType)
+	beaconAPIResponseBytes.WithLabelValues(label).Observe(float64(size))
+	beaconAPIDecodeSeconds.WithLabelValues(label).Observe(decodeTime.Seconds())
+}
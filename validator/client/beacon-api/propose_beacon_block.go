@@ -8,7 +8,10 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/theQRL/go-qrl/common/hexutil"
+	"github.com/theQRL/qrysm/api"
+	gatewaymiddleware "github.com/theQRL/qrysm/api/gateway/apimiddleware"
 	"github.com/theQRL/qrysm/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/qrysm/config/features"
 	"github.com/theQRL/qrysm/encoding/bytesutil"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 )
@@ -18,7 +21,6 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *qr
 	var beaconBlockRoot [32]byte
 
 	var err error
-	var marshalledSignedBeaconBlockJson []byte
 	blinded := false
 
 	switch blockType := in.Block.(type) {
@@ -28,11 +30,6 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *qr
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for zond beacon block")
 		}
-
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockZond(blockType.Zond)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall zond beacon block")
-		}
 	case *qrysmpb.GenericSignedBeaconBlock_BlindedZond:
 		blinded = true
 		consensusVersion = "zond"
@@ -40,11 +37,6 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *qr
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for blinded zond beacon block")
 		}
-
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockBlindedZond(blockType.BlindedZond)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall blinded zond beacon block")
-		}
 	default:
 		return nil, errors.Errorf("unsupported block type %T", in.Block)
 	}
@@ -57,19 +49,70 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *qr
 		endpoint = "/qrl/v1/beacon/blocks"
 	}
 
-	headers := map[string]string{"Qrl-Consensus-Version": consensusVersion}
-	if httpError, err := c.jsonRestHandler.PostRestJson(ctx, endpoint, headers, bytes.NewBuffer(marshalledSignedBeaconBlockJson), nil); err != nil {
-		if httpError != nil && httpError.Code == http.StatusAccepted {
-			// Error 202 means that the block was successfully broadcasted, but validation failed
-			return nil, errors.Wrap(err, "block was successfully broadcasted but failed validation")
+	if features.Get().EnableBeaconRESTApiSSZ {
+		sszBlock, err := marshalSignedBeaconBlockSSZ(in)
+		if err != nil {
+			return nil, err
+		}
+		headers := map[string]string{
+			api.VersionHeader: consensusVersion,
+			"Content-Type":    api.OctetStreamMediaType,
 		}
+		httpError, err := c.jsonRestHandler.PostRestJson(ctx, endpoint, headers, bytes.NewBuffer(sszBlock), nil)
+		if err == nil {
+			return &qrysmpb.ProposeResponse{BlockRoot: beaconBlockRoot[:]}, nil
+		}
+		// Beacon nodes that do not accept SSZ encoded blocks are sent the block as JSON. Nodes that do not know the
+		// Content-Type either reject it with 415, or try to decode the body as JSON and reject it with 400.
+		if httpError == nil || (httpError.Code != http.StatusUnsupportedMediaType && httpError.Code != http.StatusBadRequest) {
+			return nil, wrapProposeError(httpError, err)
+		}
+		log.WithError(err).Debug("Beacon node does not accept SSZ encoded blocks, sending the block as JSON")
+	}
 
-		return nil, errors.Wrap(err, "failed to send POST data to REST endpoint")
+	var marshalledSignedBeaconBlockJson []byte
+	switch blockType := in.Block.(type) {
+	case *qrysmpb.GenericSignedBeaconBlock_Zond:
+		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockZond(blockType.Zond)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshall zond beacon block")
+		}
+	case *qrysmpb.GenericSignedBeaconBlock_BlindedZond:
+		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockBlindedZond(blockType.BlindedZond)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshall blinded zond beacon block")
+		}
+	}
+
+	headers := map[string]string{"Qrl-Consensus-Version": consensusVersion}
+	if httpError, err := c.jsonRestHandler.PostRestJson(ctx, endpoint, headers, bytes.NewBuffer(marshalledSignedBeaconBlockJson), nil); err != nil {
+		return nil, wrapProposeError(httpError, err)
 	}
 
 	return &qrysmpb.ProposeResponse{BlockRoot: beaconBlockRoot[:]}, nil
 }
 
+func wrapProposeError(httpError *gatewaymiddleware.DefaultErrorJson, err error) error {
+	if httpError != nil && httpError.Code == http.StatusAccepted {
+		// Error 202 means that the block was successfully broadcasted, but validation failed
+		return errors.Wrap(err, "block was successfully broadcasted but failed validation")
+	}
+	return errors.Wrap(err, "failed to send POST data to REST endpoint")
+}
+
+func marshalSignedBeaconBlockSSZ(in *qrysmpb.GenericSignedBeaconBlock) ([]byte, error) {
+	switch blockType := in.Block.(type) {
+	case *qrysmpb.GenericSignedBeaconBlock_Zond:
+		b, err := blockType.Zond.MarshalSSZ()
+		return b, errors.Wrap(err, "failed to marshal zond beacon block to SSZ")
+	case *qrysmpb.GenericSignedBeaconBlock_BlindedZond:
+		b, err := blockType.BlindedZond.MarshalSSZ()
+		return b, errors.Wrap(err, "failed to marshal blinded zond beacon block to SSZ")
+	default:
+		return nil, errors.Errorf("unsupported block type %T", in.Block)
+	}
+}
+
 func marshallBeaconBlockZond(block *qrysmpb.SignedBeaconBlockZond) ([]byte, error) {
 	signedBeaconBlockZondJson := &apimiddleware.SignedBeaconBlockZondJson{
 		Signature: hexutil.Encode(block.Signature),
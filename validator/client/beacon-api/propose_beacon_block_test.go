@@ -1,15 +1,20 @@
 package beacon_api
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/theQRL/qrysm/api"
 	"github.com/theQRL/qrysm/api/gateway/apimiddleware"
+	"github.com/theQRL/qrysm/config/features"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
 	"github.com/theQRL/qrysm/validator/client/beacon-api/mock"
 )
 
@@ -93,3 +98,122 @@ func TestProposeBeaconBlock_UnsupportedBlockType(t *testing.T) {
 	_, err := validatorClient.proposeBeaconBlock(context.Background(), &qrysmpb.GenericSignedBeaconBlock{})
 	assert.ErrorContains(t, "unsupported block type", err)
 }
+
+func TestProposeBeaconBlock_SSZ(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableBeaconRESTApiSSZ: true})
+	defer resetCfg()
+
+	block := &qrysmpb.GenericSignedBeaconBlock{Block: generateSignedZondBlock()}
+	sszBlock, err := marshalSignedBeaconBlockSSZ(block)
+	require.NoError(t, err)
+	sszHeaders := map[string]string{
+		api.VersionHeader: "zond",
+		"Content-Type":    api.OctetStreamMediaType,
+	}
+	jsonHeaders := map[string]string{"Qrl-Consensus-Version": "zond"}
+
+	t.Run("ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().PostRestJson(
+			ctx,
+			"/qrl/v1/beacon/blocks",
+			sszHeaders,
+			bytes.NewBuffer(sszBlock),
+			nil,
+		).Return(
+			nil,
+			nil,
+		).Times(1)
+
+		validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+		resp, err := validatorClient.proposeBeaconBlock(ctx, block)
+		require.NoError(t, err)
+		root, err := block.GetZond().Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.DeepEqual(t, root[:], resp.BlockRoot)
+	})
+	for _, code := range []int{http.StatusUnsupportedMediaType, http.StatusBadRequest} {
+		t.Run(fmt.Sprintf("falls back to json on error %d", code), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+			gomock.InOrder(
+				jsonRestHandler.EXPECT().PostRestJson(
+					ctx,
+					"/qrl/v1/beacon/blocks",
+					sszHeaders,
+					gomock.Any(),
+					nil,
+				).Return(
+					&apimiddleware.DefaultErrorJson{Code: code},
+					errors.New("unsupported media type"),
+				).Times(1),
+				jsonRestHandler.EXPECT().PostRestJson(
+					ctx,
+					"/qrl/v1/beacon/blocks",
+					jsonHeaders,
+					gomock.Any(),
+					nil,
+				).Return(
+					nil,
+					nil,
+				).Times(1),
+			)
+
+			validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+			_, err := validatorClient.proposeBeaconBlock(ctx, block)
+			require.NoError(t, err)
+		})
+	}
+	t.Run("error 202", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().PostRestJson(
+			ctx,
+			"/qrl/v1/beacon/blocks",
+			sszHeaders,
+			gomock.Any(),
+			nil,
+		).Return(
+			&apimiddleware.DefaultErrorJson{Code: http.StatusAccepted},
+			errors.New("foo error"),
+		).Times(1)
+
+		validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+		_, err := validatorClient.proposeBeaconBlock(ctx, block)
+		assert.ErrorContains(t, "block was successfully broadcasted but failed validation", err)
+	})
+}
+
+// BenchmarkProposeBeaconBlock_Encoding compares the size and encoding time of a signed block sent as JSON and as SSZ.
+func BenchmarkProposeBeaconBlock_Encoding(b *testing.B) {
+	block := &qrysmpb.GenericSignedBeaconBlock{Block: generateSignedZondBlock()}
+
+	b.Run("json", func(b *testing.B) {
+		var size int
+		for i := 0; i < b.N; i++ {
+			enc, err := marshallBeaconBlockZond(block.GetZond())
+			require.NoError(b, err)
+			size = len(enc)
+		}
+		b.ReportMetric(float64(size), "bytes/block")
+	})
+	b.Run("ssz", func(b *testing.B) {
+		var size int
+		for i := 0; i < b.N; i++ {
+			enc, err := marshalSignedBeaconBlockSSZ(block)
+			require.NoError(b, err)
+			size = len(enc)
+		}
+		b.ReportMetric(float64(size), "bytes/block")
+	})
+}
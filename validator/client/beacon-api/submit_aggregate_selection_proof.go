@@ -9,6 +9,7 @@ import (
 	"github.com/theQRL/go-qrl/common/hexutil"
 	"github.com/theQRL/qrysm/beacon-chain/core/helpers"
 	"github.com/theQRL/qrysm/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/qrysm/config/features"
 	"github.com/theQRL/qrysm/consensus-types/primitives"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
 	"github.com/theQRL/qrysm/time/slots"
@@ -65,16 +66,11 @@ func (c *beaconApiValidatorClient) submitAggregateSelectionProof(ctx context.Con
 		return nil, errors.Wrap(err, "failed to calculate attestation data root")
 	}
 
-	aggregateAttestationResponse, err := c.getAggregateAttestation(ctx, in.Slot, attestationDataRoot[:])
+	aggregatedAttestation, err := c.getAggregateAttestation(ctx, in.Slot, attestationDataRoot[:])
 	if err != nil {
 		return nil, err
 	}
 
-	aggregatedAttestation, err := convertAttestationToProto(aggregateAttestationResponse.Data)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to convert aggregate attestation json to proto")
-	}
-
 	return &qrysmpb.AggregateSelectionResponse{
 		AggregateAndProof: &qrysmpb.AggregateAttestationAndProof{
 			AggregatorIndex: validatorIndexResponse.Index,
@@ -84,16 +80,50 @@ func (c *beaconApiValidatorClient) submitAggregateSelectionProof(ctx context.Con
 	}, nil
 }
 
-func (c *beaconApiValidatorClient) getAggregateAttestation(ctx context.Context, slot primitives.Slot, attestationDataRoot []byte) (*apimiddleware.AggregateAttestationResponseJson, error) {
+func (c *beaconApiValidatorClient) getAggregateAttestation(ctx context.Context, slot primitives.Slot, attestationDataRoot []byte) (*qrysmpb.Attestation, error) {
 	params := url.Values{}
 	params.Add("slot", strconv.FormatUint(uint64(slot), 10))
 	params.Add("attestation_data_root", hexutil.Encode(attestationDataRoot))
 	endpoint := buildURL("/qrl/v1/validator/aggregate_attestation", params)
 
-	var aggregateAttestationResponse apimiddleware.AggregateAttestationResponseJson
-	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, endpoint, &aggregateAttestationResponse); err != nil {
-		return nil, errors.Wrap(err, "failed to get aggregate attestation")
+	var aggregateAttestationJson *apimiddleware.AttestationJson
+	if features.Get().EnableBeaconRESTApiSSZ {
+		var aggregateAttestationResponse aggregateAttestationSSZResponse
+		if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, endpoint, &aggregateAttestationResponse); err != nil {
+			return nil, errors.Wrap(err, "failed to get aggregate attestation")
+		}
+		if aggregateAttestationResponse.attestation != nil {
+			return aggregateAttestationResponse.attestation, nil
+		}
+		aggregateAttestationJson = aggregateAttestationResponse.Data
+	} else {
+		var aggregateAttestationResponse apimiddleware.AggregateAttestationResponseJson
+		if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, endpoint, &aggregateAttestationResponse); err != nil {
+			return nil, errors.Wrap(err, "failed to get aggregate attestation")
+		}
+		aggregateAttestationJson = aggregateAttestationResponse.Data
+	}
+
+	aggregatedAttestation, err := convertAttestationToProto(aggregateAttestationJson)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert aggregate attestation json to proto")
 	}
+	return aggregatedAttestation, nil
+}
 
-	return &aggregateAttestationResponse, nil
+// aggregateAttestationSSZResponse decodes the aggregate attestation from either a JSON or an SSZ response body.
+type aggregateAttestationSSZResponse struct {
+	apimiddleware.AggregateAttestationResponseJson
+	// attestation is the attestation decoded from an SSZ response body.
+	attestation *qrysmpb.Attestation
+}
+
+// UnmarshalSSZ decodes an SSZ encoded attestation.
+func (r *aggregateAttestationSSZResponse) UnmarshalSSZ(buf []byte) error {
+	att := &qrysmpb.Attestation{}
+	if err := att.UnmarshalSSZ(buf); err != nil {
+		return errors.Wrap(err, "failed to unmarshal SSZ encoded attestation")
+	}
+	r.attestation = att
+	return nil
 }
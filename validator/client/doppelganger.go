@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/time/slots"
+	"github.com/theQRL/qrysm/validator/client/doppelganger"
+	vdb "github.com/theQRL/qrysm/validator/db"
+)
+
+// doppelgangerHistory tells the doppelganger service whether this validator client signed with a key
+// during an epoch, according to the slashing protection database.
+type doppelgangerHistory struct {
+	db vdb.Database
+}
+
+var _ doppelganger.History = (*doppelgangerHistory)(nil)
+
+// SignedInEpoch returns true if an attestation targeting the epoch or a block in the epoch was signed
+// with the key.
+func (h *doppelgangerHistory) SignedInEpoch(ctx context.Context, pubKey [fieldparams.MLDSA87PubkeyLength]byte, epoch primitives.Epoch) (bool, error) {
+	atts, err := h.db.AttestationHistoryForPubKey(ctx, pubKey)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get attestation history")
+	}
+	for _, att := range atts {
+		if att.Target == epoch {
+			return true, nil
+		}
+	}
+	proposals, err := h.db.ProposalHistoryForPubKey(ctx, pubKey)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get proposal history")
+	}
+	for _, p := range proposals {
+		if slots.ToEpoch(p.Slot) == epoch {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CheckDoppelGanger starts the doppelganger check of the current validating keys and runs it for the
+// current epoch. Keys do not sign until their check completed without them being seen live, so a failure
+// to reach the beacon nodes is logged rather than returned.
+func (v *validator) CheckDoppelGanger(ctx context.Context) error {
+	if v.doppelganger == nil {
+		return nil
+	}
+	pubkeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return err
+	}
+	log.WithField("keys", len(pubkeys)).Info("Running doppelganger check")
+	currentEpoch := slots.ToEpoch(slots.CurrentSlot(v.genesisTime))
+	v.doppelganger.Track(pubkeys, currentEpoch)
+	// Exit early if no validating pub keys are found.
+	if len(pubkeys) == 0 {
+		return nil
+	}
+	indices, err := v.doppelgangerIndices(ctx, pubkeys)
+	if err != nil {
+		return errors.Wrap(err, "could not get validator indices")
+	}
+	if err := v.doppelganger.Check(ctx, currentEpoch, indices); err != nil {
+		log.WithError(err).Warn("Could not check validators for doppelgangers, keys will not sign until they are checked")
+	}
+	return nil
+}
+
+// checkDoppelGangers starts the doppelganger check of keys that were added since the previous check and
+// continues the check of the keys that are still being checked, without blocking the caller.
+func (v *validator) checkDoppelGangers(
+	pubKeys [][fieldparams.MLDSA87PubkeyLength]byte,
+	currentEpoch primitives.Epoch,
+	indices map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.ValidatorIndex,
+) {
+	if v.doppelganger == nil {
+		return
+	}
+	v.doppelganger.Track(pubKeys, currentEpoch)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second)
+		defer cancel()
+		if err := v.doppelganger.Check(ctx, currentEpoch, indices); err != nil {
+			log.WithError(err).Warn("Could not check validators for doppelgangers, keys will not sign until they are checked")
+		}
+	}()
+}
+
+// doppelgangerIndices returns the validator indices of the keys that are known to the beacon node.
+func (v *validator) doppelgangerIndices(
+	ctx context.Context,
+	pubKeys [][fieldparams.MLDSA87PubkeyLength]byte,
+) (map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.ValidatorIndex, error) {
+	req := &qrysmpb.MultipleValidatorStatusRequest{PublicKeys: make([][]byte, len(pubKeys))}
+	for i := range pubKeys {
+		req.PublicKeys[i] = pubKeys[i][:]
+	}
+	resp, err := v.validatorClient.MultipleValidatorStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return statusIndices(resp), nil
+}
+
+// statusIndices returns the validator indices of the keys with a known status.
+func statusIndices(resp *qrysmpb.MultipleValidatorStatusResponse) map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.ValidatorIndex {
+	indices := make(map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.ValidatorIndex, len(resp.PublicKeys))
+	for i, s := range resp.Statuses {
+		if i >= len(resp.PublicKeys) || i >= len(resp.Indices) || s.GetStatus() == qrysmpb.ValidatorStatus_UNKNOWN_STATUS {
+			continue
+		}
+		var pubKey [fieldparams.MLDSA87PubkeyLength]byte
+		copy(pubKey[:], resp.PublicKeys[i])
+		indices[pubKey] = resp.Indices[i]
+	}
+	return indices
+}
+
+// dutyIndices returns the validator indices of the keys with a known status.
+func dutyIndices(duties []*qrysmpb.DutiesResponse_Duty) map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.ValidatorIndex {
+	indices := make(map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.ValidatorIndex, len(duties))
+	for _, d := range duties {
+		if d == nil || d.Status == qrysmpb.ValidatorStatus_UNKNOWN_STATUS {
+			continue
+		}
+		var pubKey [fieldparams.MLDSA87PubkeyLength]byte
+		copy(pubKey[:], d.PublicKey)
+		indices[pubKey] = d.ValidatorIndex
+	}
+	return indices
+}
@@ -0,0 +1,297 @@
+// Package doppelganger keeps validator keys from signing until they were checked not to be in use by
+// another validator client, by watching their liveness on one or more beacon nodes for a number of epochs.
+package doppelganger
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+)
+
+// Status of the doppelganger check of a key.
+type Status int
+
+const (
+	// Checking keys may not sign yet.
+	Checking Status = iota
+	// Safe keys were not seen live by any beacon node during their check and may sign.
+	Safe
+	// Detected keys were seen live while this validator client was not signing with them. They never sign.
+	Detected
+)
+
+// String returns the name of the status, as shown in the keymanager API.
+func (s Status) String() string {
+	switch s {
+	case Checking:
+		return "checking"
+	case Safe:
+		return "safe"
+	case Detected:
+		return "detected"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// LivenessProvider returns whether validators were seen live by a beacon node during an epoch.
+type LivenessProvider interface {
+	Host() string
+	Liveness(ctx context.Context, epoch primitives.Epoch, indices []primitives.ValidatorIndex) (map[primitives.ValidatorIndex]bool, error)
+}
+
+// History tells whether this validator client signed with a key during an epoch. Liveness during such an
+// epoch, typically right before a restart, is caused by this validator client and not by a doppelganger.
+type History interface {
+	SignedInEpoch(ctx context.Context, pubKey [fieldparams.MLDSA87PubkeyLength]byte, epoch primitives.Epoch) (bool, error)
+}
+
+// Config of the doppelganger service.
+type Config struct {
+	// Epochs is the number of full epochs, starting with the one in which a key is added, during which
+	// the key must not be seen live before it may sign. With zero epochs, keys only wait for the check of
+	// the previous and the current epoch.
+	Epochs uint64
+	// Nodes are the beacon nodes the liveness of the keys is checked on.
+	Nodes   []LivenessProvider
+	History History
+}
+
+// KeyStatus is the doppelganger state of a single key.
+type KeyStatus struct {
+	PubKey [fieldparams.MLDSA87PubkeyLength]byte
+	Status Status
+	// StartEpoch is the epoch in which the key was added.
+	StartEpoch primitives.Epoch
+	// SafeEpoch is the first epoch in which the key may sign if it is not detected.
+	SafeEpoch primitives.Epoch
+	// DetectedEpoch is the epoch in which the key was seen live, if it was detected.
+	DetectedEpoch primitives.Epoch
+	// DetectedBy is the beacon node that saw the key live, if it was detected.
+	DetectedBy string
+}
+
+type keyState struct {
+	KeyStatus
+	// nextEpoch is the next complete epoch whose liveness must be checked.
+	nextEpoch primitives.Epoch
+}
+
+// Service tracks the doppelganger state of the keys of a validator client.
+type Service struct {
+	cfg       *Config
+	lock      sync.RWMutex
+	keys      map[[fieldparams.MLDSA87PubkeyLength]byte]*keyState
+	checkLock sync.Mutex
+}
+
+// NewService creates a doppelganger service.
+func NewService(cfg *Config) *Service {
+	return &Service{
+		cfg:  cfg,
+		keys: make(map[[fieldparams.MLDSA87PubkeyLength]byte]*keyState),
+	}
+}
+
+// Track starts checking the keys that are not tracked yet, as of the current epoch, and forgets the tracked
+// keys that are not part of pubKeys anymore. A key that is removed and added back is checked again.
+func (s *Service) Track(pubKeys [][fieldparams.MLDSA87PubkeyLength]byte, currentEpoch primitives.Epoch) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	current := make(map[[fieldparams.MLDSA87PubkeyLength]byte]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		current[pubKey] = true
+		if _, ok := s.keys[pubKey]; ok {
+			continue
+		}
+		// The previous epoch is checked too, as the key may have been in use elsewhere right before it was added.
+		nextEpoch := currentEpoch
+		if nextEpoch > 0 {
+			nextEpoch--
+		}
+		s.keys[pubKey] = &keyState{
+			KeyStatus: KeyStatus{
+				PubKey:     pubKey,
+				Status:     Checking,
+				StartEpoch: currentEpoch,
+				SafeEpoch:  currentEpoch + primitives.Epoch(s.cfg.Epochs),
+			},
+			nextEpoch: nextEpoch,
+		}
+		setStatusMetric(pubKey, Checking)
+		log.WithFields(logrus.Fields{
+			"pubkey":    fmtKey(pubKey),
+			"safeEpoch": currentEpoch + primitives.Epoch(s.cfg.Epochs),
+		}).Info("Started doppelganger check, the key will not sign until it completes")
+	}
+	for pubKey := range s.keys {
+		if !current[pubKey] {
+			delete(s.keys, pubKey)
+			deleteStatusMetric(pubKey)
+		}
+	}
+}
+
+// CanSign returns true if the key completed its doppelganger check without being seen live. Keys that are
+// not tracked may not sign.
+func (s *Service) CanSign(pubKey [fieldparams.MLDSA87PubkeyLength]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	k, ok := s.keys[pubKey]
+	return ok && k.Status == Safe
+}
+
+// Statuses returns the doppelganger state of the tracked keys, sorted by public key.
+func (s *Service) Statuses() []*KeyStatus {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	statuses := make([]*KeyStatus, 0, len(s.keys))
+	for _, k := range s.keys {
+		status := k.KeyStatus
+		statuses = append(statuses, &status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return string(statuses[i].PubKey[:]) < string(statuses[j].PubKey[:])
+	})
+	return statuses
+}
+
+// Check queries the beacon nodes for the liveness of the keys being checked, during the complete epochs
+// that were not checked yet and during the current epoch, and updates their status. Keys whose validator
+// index is unknown cannot have been live, so their epochs pass the check. An epoch passes the check once
+// at least one beacon node answered for it and none saw the key live. An error is returned if no beacon
+// node could be queried. Concurrent calls return immediately.
+func (s *Service) Check(ctx context.Context, currentEpoch primitives.Epoch, indices map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.ValidatorIndex) error {
+	if !s.checkLock.TryLock() {
+		return nil
+	}
+	defer s.checkLock.Unlock()
+
+	// Group the keys being checked by the epochs they need to be checked for.
+	byEpoch := make(map[primitives.Epoch][][fieldparams.MLDSA87PubkeyLength]byte)
+	s.lock.RLock()
+	for pubKey, k := range s.keys {
+		if k.Status != Checking {
+			continue
+		}
+		for epoch := k.nextEpoch; epoch <= currentEpoch; epoch++ {
+			byEpoch[epoch] = append(byEpoch[epoch], pubKey)
+		}
+	}
+	s.lock.RUnlock()
+
+	epochs := make([]primitives.Epoch, 0, len(byEpoch))
+	for epoch := range byEpoch {
+		epochs = append(epochs, epoch)
+	}
+	sort.Slice(epochs, func(i, j int) bool { return epochs[i] < epochs[j] })
+
+	var checkErr error
+	for _, epoch := range epochs {
+		live, err := s.liveKeys(ctx, epoch, byEpoch[epoch], indices)
+		if err != nil {
+			checkErr = err
+			break
+		}
+		s.lock.Lock()
+		for _, pubKey := range byEpoch[epoch] {
+			k, ok := s.keys[pubKey]
+			if !ok || k.Status != Checking {
+				continue
+			}
+			if host, ok := live[pubKey]; ok {
+				k.Status = Detected
+				k.DetectedEpoch = epoch
+				k.DetectedBy = host
+				setStatusMetric(pubKey, Detected)
+				log.WithFields(logrus.Fields{
+					"pubkey": fmtKey(pubKey),
+					"epoch":  epoch,
+					"node":   host,
+				}).Error("Doppelganger detected, the key is live elsewhere and will not sign. Make sure it only runs " +
+					"in a single validator client, then restart this one")
+				continue
+			}
+			// The current epoch is not complete yet, it is checked again once it is.
+			if epoch < currentEpoch && epoch >= k.nextEpoch {
+				k.nextEpoch = epoch + 1
+			}
+		}
+		s.lock.Unlock()
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for pubKey, k := range s.keys {
+		// The current epoch must have been checked too, which only matters for keys checked with zero epochs.
+		if k.Status == Checking && checkErr == nil && k.nextEpoch >= k.SafeEpoch {
+			k.Status = Safe
+			setStatusMetric(pubKey, Safe)
+			log.WithField("pubkey", fmtKey(pubKey)).Info("Doppelganger check completed, the key may sign")
+		}
+	}
+	return checkErr
+}
+
+// liveKeys returns the keys seen live during the epoch by any of the beacon nodes, with the node that saw
+// them, ignoring the epochs in which this validator client signed with them.
+func (s *Service) liveKeys(
+	ctx context.Context,
+	epoch primitives.Epoch,
+	pubKeys [][fieldparams.MLDSA87PubkeyLength]byte,
+	indices map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.ValidatorIndex,
+) (map[[fieldparams.MLDSA87PubkeyLength]byte]string, error) {
+	pubKeyByIndex := make(map[primitives.ValidatorIndex][fieldparams.MLDSA87PubkeyLength]byte, len(pubKeys))
+	request := make([]primitives.ValidatorIndex, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if index, ok := indices[pubKey]; ok {
+			pubKeyByIndex[index] = pubKey
+			request = append(request, index)
+		}
+	}
+	live := make(map[[fieldparams.MLDSA87PubkeyLength]byte]string)
+	if len(request) == 0 {
+		return live, nil
+	}
+
+	answered := 0
+	for _, node := range s.cfg.Nodes {
+		liveness, err := node.Liveness(ctx, epoch, request)
+		if err != nil {
+			livenessRequestFailures.WithLabelValues(node.Host()).Inc()
+			log.WithError(err).WithFields(logrus.Fields{
+				"node":  node.Host(),
+				"epoch": epoch,
+			}).Warn("Could not check the liveness of validators for doppelganger protection")
+			continue
+		}
+		answered++
+		for index, isLive := range liveness {
+			pubKey, ok := pubKeyByIndex[index]
+			if !ok || !isLive {
+				continue
+			}
+			if _, ok := live[pubKey]; ok {
+				continue
+			}
+			signed, err := s.cfg.History.SignedInEpoch(ctx, pubKey, epoch)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not read slashing protection history")
+			}
+			if !signed {
+				live[pubKey] = node.Host()
+			}
+		}
+	}
+	if answered == 0 {
+		return nil, fmt.Errorf("no beacon node could be queried for the liveness of validators during epoch %d", epoch)
+	}
+	return live, nil
+}
@@ -0,0 +1,132 @@
+package doppelganger
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+)
+
+type fakeNode struct {
+	host string
+	err  error
+	// live holds the validators seen live by epoch.
+	live     map[primitives.Epoch]map[primitives.ValidatorIndex]bool
+	requests []primitives.Epoch
+}
+
+func (n *fakeNode) Host() string {
+	return n.host
+}
+
+func (n *fakeNode) Liveness(_ context.Context, epoch primitives.Epoch, indices []primitives.ValidatorIndex) (map[primitives.ValidatorIndex]bool, error) {
+	n.requests = append(n.requests, epoch)
+	if n.err != nil {
+		return nil, n.err
+	}
+	liveness := make(map[primitives.ValidatorIndex]bool, len(indices))
+	for _, i := range indices {
+		liveness[i] = n.live[epoch][i]
+	}
+	return liveness, nil
+}
+
+type fakeHistory map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.Epoch
+
+func (h fakeHistory) SignedInEpoch(_ context.Context, pubKey [fieldparams.MLDSA87PubkeyLength]byte, epoch primitives.Epoch) (bool, error) {
+	e, ok := h[pubKey]
+	return ok && e == epoch, nil
+}
+
+var (
+	key1 = [fieldparams.MLDSA87PubkeyLength]byte{1}
+	key2 = [fieldparams.MLDSA87PubkeyLength]byte{2}
+	key3 = [fieldparams.MLDSA87PubkeyLength]byte{3}
+)
+
+func TestService_SafeAfterEpochs(t *testing.T) {
+	ctx := context.Background()
+	node := &fakeNode{host: "a"}
+	s := NewService(&Config{Epochs: 2, Nodes: []LivenessProvider{node}, History: fakeHistory{}})
+	indices := map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.ValidatorIndex{key1: 1}
+
+	s.Track([][fieldparams.MLDSA87PubkeyLength]byte{key1, key2}, 10)
+	for epoch := primitives.Epoch(10); epoch < 12; epoch++ {
+		require.NoError(t, s.Check(ctx, epoch, indices))
+		assert.Equal(t, false, s.CanSign(key1))
+		assert.Equal(t, false, s.CanSign(key2))
+	}
+	require.NoError(t, s.Check(ctx, 12, indices))
+	assert.Equal(t, true, s.CanSign(key1))
+	// Keys without a validator index cannot have been live.
+	assert.Equal(t, true, s.CanSign(key2))
+	// The previous epoch and the complete epochs are checked once, the current epochs on every check.
+	assert.DeepEqual(t, []primitives.Epoch{9, 10, 10, 11, 11, 12}, node.requests)
+
+	// Untracked keys may not sign.
+	assert.Equal(t, false, s.CanSign(key3))
+	s.Track([][fieldparams.MLDSA87PubkeyLength]byte{key1, key3}, 12)
+	assert.Equal(t, false, s.CanSign(key3))
+	require.Equal(t, 2, len(s.Statuses()))
+	assert.Equal(t, Safe, s.Statuses()[0].Status)
+	assert.Equal(t, Checking, s.Statuses()[1].Status)
+}
+
+func TestService_ZeroEpochs(t *testing.T) {
+	node := &fakeNode{host: "a"}
+	s := NewService(&Config{Epochs: 0, Nodes: []LivenessProvider{node}, History: fakeHistory{}})
+	s.Track([][fieldparams.MLDSA87PubkeyLength]byte{key1}, 10)
+	require.NoError(t, s.Check(context.Background(), 10, map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.ValidatorIndex{key1: 1}))
+	assert.Equal(t, true, s.CanSign(key1))
+	assert.DeepEqual(t, []primitives.Epoch{9, 10}, node.requests)
+}
+
+func TestService_DetectedOnAnyNode(t *testing.T) {
+	ctx := context.Background()
+	nodeA := &fakeNode{host: "a"}
+	nodeB := &fakeNode{host: "b", live: map[primitives.Epoch]map[primitives.ValidatorIndex]bool{
+		9:  {1: true, 2: true},
+		11: {3: true},
+	}}
+	// Key 2 attested in epoch 9 from this validator client, before it was restarted.
+	history := fakeHistory{key2: 9}
+	s := NewService(&Config{Epochs: 2, Nodes: []LivenessProvider{nodeA, nodeB}, History: history})
+	indices := map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.ValidatorIndex{key1: 1, key2: 2, key3: 3}
+
+	s.Track([][fieldparams.MLDSA87PubkeyLength]byte{key1, key2, key3}, 10)
+	for epoch := primitives.Epoch(10); epoch <= 12; epoch++ {
+		require.NoError(t, s.Check(ctx, epoch, indices))
+	}
+	statuses := s.Statuses()
+	require.Equal(t, 3, len(statuses))
+	assert.Equal(t, Detected, statuses[0].Status)
+	assert.Equal(t, primitives.Epoch(9), statuses[0].DetectedEpoch)
+	assert.Equal(t, "b", statuses[0].DetectedBy)
+	assert.Equal(t, Safe, statuses[1].Status)
+	assert.Equal(t, Detected, statuses[2].Status)
+	assert.Equal(t, primitives.Epoch(11), statuses[2].DetectedEpoch)
+	assert.Equal(t, false, s.CanSign(key1))
+	assert.Equal(t, true, s.CanSign(key2))
+	assert.Equal(t, false, s.CanSign(key3))
+}
+
+func TestService_NodeFailures(t *testing.T) {
+	ctx := context.Background()
+	down := &fakeNode{host: "down", err: errors.New("connection refused")}
+	s := NewService(&Config{Epochs: 0, Nodes: []LivenessProvider{down}, History: fakeHistory{}})
+	indices := map[[fieldparams.MLDSA87PubkeyLength]byte]primitives.ValidatorIndex{key1: 1}
+	s.Track([][fieldparams.MLDSA87PubkeyLength]byte{key1}, 10)
+
+	// No beacon node answered, so nothing was checked.
+	require.ErrorContains(t, "no beacon node could be queried", s.Check(ctx, 10, indices))
+	assert.Equal(t, false, s.CanSign(key1))
+
+	// A single beacon node answering is enough.
+	s.cfg.Nodes = append(s.cfg.Nodes, &fakeNode{host: "up"})
+	require.NoError(t, s.Check(ctx, 10, indices))
+	assert.Equal(t, true, s.CanSign(key1))
+}
@@ -0,0 +1,5 @@
+package doppelganger
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "doppelganger")
@@ -0,0 +1,47 @@
+package doppelganger
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+)
+
+var (
+	// statusGaugeVec tracks the doppelganger status of each key.
+	statusGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "doppelganger_status",
+			Help:      "doppelganger check status: 0 CHECKING, 1 SAFE, 2 DETECTED",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// livenessRequestFailures counts the liveness requests that failed, by beacon node.
+	livenessRequestFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "doppelganger_liveness_request_failures_total",
+			Help:      "The number of liveness requests for doppelganger protection that failed, by beacon node.",
+		},
+		[]string{
+			"node",
+		},
+	)
+)
+
+func setStatusMetric(pubKey [fieldparams.MLDSA87PubkeyLength]byte, status Status) {
+	statusGaugeVec.WithLabelValues(fmtKey(pubKey)).Set(float64(status))
+}
+
+func deleteStatusMetric(pubKey [fieldparams.MLDSA87PubkeyLength]byte) {
+	statusGaugeVec.DeleteLabelValues(fmtKey(pubKey))
+}
+
+func fmtKey(pubKey [fieldparams.MLDSA87PubkeyLength]byte) string {
+	return fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	field_params "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+	"github.com/theQRL/qrysm/validator/client/doppelganger"
+	dbTest "github.com/theQRL/qrysm/validator/db/testing"
+)
+
+type stubLivenessProvider struct {
+	live map[primitives.ValidatorIndex]bool
+}
+
+func (*stubLivenessProvider) Host() string {
+	return "stub"
+}
+
+func (p *stubLivenessProvider) Liveness(_ context.Context, _ primitives.Epoch, indices []primitives.ValidatorIndex) (map[primitives.ValidatorIndex]bool, error) {
+	liveness := make(map[primitives.ValidatorIndex]bool, len(indices))
+	for _, i := range indices {
+		liveness[i] = p.live[i]
+	}
+	return liveness, nil
+}
+
+func TestValidator_CheckDoppelGanger_Disabled(t *testing.T) {
+	v, _, _, finish := setup(t)
+	defer finish()
+	// No call is made to the beacon node.
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+}
+
+func TestValidator_CheckDoppelGanger(t *testing.T) {
+	tests := []struct {
+		name     string
+		live     bool
+		epochs   uint64
+		expected doppelganger.Status
+	}{
+		{name: "doppelganger detected", live: true, epochs: 2, expected: doppelganger.Detected},
+		{name: "no doppelganger, still checking", live: false, epochs: 2, expected: doppelganger.Checking},
+		{name: "no doppelganger, zero epochs", live: false, epochs: 0, expected: doppelganger.Safe},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, m, validatorKey, finish := setup(t)
+			defer finish()
+			pubKey := validatorKey.PublicKey().Marshal()
+			epochDuration := uint64(params.BeaconConfig().SlotsPerEpoch) * params.BeaconConfig().SecondsPerSlot
+			v.genesisTime = uint64(time.Now().Unix()) - 10*epochDuration
+			v.doppelganger = doppelganger.NewService(&doppelganger.Config{
+				Epochs:  tt.epochs,
+				Nodes:   []doppelganger.LivenessProvider{&stubLivenessProvider{live: map[primitives.ValidatorIndex]bool{1: tt.live}}},
+				History: &doppelgangerHistory{db: v.db},
+			})
+			m.validatorClient.EXPECT().MultipleValidatorStatus(
+				gomock.Any(),
+				&qrysmpb.MultipleValidatorStatusRequest{PublicKeys: [][]byte{pubKey}},
+			).Return(&qrysmpb.MultipleValidatorStatusResponse{
+				PublicKeys: [][]byte{pubKey},
+				Statuses:   []*qrysmpb.ValidatorStatusResponse{{Status: qrysmpb.ValidatorStatus_ACTIVE}},
+				Indices:    []primitives.ValidatorIndex{1},
+			}, nil)
+
+			require.NoError(t, v.CheckDoppelGanger(context.Background()))
+			statuses := v.doppelganger.Statuses()
+			require.Equal(t, 1, len(statuses))
+			assert.Equal(t, tt.expected, statuses[0].Status)
+
+			// Keys that may not sign are given no roles.
+			v.duties = &qrysmpb.DutiesResponse{
+				CurrentEpochDuties: []*qrysmpb.DutiesResponse_Duty{
+					{ProposerSlots: []primitives.Slot{1}, PublicKey: pubKey},
+				},
+			}
+			roles, err := v.RolesAt(context.Background(), 1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected == doppelganger.Safe, len(roles[bytesutil.ToBytes2592(pubKey)]) > 0)
+		})
+	}
+}
+
+func TestDoppelgangerHistory_SignedInEpoch(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [field_params.MLDSA87PubkeyLength]byte{1}
+	db := dbTest.SetupDB(t, [][field_params.MLDSA87PubkeyLength]byte{pubKey})
+	att := createAttestation(4, 5)
+	root, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveAttestationForPubKey(ctx, pubKey, root, att))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, params.BeaconConfig().SlotsPerEpoch.Mul(7), []byte{1}))
+
+	h := &doppelgangerHistory{db: db}
+	for epoch, expected := range map[primitives.Epoch]bool{4: false, 5: true, 6: false, 7: true} {
+		signed, err := h.SignedInEpoch(ctx, pubKey, epoch)
+		require.NoError(t, err)
+		assert.Equal(t, expected, signed, "epoch %d", epoch)
+	}
+}
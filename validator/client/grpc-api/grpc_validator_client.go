@@ -19,10 +19,6 @@ func (c *grpcValidatorClient) GetDuties(ctx context.Context, in *qrysmpb.DutiesR
 	return c.beaconNodeValidatorClient.GetDuties(ctx, in)
 }
 
-func (c *grpcValidatorClient) CheckDoppelGanger(ctx context.Context, in *qrysmpb.DoppelGangerRequest) (*qrysmpb.DoppelGangerResponse, error) {
-	return c.beaconNodeValidatorClient.CheckDoppelGanger(ctx, in)
-}
-
 func (c *grpcValidatorClient) DomainData(ctx context.Context, in *qrysmpb.DomainRequest) (*qrysmpb.DomainResponse, error) {
 	return c.beaconNodeValidatorClient.DomainData(ctx, in)
 }
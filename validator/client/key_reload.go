@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	field_params "github.com/theQRL/qrysm/config/fieldparams"
 	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/time/slots"
 	"go.opencensus.io/trace"
 )
 
@@ -25,6 +26,8 @@ func (v *validator) HandleKeyReload(ctx context.Context, currentKeys [][field_pa
 	if err != nil {
 		return false, err
 	}
+	// Keys added at runtime do not sign before they were checked for doppelgangers either.
+	v.checkDoppelGangers(currentKeys, slots.ToEpoch(slots.CurrentSlot(v.genesisTime)), statusIndices(resp))
 	statuses := make([]*validatorStatus, len(resp.Statuses))
 	for i, s := range resp.Statuses {
 		statuses[i] = &validatorStatus{
@@ -14,6 +14,7 @@ import (
 	grpcutil "github.com/theQRL/qrysm/api/grpc"
 	"github.com/theQRL/qrysm/async/event"
 	lruwrpr "github.com/theQRL/qrysm/cache/lru"
+	"github.com/theQRL/qrysm/config/features"
 	field_params "github.com/theQRL/qrysm/config/fieldparams"
 	"github.com/theQRL/qrysm/config/params"
 	validatorserviceconfig "github.com/theQRL/qrysm/config/validator/service"
@@ -23,6 +24,7 @@ import (
 	"github.com/theQRL/qrysm/validator/accounts/wallet"
 	beaconApi "github.com/theQRL/qrysm/validator/client/beacon-api"
 	beaconChainClientFactory "github.com/theQRL/qrysm/validator/client/beacon-chain-client-factory"
+	"github.com/theQRL/qrysm/validator/client/doppelganger"
 	"github.com/theQRL/qrysm/validator/client/iface"
 	nodeClientFactory "github.com/theQRL/qrysm/validator/client/node-client-factory"
 	validatorClientFactory "github.com/theQRL/qrysm/validator/client/validator-client-factory"
@@ -73,7 +75,10 @@ type ValidatorService struct {
 	grpcHeaders           []string
 	graffiti              []byte
 	// Web3SignerConfig      *remoteweb3signer.SetupConfig
-	proposerSettings *validatorserviceconfig.ProposerSettings
+	proposerSettings   *validatorserviceconfig.ProposerSettings
+	doppelgangerEpochs uint64
+	doppelgangerNodes  string
+	doppelganger       *doppelganger.Service
 }
 
 // Config for the validator service.
@@ -98,6 +103,11 @@ type Config struct {
 	ProposerSettings  *validatorserviceconfig.ProposerSettings
 	BeaconApiEndpoint string
 	BeaconApiTimeout  time.Duration
+	// DoppelgangerEpochs is the number of epochs keys are checked for doppelgangers before they may sign.
+	DoppelgangerEpochs uint64
+	// DoppelgangerBeaconApiEndpoints are the comma-separated beacon nodes the doppelganger check queries.
+	// BeaconApiEndpoint is used when empty.
+	DoppelgangerBeaconApiEndpoints string
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -124,7 +134,12 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		interopKeysConfig:     cfg.InteropKeysConfig,
 		graffitiStruct:        cfg.GraffitiStruct,
 		// Web3SignerConfig:  cfg.Web3SignerConfig,
-		proposerSettings: cfg.ProposerSettings,
+		proposerSettings:   cfg.ProposerSettings,
+		doppelgangerEpochs: cfg.DoppelgangerEpochs,
+		doppelgangerNodes:  cfg.DoppelgangerBeaconApiEndpoints,
+	}
+	if s.doppelgangerNodes == "" {
+		s.doppelgangerNodes = cfg.BeaconApiEndpoint
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -195,6 +210,19 @@ func (v *ValidatorService) Start() {
 		dutyDependentRootTimeout = time.Second
 	}
 
+	if features.Get().EnableDoppelGanger {
+		providers := beaconApi.NewLivenessProviders(v.doppelgangerNodes, v.conn.GetBeaconApiTimeout())
+		nodes := make([]doppelganger.LivenessProvider, len(providers))
+		for i, p := range providers {
+			nodes[i] = p
+		}
+		v.doppelganger = doppelganger.NewService(&doppelganger.Config{
+			Epochs:  v.doppelgangerEpochs,
+			Nodes:   nodes,
+			History: &doppelgangerHistory{db: v.db},
+		})
+	}
+
 	valStruct := &validator{
 		db:                             v.db,
 		validatorClient:                validatorClient,
@@ -224,6 +252,7 @@ func (v *ValidatorService) Start() {
 		// Web3SignerConfig:               v.Web3SignerConfig,
 		proposerSettings:         v.proposerSettings,
 		walletInitializedChannel: make(chan *wallet.Wallet, 1),
+		doppelganger:             v.doppelganger,
 	}
 	if tracker, ok := v.conn.GetGrpcClientConn().(grpcHealthTracker); ok {
 		valStruct.grpcHealthTracker = tracker
@@ -315,6 +344,11 @@ func (v *ValidatorService) SetProposerSettings(ctx context.Context, settings *va
 	return v.validator.SetProposerSettings(ctx, settings)
 }
 
+// Doppelganger returns the doppelganger service of the validator, or nil if doppelganger protection is disabled.
+func (v *ValidatorService) Doppelganger() *doppelganger.Service {
+	return v.doppelganger
+}
+
 // ConstructDialOptions constructs a list of grpc dial options
 func ConstructDialOptions(
 	maxCallRecvMsgSize int,
@@ -23,7 +23,6 @@ import (
 	"github.com/theQRL/go-qrl/common/hexutil"
 	"github.com/theQRL/qrysm/async/event"
 	"github.com/theQRL/qrysm/beacon-chain/core/altair"
-	"github.com/theQRL/qrysm/config/features"
 	fieldparams "github.com/theQRL/qrysm/config/fieldparams"
 	"github.com/theQRL/qrysm/config/params"
 	validatorserviceconfig "github.com/theQRL/qrysm/config/validator/service"
@@ -37,9 +36,9 @@ import (
 	accountsiface "github.com/theQRL/qrysm/validator/accounts/iface"
 	"github.com/theQRL/qrysm/validator/accounts/wallet"
 	beacon_api "github.com/theQRL/qrysm/validator/client/beacon-api"
+	"github.com/theQRL/qrysm/validator/client/doppelganger"
 	"github.com/theQRL/qrysm/validator/client/iface"
 	vdb "github.com/theQRL/qrysm/validator/db"
-	"github.com/theQRL/qrysm/validator/db/kv"
 	"github.com/theQRL/qrysm/validator/graffiti"
 	"github.com/theQRL/qrysm/validator/keymanager"
 	"github.com/theQRL/qrysm/validator/keymanager/local"
@@ -111,6 +110,7 @@ type validator struct {
 	graffiti                           []byte
 	voteStats                          voteStats
 	syncCommitteeStats                 syncCommitteeStats
+	doppelganger                       *doppelganger.Service
 	// Web3SignerConfig                   *remoteweb3signer.SetupConfig
 	proposerSettings         *validatorserviceconfig.ProposerSettings
 	walletInitializedChannel chan *wallet.Wallet
@@ -457,100 +457,6 @@ func (v *validator) SlotDeadline(slot primitives.Slot) time.Time {
 	return time.Unix(int64(v.genesisTime), 0 /*ns*/).Add(secs * time.Second)
 }
 
-// CheckDoppelGanger checks if the current actively provided keys have
-// any duplicates active in the network.
-func (v *validator) CheckDoppelGanger(ctx context.Context) error {
-	if !features.Get().EnableDoppelGanger {
-		return nil
-	}
-	pubkeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
-	if err != nil {
-		return err
-	}
-	log.WithField("keys", len(pubkeys)).Info("Running doppelganger check")
-	// Exit early if no validating pub keys are found.
-	if len(pubkeys) == 0 {
-		return nil
-	}
-	req := &qrysmpb.DoppelGangerRequest{ValidatorRequests: []*qrysmpb.DoppelGangerRequest_ValidatorRequest{}}
-	for _, pkey := range pubkeys {
-		copiedKey := pkey
-		attRec, err := v.db.AttestationHistoryForPubKey(ctx, copiedKey)
-		if err != nil {
-			return err
-		}
-		if len(attRec) == 0 {
-			// If no history exists we simply send in a zero
-			// value for the request epoch and root.
-			req.ValidatorRequests = append(req.ValidatorRequests,
-				&qrysmpb.DoppelGangerRequest_ValidatorRequest{
-					PublicKey:  copiedKey[:],
-					Epoch:      0,
-					SignedRoot: make([]byte, fieldparams.RootLength),
-				})
-			continue
-		}
-		r := retrieveLatestRecord(attRec)
-		if copiedKey != r.PubKey {
-			return errors.New("attestation record mismatched public key")
-		}
-		req.ValidatorRequests = append(req.ValidatorRequests,
-			&qrysmpb.DoppelGangerRequest_ValidatorRequest{
-				PublicKey:  r.PubKey[:],
-				Epoch:      r.Target,
-				SignedRoot: r.SigningRoot[:],
-			})
-	}
-	resp, err := v.validatorClient.CheckDoppelGanger(ctx, req)
-	if err != nil {
-		return err
-	}
-	// If nothing is returned by the beacon node, we return an
-	// error as it is unsafe for us to proceed.
-	if resp == nil || resp.Responses == nil || len(resp.Responses) == 0 {
-		return errors.New("beacon node returned 0 responses for doppelganger check")
-	}
-	return buildDuplicateError(resp.Responses)
-}
-
-func buildDuplicateError(response []*qrysmpb.DoppelGangerResponse_ValidatorResponse) error {
-	duplicates := make([][]byte, 0)
-	for _, valRes := range response {
-		if valRes.DuplicateExists {
-			var copiedKey [fieldparams.MLDSA87PubkeyLength]byte
-			copy(copiedKey[:], valRes.PublicKey)
-			duplicates = append(duplicates, copiedKey[:])
-		}
-	}
-	if len(duplicates) == 0 {
-		return nil
-	}
-	return errors.Errorf("Duplicate instances exists in the network for validator keys: %#x", duplicates)
-}
-
-// Ensures that the latest attestation history is retrieved.
-func retrieveLatestRecord(recs []*kv.AttestationRecord) *kv.AttestationRecord {
-	if len(recs) == 0 {
-		return nil
-	}
-	lastSource := recs[len(recs)-1].Source
-	chosenRec := recs[len(recs)-1]
-	for i := len(recs) - 1; i >= 0; i-- {
-		// Exit if we are now on a different source
-		// as it is assumed that all source records are
-		// byte sorted.
-		if recs[i].Source != lastSource {
-			break
-		}
-		// If we have a smaller target, we do
-		// change our chosen record.
-		if chosenRec.Target < recs[i].Target {
-			chosenRec = recs[i]
-		}
-	}
-	return chosenRec
-}
-
 // UpdateDuties checks the slot number to determine if the validator's
 // list of upcoming assignments needs to be updated. For example, at the
 // beginning of a new epoch.
@@ -632,6 +538,7 @@ func (v *validator) UpdateDuties(ctx context.Context, slot primitives.Slot) erro
 	v.currentDutyDependentRoot = currentDutyDependentRoot
 	v.logDuties(slot, v.duties.CurrentEpochDuties, v.duties.NextEpochDuties)
 	v.dutiesLock.Unlock()
+	v.checkDoppelGangers(validatingKeys, req.Epoch, dutyIndices(resp.CurrentEpochDuties))
 
 	allExitedCounter := 0
 	for i := range resp.CurrentEpochDuties {
@@ -782,6 +689,9 @@ func (v *validator) RolesAt(ctx context.Context, slot primitives.Slot) (map[[fie
 		if duty == nil {
 			continue
 		}
+		if v.doppelganger != nil && !v.doppelganger.CanSign(bytesutil.ToBytes2592(duty.PublicKey)) {
+			continue
+		}
 		if len(duty.ProposerSlots) > 0 {
 			for _, proposerSlot := range duty.ProposerSlots {
 				if proposerSlot != 0 && proposerSlot == slot {
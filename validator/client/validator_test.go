@@ -3,7 +3,6 @@ package client
 import (
 	"context"
 	"errors"
-	"fmt"
 	"io"
 	"math"
 	"strings"
@@ -17,7 +16,6 @@ import (
 	"github.com/theQRL/go-qrl/common"
 	"github.com/theQRL/go-qrl/common/hexutil"
 	"github.com/theQRL/qrysm/async/event"
-	"github.com/theQRL/qrysm/config/features"
 	field_params "github.com/theQRL/qrysm/config/fieldparams"
 	"github.com/theQRL/qrysm/config/params"
 	validatorserviceconfig "github.com/theQRL/qrysm/config/validator/service"
@@ -1115,247 +1113,6 @@ func TestService_ReceiveBlocks_SetHighestZond(t *testing.T) {
 	require.Equal(t, slot, v.highestValidSlot)
 }
 
-type doppelGangerRequestMatcher struct {
-	req *qrysmpb.DoppelGangerRequest
-}
-
-var _ gomock.Matcher = (*doppelGangerRequestMatcher)(nil)
-
-func (m *doppelGangerRequestMatcher) Matches(x any) bool {
-	r, ok := x.(*qrysmpb.DoppelGangerRequest)
-	if !ok {
-		panic("Invalid match type")
-	}
-	return gomock.InAnyOrder(m.req.ValidatorRequests).Matches(r.ValidatorRequests)
-}
-
-func (m *doppelGangerRequestMatcher) String() string {
-	return fmt.Sprintf("%#v", m.req.ValidatorRequests)
-}
-
-func TestValidator_CheckDoppelGanger(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-	flgs := features.Get()
-	flgs.EnableDoppelGanger = true
-	reset := features.InitWithReset(flgs)
-	defer reset()
-	tests := []struct {
-		name            string
-		validatorSetter func(t *testing.T) *validator
-		err             string
-	}{
-		{
-			name: "no doppelganger",
-			validatorSetter: func(t *testing.T) *validator {
-				client := validatormock.NewMockValidatorClient(ctrl)
-				km := genMockKeymanager(t, 10)
-				keys, err := km.FetchValidatingPublicKeys(context.Background())
-				assert.NoError(t, err)
-				db := dbTest.SetupDB(t, keys)
-				req := &qrysmpb.DoppelGangerRequest{ValidatorRequests: []*qrysmpb.DoppelGangerRequest_ValidatorRequest{}}
-				resp := &qrysmpb.DoppelGangerRequest{ValidatorRequests: []*qrysmpb.DoppelGangerRequest_ValidatorRequest{}}
-				for _, k := range keys {
-					pkey := k
-					att := createAttestation(10, 12)
-					rt, err := att.Data.HashTreeRoot()
-					assert.NoError(t, err)
-					assert.NoError(t, db.SaveAttestationForPubKey(context.Background(), pkey, rt, att))
-					resp.ValidatorRequests = append(resp.ValidatorRequests, &qrysmpb.DoppelGangerRequest_ValidatorRequest{PublicKey: pkey[:], Epoch: att.Data.Target.Epoch, SignedRoot: rt[:]})
-					req.ValidatorRequests = append(req.ValidatorRequests, &qrysmpb.DoppelGangerRequest_ValidatorRequest{PublicKey: pkey[:], Epoch: att.Data.Target.Epoch, SignedRoot: rt[:]})
-				}
-				v := &validator{
-					validatorClient: client,
-					keyManager:      km,
-					db:              db,
-				}
-				client.EXPECT().CheckDoppelGanger(
-					gomock.Any(),                     // ctx
-					&doppelGangerRequestMatcher{req}, // request
-				).Return(nil, nil /*err*/)
-
-				return v
-			},
-		},
-		{
-			name: "multiple doppelganger exists",
-			validatorSetter: func(t *testing.T) *validator {
-				client := validatormock.NewMockValidatorClient(ctrl)
-				km := genMockKeymanager(t, 10)
-				keys, err := km.FetchValidatingPublicKeys(context.Background())
-				assert.NoError(t, err)
-				db := dbTest.SetupDB(t, keys)
-				req := &qrysmpb.DoppelGangerRequest{ValidatorRequests: []*qrysmpb.DoppelGangerRequest_ValidatorRequest{}}
-				resp := &qrysmpb.DoppelGangerResponse{Responses: []*qrysmpb.DoppelGangerResponse_ValidatorResponse{}}
-				for i, k := range keys {
-					pkey := k
-					att := createAttestation(10, 12)
-					rt, err := att.Data.HashTreeRoot()
-					assert.NoError(t, err)
-					assert.NoError(t, db.SaveAttestationForPubKey(context.Background(), pkey, rt, att))
-					if i%3 == 0 {
-						resp.Responses = append(resp.Responses, &qrysmpb.DoppelGangerResponse_ValidatorResponse{PublicKey: pkey[:], DuplicateExists: true})
-					}
-					req.ValidatorRequests = append(req.ValidatorRequests, &qrysmpb.DoppelGangerRequest_ValidatorRequest{PublicKey: pkey[:], Epoch: att.Data.Target.Epoch, SignedRoot: rt[:]})
-				}
-				v := &validator{
-					validatorClient: client,
-					keyManager:      km,
-					db:              db,
-				}
-				client.EXPECT().CheckDoppelGanger(
-					gomock.Any(),                     // ctx
-					&doppelGangerRequestMatcher{req}, // request
-				).Return(resp, nil /*err*/)
-				return v
-			},
-			err: "Duplicate instances exists in the network for validator keys",
-		},
-		{
-			name: "single doppelganger exists",
-			validatorSetter: func(t *testing.T) *validator {
-				client := validatormock.NewMockValidatorClient(ctrl)
-				km := genMockKeymanager(t, 10)
-				keys, err := km.FetchValidatingPublicKeys(context.Background())
-				assert.NoError(t, err)
-				db := dbTest.SetupDB(t, keys)
-				req := &qrysmpb.DoppelGangerRequest{ValidatorRequests: []*qrysmpb.DoppelGangerRequest_ValidatorRequest{}}
-				resp := &qrysmpb.DoppelGangerResponse{Responses: []*qrysmpb.DoppelGangerResponse_ValidatorResponse{}}
-				for i, k := range keys {
-					pkey := k
-					att := createAttestation(10, 12)
-					rt, err := att.Data.HashTreeRoot()
-					assert.NoError(t, err)
-					assert.NoError(t, db.SaveAttestationForPubKey(context.Background(), pkey, rt, att))
-					if i%9 == 0 {
-						resp.Responses = append(resp.Responses, &qrysmpb.DoppelGangerResponse_ValidatorResponse{PublicKey: pkey[:], DuplicateExists: true})
-					}
-					req.ValidatorRequests = append(req.ValidatorRequests, &qrysmpb.DoppelGangerRequest_ValidatorRequest{PublicKey: pkey[:], Epoch: att.Data.Target.Epoch, SignedRoot: rt[:]})
-				}
-				v := &validator{
-					validatorClient: client,
-					keyManager:      km,
-					db:              db,
-				}
-				client.EXPECT().CheckDoppelGanger(
-					gomock.Any(),                     // ctx
-					&doppelGangerRequestMatcher{req}, // request
-				).Return(resp, nil /*err*/)
-				return v
-			},
-			err: "Duplicate instances exists in the network for validator keys",
-		},
-		{
-			name: "multiple attestations saved",
-			validatorSetter: func(t *testing.T) *validator {
-				client := validatormock.NewMockValidatorClient(ctrl)
-				km := genMockKeymanager(t, 10)
-				keys, err := km.FetchValidatingPublicKeys(context.Background())
-				assert.NoError(t, err)
-				db := dbTest.SetupDB(t, keys)
-				req := &qrysmpb.DoppelGangerRequest{ValidatorRequests: []*qrysmpb.DoppelGangerRequest_ValidatorRequest{}}
-				resp := &qrysmpb.DoppelGangerResponse{Responses: []*qrysmpb.DoppelGangerResponse_ValidatorResponse{}}
-				attLimit := 5
-				for i, k := range keys {
-					pkey := k
-					for j := range attLimit {
-						att := createAttestation(10+primitives.Epoch(j), 12+primitives.Epoch(j))
-						rt, err := att.Data.HashTreeRoot()
-						assert.NoError(t, err)
-						assert.NoError(t, db.SaveAttestationForPubKey(context.Background(), pkey, rt, att))
-						if j == attLimit-1 {
-							req.ValidatorRequests = append(req.ValidatorRequests, &qrysmpb.DoppelGangerRequest_ValidatorRequest{PublicKey: pkey[:], Epoch: att.Data.Target.Epoch, SignedRoot: rt[:]})
-						}
-					}
-					if i%3 == 0 {
-						resp.Responses = append(resp.Responses, &qrysmpb.DoppelGangerResponse_ValidatorResponse{PublicKey: pkey[:], DuplicateExists: true})
-					}
-				}
-				v := &validator{
-					validatorClient: client,
-					keyManager:      km,
-					db:              db,
-				}
-				client.EXPECT().CheckDoppelGanger(
-					gomock.Any(),                     // ctx
-					&doppelGangerRequestMatcher{req}, // request
-				).Return(resp, nil /*err*/)
-				return v
-			},
-			err: "Duplicate instances exists in the network for validator keys",
-		},
-		{
-			name: "no history exists",
-			validatorSetter: func(t *testing.T) *validator {
-				client := validatormock.NewMockValidatorClient(ctrl)
-				// Use only 1 key for deterministic order.
-				km := genMockKeymanager(t, 1)
-				keys, err := km.FetchValidatingPublicKeys(context.Background())
-				assert.NoError(t, err)
-				db := dbTest.SetupDB(t, keys)
-				resp := &qrysmpb.DoppelGangerResponse{Responses: []*qrysmpb.DoppelGangerResponse_ValidatorResponse{}}
-				req := &qrysmpb.DoppelGangerRequest{ValidatorRequests: []*qrysmpb.DoppelGangerRequest_ValidatorRequest{}}
-				for _, k := range keys {
-					resp.Responses = append(resp.Responses, &qrysmpb.DoppelGangerResponse_ValidatorResponse{PublicKey: k[:], DuplicateExists: false})
-					req.ValidatorRequests = append(req.ValidatorRequests, &qrysmpb.DoppelGangerRequest_ValidatorRequest{PublicKey: k[:], SignedRoot: make([]byte, 32), Epoch: 0})
-				}
-				v := &validator{
-					validatorClient: client,
-					keyManager:      km,
-					db:              db,
-				}
-				client.EXPECT().CheckDoppelGanger(
-					gomock.Any(), // ctx
-					req,          // request
-				).Return(resp, nil /*err*/)
-				return v
-			},
-			err: "",
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			v := tt.validatorSetter(t)
-			if err := v.CheckDoppelGanger(context.Background()); tt.err != "" {
-				assert.ErrorContains(t, tt.err, err)
-			}
-		})
-	}
-}
-
-func TestValidatorAttestationsAreOrdered(t *testing.T) {
-	km := genMockKeymanager(t, 10)
-	keys, err := km.FetchValidatingPublicKeys(context.Background())
-	assert.NoError(t, err)
-	db := dbTest.SetupDB(t, keys)
-
-	k := keys[0]
-	att := createAttestation(10, 14)
-	rt, err := att.Data.HashTreeRoot()
-	assert.NoError(t, err)
-	assert.NoError(t, db.SaveAttestationForPubKey(context.Background(), k, rt, att))
-
-	att = createAttestation(6, 8)
-	rt, err = att.Data.HashTreeRoot()
-	assert.NoError(t, err)
-	assert.NoError(t, db.SaveAttestationForPubKey(context.Background(), k, rt, att))
-
-	att = createAttestation(10, 12)
-	rt, err = att.Data.HashTreeRoot()
-	assert.NoError(t, err)
-	assert.NoError(t, db.SaveAttestationForPubKey(context.Background(), k, rt, att))
-
-	att = createAttestation(2, 3)
-	rt, err = att.Data.HashTreeRoot()
-	assert.NoError(t, err)
-	assert.NoError(t, db.SaveAttestationForPubKey(context.Background(), k, rt, att))
-
-	histories, err := db.AttestationHistoryForPubKey(context.Background(), k)
-	assert.NoError(t, err)
-	r := retrieveLatestRecord(histories)
-	assert.Equal(t, r.Target, primitives.Epoch(14))
-}
-
 func createAttestation(source, target primitives.Epoch) *qrysmpb.IndexedAttestation {
 	return &qrysmpb.IndexedAttestation{
 		Data: &qrysmpb.AttestationData{
@@ -271,10 +271,11 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 		return err
 	}
 	if cliCtx.Bool(flags.EnableRPCFlag.Name) {
-		if err := c.registerRPCService(cliCtx); err != nil {
+		router := mux.NewRouter()
+		if err := c.registerRPCService(cliCtx, router); err != nil {
 			return err
 		}
-		if err := c.registerRPCGatewayService(cliCtx); err != nil {
+		if err := c.registerRPCGatewayService(cliCtx, router); err != nil {
 			return err
 		}
 	}
@@ -358,9 +359,11 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
 		// Web3SignerConfig:           wsc,
-		ProposerSettings:  bpc,
-		BeaconApiTimeout:  time.Second * 30,
-		BeaconApiEndpoint: c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		ProposerSettings:               bpc,
+		BeaconApiTimeout:               time.Second * 30,
+		BeaconApiEndpoint:              c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		DoppelgangerEpochs:             c.cliCtx.Uint64(flags.DoppelgangerEpochsFlag.Name),
+		DoppelgangerBeaconApiEndpoints: c.cliCtx.String(flags.DoppelgangerBeaconNodesFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -653,7 +656,7 @@ func reviewGasLimit(gasLimit validator.Uint64) validator.Uint64 {
 	return gasLimit
 }
 
-func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context) error {
+func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context, router *mux.Router) error {
 	var vs *client.ValidatorService
 	if err := c.services.FetchService(&vs); err != nil {
 		return err
@@ -695,11 +698,13 @@ func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context) error {
 		ClientWithCert:           clientCert,
 		BeaconApiTimeout:         time.Second * 30,
 		BeaconApiEndpoint:        cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		Router:                   router,
+		MergeSlashingProtection:  cliCtx.Bool(flags.MergeSlashingProtectionImportsFlag.Name),
 	})
 	return c.services.RegisterService(server)
 }
 
-func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
+func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context, router *mux.Router) error {
 	gatewayHost := cliCtx.String(flags.GRPCGatewayHost.Name)
 	if gatewayHost != flags.DefaultGatewayHost {
 		log.WithField("web-host", gatewayHost).Warn(
@@ -762,7 +767,7 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		Mux:           gwmux,
 	}
 	opts := []gateway.Option{
-		gateway.WithRouter(mux.NewRouter()),
+		gateway.WithRouter(router),
 		gateway.WithRemoteAddr(rpcAddr),
 		gateway.WithGatewayAddr(gatewayAddress),
 		gateway.WithMaxCallRecvMsgSize(maxCallSize),
@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	http2 "github.com/theQRL/qrysm/network/http"
+	"github.com/theQRL/qrysm/validator/client/doppelganger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// DoppelgangerStatus is the doppelganger protection state of a key.
+type DoppelgangerStatus struct {
+	Pubkey        string `json:"pubkey"`
+	Status        string `json:"status"`
+	StartEpoch    string `json:"start_epoch"`
+	SafeEpoch     string `json:"safe_epoch"`
+	DetectedEpoch string `json:"detected_epoch,omitempty"`
+	DetectedBy    string `json:"detected_by,omitempty"`
+}
+
+// ListDoppelgangerStatusesResponse lists the doppelganger protection state of the keys of the validator client.
+type ListDoppelgangerStatusesResponse struct {
+	Data []*DoppelgangerStatus `json:"data"`
+}
+
+// ListDoppelgangerStatuses returns whether each key of the validator client is still being checked for
+// doppelgangers, may sign, or was seen live elsewhere and does not sign.
+func (s *Server) ListDoppelgangerStatuses(w http.ResponseWriter, r *http.Request) {
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+	if err := s.authorize(ctx); err != nil {
+		code := http.StatusUnauthorized
+		if status.Code(err) != codes.Unauthenticated {
+			code = http.StatusInternalServerError
+		}
+		http2.HandleError(w, status.Convert(err).Message(), code)
+		return
+	}
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	dg := s.validatorService.Doppelganger()
+	if dg == nil {
+		http2.HandleError(w, "Doppelganger protection is not enabled", http.StatusNotFound)
+		return
+	}
+	statuses := dg.Statuses()
+	resp := &ListDoppelgangerStatusesResponse{Data: make([]*DoppelgangerStatus, len(statuses))}
+	for i, st := range statuses {
+		resp.Data[i] = &DoppelgangerStatus{
+			Pubkey:     fmt.Sprintf("%#x", st.PubKey),
+			Status:     st.Status.String(),
+			StartEpoch: strconv.FormatUint(uint64(st.StartEpoch), 10),
+			SafeEpoch:  strconv.FormatUint(uint64(st.SafeEpoch), 10),
+		}
+		if st.Status == doppelganger.Detected {
+			resp.Data[i].DetectedEpoch = strconv.FormatUint(uint64(st.DetectedEpoch), 10)
+			resp.Data[i].DetectedBy = st.DetectedBy
+		}
+	}
+	http2.WriteJson(w, resp)
+}
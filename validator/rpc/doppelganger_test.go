@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/validator/client"
+)
+
+func TestServer_ListDoppelgangerStatuses(t *testing.T) {
+	s := &Server{
+		authToken:        testAuthToken(),
+		validatorService: &client.ValidatorService{},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/qrysm/validator/keystores/doppelganger", nil)
+	w := httptest.NewRecorder()
+	s.ListDoppelgangerStatuses(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req.Header.Set("Authorization", "Bearer "+badAuthToken())
+	w = httptest.NewRecorder()
+	s.ListDoppelgangerStatuses(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req.Header.Set("Authorization", "Bearer "+s.authToken)
+	w = httptest.NewRecorder()
+	s.ListDoppelgangerStatuses(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.StringContains(t, "Doppelganger protection is not enabled", w.Body.String())
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"time"
 
+	"github.com/gorilla/mux"
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
@@ -51,6 +53,8 @@ type Config struct {
 	BeaconApiEndpoint        string
 	BeaconApiTimeout         time.Duration
 	Wallet                   *wallet.Wallet
+	Router                   *mux.Router
+	MergeSlashingProtection  bool
 }
 
 // Server defining a gRPC server for the remote signer API.
@@ -90,12 +94,13 @@ type Server struct {
 	validatorGatewayPort      int
 	beaconApiEndpoint         string
 	beaconApiTimeout          time.Duration
+	mergeSlashingProtection   bool
 }
 
 // NewServer instantiates a new gRPC server.
 func NewServer(ctx context.Context, cfg *Config) *Server {
 	ctx, cancel := context.WithCancel(ctx)
-	return &Server{
+	server := &Server{
 		ctx:                      ctx,
 		cancel:                   cancel,
 		logsStreamer:             logs.NewStreamServer(),
@@ -124,7 +129,12 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		validatorGatewayPort:     cfg.ValidatorGatewayPort,
 		beaconApiEndpoint:        cfg.BeaconApiEndpoint,
 		beaconApiTimeout:         cfg.BeaconApiTimeout,
+		mergeSlashingProtection:  cfg.MergeSlashingProtection,
 	}
+	if cfg.Router != nil {
+		cfg.Router.HandleFunc("/qrysm/validator/keystores/doppelganger", server.ListDoppelgangerStatuses).Methods(http.MethodGet)
+	}
+	return server
 }
 
 // Start the gRPC server.
@@ -44,7 +44,8 @@ func (s *Server) ExportSlashingProtection(ctx context.Context, _ *emptypb.Empty)
 }
 
 // ImportSlashingProtection reads an input slashing protection EIP-3076
-// standard JSON string and inserts the data into validator DB.
+// standard JSON string and inserts the data into validator DB, or merges it with
+// the history in the validator DB when the server is configured to.
 //
 // Read the JSON string passed through rpc, then call the func
 // which actually imports the data from the JSON file into our database. Use the Keymanager APIs if an API is required.
@@ -60,6 +61,15 @@ func (s *Server) ImportSlashingProtection(ctx context.Context, req *pb.ImportSla
 	enc := []byte(req.SlashingProtectionJson)
 
 	buf := bytes.NewBuffer(enc)
+	if s.mergeSlashingProtection {
+		report, err := slashing.MergeStandardProtectionJSON(ctx, s.valDB, buf)
+		if err != nil {
+			return nil, err
+		}
+		slashing.LogMergeReport(report)
+		log.Info("Slashing protection JSON successfully merged")
+		return &emptypb.Empty{}, nil
+	}
 	if err := slashing.ImportStandardProtectionJSON(ctx, s.valDB, buf); err != nil {
 		return nil, err
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	logTest "github.com/sirupsen/logrus/hooks/test"
 	pb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1/validator-client"
 	"github.com/theQRL/qrysm/testing/require"
 	"github.com/theQRL/qrysm/validator/accounts"
@@ -177,3 +178,41 @@ func TestImportExportSlashingProtection_RoundTrip(t *testing.T) {
 
 	require.DeepEqual(t, mockJSON.Metadata, receivedJSON.Metadata)
 }
+
+func TestImportSlashingProtection_Merge(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := mocks.CreateRandomPubKeys(2)
+	require.NoError(t, err)
+	validatorDB, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{
+		PubKeys: pubKeys,
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, validatorDB.Close())
+	}()
+
+	proposalHistory := make([]kv.ProposalHistoryForPubkey, len(pubKeys))
+	for i := range pubKeys {
+		proposalHistory[i].Proposals = make([]kv.Proposal, 0)
+	}
+	mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys, make([][]*kv.AttestationRecord, 0), proposalHistory)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(mockJSON)
+	require.NoError(t, err)
+	req := &pb.ImportSlashingProtectionRequest{
+		SlashingProtectionJson: string(encoded),
+	}
+
+	hook := logTest.NewGlobal()
+	s := &Server{valDB: validatorDB}
+	_, err = s.ImportSlashingProtection(ctx, req)
+	require.NoError(t, err)
+	require.LogsContain(t, hook, "Slashing protection JSON successfully imported")
+
+	// Importing the same history again only merges it when the server is configured to.
+	hook.Reset()
+	s.mergeSlashingProtection = true
+	_, err = s.ImportSlashingProtection(ctx, req)
+	require.NoError(t, err)
+	require.LogsContain(t, hook, "Slashing protection JSON successfully merged")
+}
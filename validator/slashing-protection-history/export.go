@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 	field_params "github.com/theQRL/qrysm/config/fieldparams"
 	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
 	"github.com/theQRL/qrysm/encoding/bytesutil"
 	"github.com/theQRL/qrysm/monitoring/progress"
 	"github.com/theQRL/qrysm/validator/db"
@@ -198,3 +199,55 @@ func signedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [
 	}
 	return signedBlocks, nil
 }
+
+// MinimalProtectionJSON reduces a complete slashing protection export to the minimal form described by
+// EIP-3076: for every public key, a single block at the highest signed slot and a single attestation with
+// the highest signed source and target epochs. Importing it protects against slashable messages just as
+// well, but the history of the signed messages is lost.
+func MinimalProtectionJSON(complete *format.EIPSlashingProtectionFormat) (*format.EIPSlashingProtectionFormat, error) {
+	minimal := &format.EIPSlashingProtectionFormat{
+		Metadata: complete.Metadata,
+		Data:     make([]*format.ProtectionData, 0, len(complete.Data)),
+	}
+	for _, item := range complete.Data {
+		data := &format.ProtectionData{
+			Pubkey:             item.Pubkey,
+			SignedBlocks:       make([]*format.SignedBlock, 0, 1),
+			SignedAttestations: make([]*format.SignedAttestation, 0, 1),
+		}
+		if len(item.SignedBlocks) > 0 {
+			var maxSlot primitives.Slot
+			for _, b := range item.SignedBlocks {
+				slot, err := SlotFromString(b.Slot)
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not parse slot of signed block for public key %s", item.Pubkey)
+				}
+				maxSlot = max(maxSlot, slot)
+			}
+			data.SignedBlocks = append(data.SignedBlocks, &format.SignedBlock{
+				Slot: fmt.Sprintf("%d", maxSlot),
+			})
+		}
+		if len(item.SignedAttestations) > 0 {
+			var maxSource, maxTarget primitives.Epoch
+			for _, a := range item.SignedAttestations {
+				source, err := EpochFromString(a.SourceEpoch)
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not parse source epoch of signed attestation for public key %s", item.Pubkey)
+				}
+				target, err := EpochFromString(a.TargetEpoch)
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not parse target epoch of signed attestation for public key %s", item.Pubkey)
+				}
+				maxSource = max(maxSource, source)
+				maxTarget = max(maxTarget, target)
+			}
+			data.SignedAttestations = append(data.SignedAttestations, &format.SignedAttestation{
+				SourceEpoch: fmt.Sprintf("%d", maxSource),
+				TargetEpoch: fmt.Sprintf("%d", maxTarget),
+			})
+		}
+		minimal.Data = append(minimal.Data, data)
+	}
+	return minimal, nil
+}
@@ -42,37 +42,9 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 		return errors.Wrap(err, "slashing protection JSON metadata was incorrect")
 	}
 
-	// We need to handle duplicate public keys in the JSON file, with potentially
-	// different signing histories for both attestations and blocks.
-	signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(interchangeJSON.Data)
-	if err != nil {
-		return errors.Wrap(err, "could not parse unique entries for blocks by public key")
-	}
-	signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(interchangeJSON.Data)
+	proposalHistoryByPubKey, attestingHistoryByPubKey, err := parseProtectionData(ctx, interchangeJSON.Data)
 	if err != nil {
-		return errors.Wrap(err, "could not parse unique entries for attestations by public key")
-	}
-
-	attestingHistoryByPubKey := make(map[[field_params.MLDSA87PubkeyLength]byte][]*kv.AttestationRecord)
-	proposalHistoryByPubKey := make(map[[field_params.MLDSA87PubkeyLength]byte]kv.ProposalHistoryForPubkey)
-	for pubKey, signedBlocks := range signedBlocksByPubKey {
-		// Transform the processed signed blocks data from the JSON
-		// file into the internal Qrysm representation of proposal history.
-		proposalHistory, err := transformSignedBlocks(ctx, signedBlocks)
-		if err != nil {
-			return errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
-		}
-		proposalHistoryByPubKey[pubKey] = *proposalHistory
-	}
-
-	for pubKey, signedAtts := range signedAttsByPubKey {
-		// Transform the processed signed attestation data from the JSON
-		// file into the internal Qrysm representation of attesting history.
-		historicalAtt, err := transformSignedAttestations(pubKey, signedAtts)
-		if err != nil {
-			return errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
-		}
-		attestingHistoryByPubKey[pubKey] = historicalAtt
+		return err
 	}
 
 	// We validate and filter out public keys parsed from JSON to ensure we are
@@ -138,6 +110,46 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 	return nil
 }
 
+// parseProtectionData transforms the data of a slashing protection JSON file into the internal
+// Qrysm representation of proposal and attesting histories by public key.
+func parseProtectionData(
+	ctx context.Context, data []*format.ProtectionData,
+) (map[[field_params.MLDSA87PubkeyLength]byte]kv.ProposalHistoryForPubkey, map[[field_params.MLDSA87PubkeyLength]byte][]*kv.AttestationRecord, error) {
+	// We need to handle duplicate public keys in the JSON file, with potentially
+	// different signing histories for both attestations and blocks.
+	signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(data)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not parse unique entries for blocks by public key")
+	}
+	signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(data)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not parse unique entries for attestations by public key")
+	}
+
+	proposalHistoryByPubKey := make(map[[field_params.MLDSA87PubkeyLength]byte]kv.ProposalHistoryForPubkey)
+	for pubKey, signedBlocks := range signedBlocksByPubKey {
+		// Transform the processed signed blocks data from the JSON
+		// file into the internal Qrysm representation of proposal history.
+		proposalHistory, err := transformSignedBlocks(ctx, signedBlocks)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
+		}
+		proposalHistoryByPubKey[pubKey] = *proposalHistory
+	}
+
+	attestingHistoryByPubKey := make(map[[field_params.MLDSA87PubkeyLength]byte][]*kv.AttestationRecord)
+	for pubKey, signedAtts := range signedAttsByPubKey {
+		// Transform the processed signed attestation data from the JSON
+		// file into the internal Qrysm representation of attesting history.
+		historicalAtt, err := transformSignedAttestations(pubKey, signedAtts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
+		}
+		attestingHistoryByPubKey[pubKey] = historicalAtt
+	}
+	return proposalHistoryByPubKey, attestingHistoryByPubKey, nil
+}
+
 func validateMetadata(ctx context.Context, validatorDB db.Database, interchangeJSON *format.EIPSlashingProtectionFormat) error {
 	// We need to ensure the version in the metadata field matches the one we support.
 	version := interchangeJSON.Metadata.InterchangeFormatVersion
@@ -0,0 +1,259 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	field_params "github.com/theQRL/qrysm/config/fieldparams"
+	"github.com/theQRL/qrysm/config/params"
+	"github.com/theQRL/qrysm/consensus-types/primitives"
+	"github.com/theQRL/qrysm/encoding/bytesutil"
+	qrysmpb "github.com/theQRL/qrysm/proto/qrysm/v1alpha1"
+	"github.com/theQRL/qrysm/proto/qrysm/v1alpha1/slashings"
+	"github.com/theQRL/qrysm/validator/db"
+	"github.com/theQRL/qrysm/validator/db/kv"
+	"github.com/theQRL/qrysm/validator/slashing-protection-history/format"
+)
+
+// Kinds of conflicts between an imported record and the history it is merged into.
+const (
+	DoubleProposal  = "double proposal"
+	DoubleVote      = "double vote"
+	SurroundingVote = "surrounding vote"
+	SurroundedVote  = "surrounded vote"
+)
+
+// Conflict is a record of a slashing protection JSON file that was not merged, because
+// signing it on top of the existing history would be slashable.
+type Conflict struct {
+	PubKey [field_params.MLDSA87PubkeyLength]byte
+	Kind   string
+	// Record is the record of the file that was left out.
+	Record string
+	// Existing is the record it conflicts with, either from the database or from earlier in the file.
+	Existing string
+}
+
+// MergeReport summarizes the outcome of merging a slashing protection JSON file into the database.
+type MergeReport struct {
+	ImportedProposals    int
+	ImportedAttestations int
+	// Duplicates is the number of records of the file that were already in the database.
+	Duplicates int
+	Conflicts  []*Conflict
+}
+
+// MergeStandardProtectionJSON merges an EIP-3076 compliant slashing protection JSON file with the
+// attesting and proposal histories already in the validator database. Records already present are
+// skipped, and records that would be slashable with respect to the existing history, or to the records
+// merged before them, are left out and listed in the returned report. Public keys with such conflicts
+// are saved as slashable, so that the validator client refuses to sign with them, like on a regular import.
+func MergeStandardProtectionJSON(ctx context.Context, validatorDB db.Database, r io.Reader) (*MergeReport, error) {
+	encodedJSON, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read slashing protection JSON file")
+	}
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+	}
+	report := &MergeReport{}
+	if interchangeJSON.Data == nil {
+		log.Warn("No slashing protection data to import")
+		return report, nil
+	}
+	if err := validateMetadata(ctx, validatorDB, interchangeJSON); err != nil {
+		return nil, errors.Wrap(err, "slashing protection JSON metadata was incorrect")
+	}
+	proposalHistoryByPubKey, attestingHistoryByPubKey, err := parseProtectionData(ctx, interchangeJSON.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	for pubKey, proposalHistory := range proposalHistoryByPubKey {
+		if err := mergeProposals(ctx, validatorDB, pubKey, proposalHistory.Proposals, report); err != nil {
+			return nil, err
+		}
+	}
+	for pubKey, attestations := range attestingHistoryByPubKey {
+		if err := mergeAttestations(ctx, validatorDB, pubKey, attestations, report); err != nil {
+			return nil, err
+		}
+	}
+
+	slashablePublicKeys := make([][field_params.MLDSA87PubkeyLength]byte, 0)
+	seen := make(map[[field_params.MLDSA87PubkeyLength]byte]bool)
+	for _, c := range report.Conflicts {
+		if !seen[c.PubKey] {
+			seen[c.PubKey] = true
+			slashablePublicKeys = append(slashablePublicKeys, c.PubKey)
+		}
+	}
+	if err := validatorDB.SaveEIPImportBlacklistedPublicKeys(ctx, slashablePublicKeys); err != nil {
+		return nil, errors.Wrap(err, "could not save slashable public keys to database")
+	}
+	return report, nil
+}
+
+// LogMergeReport prints the records that were left out of a merge because they would be slashable.
+func LogMergeReport(report *MergeReport) {
+	for _, c := range report.Conflicts {
+		log.WithFields(logrus.Fields{
+			"pubkey":   fmt.Sprintf("%#x", bytesutil.Trunc(c.PubKey[:])),
+			"kind":     c.Kind,
+			"imported": c.Record,
+			"existing": c.Existing,
+		}).Warn("Slashable record was not merged")
+	}
+	log.WithFields(logrus.Fields{
+		"importedProposals":    report.ImportedProposals,
+		"importedAttestations": report.ImportedAttestations,
+		"duplicates":           report.Duplicates,
+		"conflicts":            len(report.Conflicts),
+	}).Info("Merged slashing protection history")
+	if len(report.Conflicts) > 0 {
+		log.Warn("Public keys with conflicting records were marked as slashable and will not be used for signing")
+	}
+}
+
+func mergeProposals(
+	ctx context.Context,
+	validatorDB db.Database,
+	pubKey [field_params.MLDSA87PubkeyLength]byte,
+	proposals []kv.Proposal,
+	report *MergeReport,
+) error {
+	existing, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
+	if err != nil {
+		return errors.Wrapf(err, "could not get proposal history for public key %#x", pubKey)
+	}
+	rootsBySlot := make(map[primitives.Slot][32]byte, len(existing))
+	for _, p := range existing {
+		rootsBySlot[p.Slot] = bytesToRoot(p.SigningRoot)
+	}
+	for _, p := range proposals {
+		root := bytesToRoot(p.SigningRoot)
+		if existingRoot, ok := rootsBySlot[p.Slot]; ok {
+			// Signing roots are optional: a proposal without one is the same message with an unknown root.
+			// Only two different signing roots at the same slot make a double proposal.
+			if !conflictingRoots(existingRoot, root) {
+				report.Duplicates++
+				continue
+			}
+			report.Conflicts = append(report.Conflicts, &Conflict{
+				PubKey:   pubKey,
+				Kind:     DoubleProposal,
+				Record:   proposalString(p.Slot, root),
+				Existing: proposalString(p.Slot, existingRoot),
+			})
+			continue
+		}
+		if err := validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, p.Slot, p.SigningRoot); err != nil {
+			return errors.Wrap(err, "could not save proposal history from imported JSON to database")
+		}
+		rootsBySlot[p.Slot] = root
+		report.ImportedProposals++
+	}
+	return nil
+}
+
+func mergeAttestations(
+	ctx context.Context,
+	validatorDB db.Database,
+	pubKey [field_params.MLDSA87PubkeyLength]byte,
+	attestations []*kv.AttestationRecord,
+	report *MergeReport,
+) error {
+	known, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+	if err != nil {
+		return errors.Wrapf(err, "could not get attestation history for public key %#x", pubKey)
+	}
+	indexedAtts := make([]*qrysmpb.IndexedAttestation, 0, len(attestations))
+	signingRoots := make([][32]byte, 0, len(attestations))
+	for _, att := range attestations {
+		duplicate, conflict := attestationConflict(known, att)
+		if duplicate {
+			report.Duplicates++
+			continue
+		}
+		if conflict != nil {
+			report.Conflicts = append(report.Conflicts, conflict)
+			continue
+		}
+		known = append(known, att)
+		indexedAtts = append(indexedAtts, createAttestation(att.Source, att.Target))
+		signingRoots = append(signingRoots, att.SigningRoot)
+	}
+	if len(indexedAtts) == 0 {
+		return nil
+	}
+	if err := validatorDB.SaveAttestationsForPubKey(ctx, pubKey, signingRoots, indexedAtts); err != nil {
+		return errors.Wrap(err, "could not save attestations from imported JSON to database")
+	}
+	report.ImportedAttestations += len(indexedAtts)
+	return nil
+}
+
+// attestationConflict checks an attestation record against the known history of its public key. It returns
+// whether the very same record is already known, or the conflict that makes it slashable.
+func attestationConflict(known []*kv.AttestationRecord, att *kv.AttestationRecord) (bool, *Conflict) {
+	incoming := createAttestation(att.Source, att.Target)
+	for _, k := range known {
+		if k.Source == att.Source && k.Target == att.Target && !conflictingRoots(k.SigningRoot, att.SigningRoot) {
+			return true, nil
+		}
+	}
+	for _, k := range known {
+		var kind string
+		existing := createAttestation(k.Source, k.Target)
+		switch {
+		case k.Target == att.Target && (k.Source != att.Source || conflictingRoots(k.SigningRoot, att.SigningRoot)):
+			kind = DoubleVote
+		case slashings.IsSurround(incoming, existing):
+			kind = SurroundingVote
+		case slashings.IsSurround(existing, incoming):
+			kind = SurroundedVote
+		default:
+			continue
+		}
+		return false, &Conflict{
+			PubKey:   att.PubKey,
+			Kind:     kind,
+			Record:   attestationString(att.Source, att.Target, att.SigningRoot),
+			Existing: attestationString(k.Source, k.Target, k.SigningRoot),
+		}
+	}
+	return false, nil
+}
+
+// conflictingRoots reports whether two signing roots belong to different messages. A missing signing
+// root means the message is unknown, so it conflicts with no other root.
+func conflictingRoots(a, b [32]byte) bool {
+	zeroHash := params.BeaconConfig().ZeroHash
+	return a != zeroHash && b != zeroHash && a != b
+}
+
+func bytesToRoot(b []byte) [32]byte {
+	var root [32]byte
+	copy(root[:], b)
+	return root
+}
+
+func proposalString(slot primitives.Slot, root [32]byte) string {
+	return fmt.Sprintf("block at slot %d%s", slot, signingRootString(root))
+}
+
+func attestationString(source, target primitives.Epoch, root [32]byte) string {
+	return fmt.Sprintf("attestation with source %d and target %d%s", source, target, signingRootString(root))
+}
+
+func signingRootString(root [32]byte) string {
+	if root == params.BeaconConfig().ZeroHash {
+		return " without signing root"
+	}
+	return fmt.Sprintf(" with signing root %#x", root)
+}
@@ -0,0 +1,218 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/theQRL/qrysm/testing/assert"
+	"github.com/theQRL/qrysm/testing/require"
+	dbtest "github.com/theQRL/qrysm/validator/db/testing"
+	"github.com/theQRL/qrysm/validator/slashing-protection-history/format"
+	valtest "github.com/theQRL/qrysm/validator/testing"
+)
+
+func protectionJSON(t *testing.T, data ...*format.ProtectionData) *bytes.Buffer {
+	interchangeJSON := &format.EIPSlashingProtectionFormat{Data: data}
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{1})
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	encoded, err := json.Marshal(interchangeJSON)
+	require.NoError(t, err)
+	return bytes.NewBuffer(encoded)
+}
+
+func TestMergeStandardProtectionJSON(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(3)
+	require.NoError(t, err)
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+
+	// The history of the current host.
+	existing := []*format.ProtectionData{
+		{
+			Pubkey: fmt.Sprintf("%#x", pubKeys[0]),
+			SignedBlocks: []*format.SignedBlock{
+				{Slot: "10", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+				{Slot: "11"},
+			},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+			},
+		},
+		{
+			Pubkey: fmt.Sprintf("%#x", pubKeys[1]),
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "2", TargetEpoch: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+			},
+		},
+	}
+	require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, protectionJSON(t, existing...)))
+
+	// The history of the host the validators are migrated from.
+	incoming := []*format.ProtectionData{
+		{
+			Pubkey: fmt.Sprintf("%#x", pubKeys[0]),
+			SignedBlocks: []*format.SignedBlock{
+				{Slot: "10", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})}, // Duplicate.
+				{Slot: "11"}, // Duplicate without signing root.
+				{Slot: "12", SigningRoot: fmt.Sprintf("%#x", [32]byte{3})},
+			},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})}, // Duplicate.
+				{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", [32]byte{3})},
+			},
+		},
+		{
+			Pubkey: fmt.Sprintf("%#x", pubKeys[1]),
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "3", TargetEpoch: "4", SigningRoot: fmt.Sprintf("%#x", [32]byte{3})}, // Surrounded.
+				{SourceEpoch: "5", TargetEpoch: "6", SigningRoot: fmt.Sprintf("%#x", [32]byte{4})},
+			},
+		},
+		{
+			Pubkey: fmt.Sprintf("%#x", pubKeys[2]),
+			SignedBlocks: []*format.SignedBlock{
+				{Slot: "20", SigningRoot: fmt.Sprintf("%#x", [32]byte{5})},
+			},
+		},
+	}
+	// Slashable with respect to the existing history.
+	incoming[0].SignedBlocks = append(incoming[0].SignedBlocks, &format.SignedBlock{Slot: "10", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})})
+	incoming[0].SignedAttestations = append(incoming[0].SignedAttestations, &format.SignedAttestation{SourceEpoch: "0", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})})
+	incoming[1].SignedAttestations = append(incoming[1].SignedAttestations, &format.SignedAttestation{SourceEpoch: "1", TargetEpoch: "7", SigningRoot: fmt.Sprintf("%#x", [32]byte{5})})
+
+	report, err := MergeStandardProtectionJSON(ctx, validatorDB, protectionJSON(t, incoming...))
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.ImportedProposals)
+	assert.Equal(t, 2, report.ImportedAttestations)
+	assert.Equal(t, 3, report.Duplicates)
+
+	kinds := make(map[string]int)
+	for _, c := range report.Conflicts {
+		kinds[c.Kind]++
+	}
+	assert.DeepEqual(t, map[string]int{DoubleProposal: 1, DoubleVote: 1, SurroundedVote: 1, SurroundingVote: 1}, kinds)
+
+	// Only the new records that are not slashable were saved.
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, 3, len(proposals))
+	for _, p := range proposals {
+		if p.Slot == 10 {
+			assert.DeepEqual(t, [32]byte{1}, bytesToRoot(p.SigningRoot))
+		}
+	}
+	atts, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKeys[1])
+	require.NoError(t, err)
+	require.Equal(t, 2, len(atts))
+	proposals, err = validatorDB.ProposalHistoryForPubKey(ctx, pubKeys[2])
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposals))
+
+	// Keys with conflicts are marked as slashable.
+	slashable, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(slashable))
+	slashableKeys := map[string]bool{fmt.Sprintf("%#x", slashable[0]): true, fmt.Sprintf("%#x", slashable[1]): true}
+	assert.Equal(t, true, slashableKeys[fmt.Sprintf("%#x", pubKeys[0])])
+	assert.Equal(t, true, slashableKeys[fmt.Sprintf("%#x", pubKeys[1])])
+}
+
+func TestMergeStandardProtectionJSON_ConflictsWithinFile(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+
+	report, err := MergeStandardProtectionJSON(ctx, validatorDB, protectionJSON(t, &format.ProtectionData{
+		Pubkey: fmt.Sprintf("%#x", pubKeys[0]),
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+			{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.ImportedAttestations)
+	require.Equal(t, 1, len(report.Conflicts))
+	assert.Equal(t, DoubleVote, report.Conflicts[0].Kind)
+}
+
+func TestMergeStandardProtectionJSON_MissingSigningRoots(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+
+	_, err = MergeStandardProtectionJSON(ctx, validatorDB, protectionJSON(t, &format.ProtectionData{
+		Pubkey: fmt.Sprintf("%#x", pubKeys[0]),
+		SignedBlocks: []*format.SignedBlock{
+			{Slot: "10", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+			{Slot: "11"},
+		},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+			{SourceEpoch: "2", TargetEpoch: "3"},
+		},
+	}))
+	require.NoError(t, err)
+
+	// The same messages, with the signing roots missing on one side or the other, are duplicates.
+	report, err := MergeStandardProtectionJSON(ctx, validatorDB, protectionJSON(t, &format.ProtectionData{
+		Pubkey: fmt.Sprintf("%#x", pubKeys[0]),
+		SignedBlocks: []*format.SignedBlock{
+			{Slot: "10"},
+			{Slot: "11", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+		},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "1", TargetEpoch: "2"},
+			{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, 4, report.Duplicates)
+	assert.Equal(t, 0, len(report.Conflicts))
+	slashable, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(slashable))
+}
+
+func TestMinimalProtectionJSON(t *testing.T) {
+	complete := &format.EIPSlashingProtectionFormat{
+		Data: []*format.ProtectionData{
+			{
+				Pubkey: "0x01",
+				SignedBlocks: []*format.SignedBlock{
+					{Slot: "12", SigningRoot: "0x01"},
+					{Slot: "30", SigningRoot: "0x02"},
+					{Slot: "20", SigningRoot: "0x03"},
+				},
+				SignedAttestations: []*format.SignedAttestation{
+					{SourceEpoch: "4", TargetEpoch: "5", SigningRoot: "0x01"},
+					{SourceEpoch: "5", TargetEpoch: "6", SigningRoot: "0x02"},
+					{SourceEpoch: "2", TargetEpoch: "7", SigningRoot: "0x03"},
+				},
+			},
+			{
+				Pubkey:             "0x02",
+				SignedBlocks:       []*format.SignedBlock{},
+				SignedAttestations: []*format.SignedAttestation{},
+			},
+		},
+	}
+	complete.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+
+	minimal, err := MinimalProtectionJSON(complete)
+	require.NoError(t, err)
+	assert.Equal(t, complete.Metadata, minimal.Metadata)
+	require.Equal(t, 2, len(minimal.Data))
+	assert.DeepEqual(t, []*format.SignedBlock{{Slot: "30"}}, minimal.Data[0].SignedBlocks)
+	assert.DeepEqual(t, []*format.SignedAttestation{{SourceEpoch: "5", TargetEpoch: "7"}}, minimal.Data[0].SignedAttestations)
+	assert.Equal(t, 0, len(minimal.Data[1].SignedBlocks))
+	assert.Equal(t, 0, len(minimal.Data[1].SignedAttestations))
+
+	complete.Data[0].SignedBlocks[0].Slot = "BadSlot"
+	_, err = MinimalProtectionJSON(complete)
+	require.ErrorContains(t, "could not parse slot", err)
+}